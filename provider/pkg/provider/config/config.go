@@ -0,0 +1,24 @@
+package config
+
+import (
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// Config is the provider level configuration
+type Config struct {
+	BecomePassword *string   `pulumi:"becomePassword,optional" provider:"secret"`
+	SourceRoot     *string   `pulumi:"sourceRoot,optional"`
+	BinPaths       *[]string `pulumi:"binPaths,optional"`
+}
+
+func (c *Config) Annotate(a infer.Annotator) {
+	a.Describe(&c.BecomePassword, `The password to use when running commands with become. This is
+				passed to the become method through an askpass helper and is never written to state or logs`)
+	a.Describe(&c.SourceRoot, `The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+				Defaults to cloning to $HOME/$REPO`)
+	a.Describe(&c.BinPaths, `Directories to put on PATH for commands that don't inherit the environment. They come
+				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+				resources it deletes, so this can be used to always find tools installed by other resources`)
+}
@@ -1,17 +1,48 @@
 package installers
 
 import (
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
 type BaseInputs struct {
-	UpdateCommands    *[]string `pulumi:"updateCommands,optional"`
-	UninstallCommands *[]string `pulumi:"uninstallCommands,optional"`
+	LifecycleHooks
+	UpdateCommands     *[]string `pulumi:"updateCommands,optional"`
+	UninstallCommands  *[]string `pulumi:"uninstallCommands,optional"`
+	UpdateSteps        *[]Step   `pulumi:"updateSteps,optional"`
+	UninstallSteps     *[]Step   `pulumi:"uninstallSteps,optional"`
+	Become             *bool     `pulumi:"become,optional"`
+	BecomeMethod       *string   `pulumi:"becomeMethod,optional"`
+	Stdin              *string   `pulumi:"stdin,optional" provider:"secret"`
+	OutputsFrom        *string   `pulumi:"outputsFrom,optional"`
+	SecretOutputs      *bool     `pulumi:"secretOutputs,optional"`
+	InheritEnvironment *bool     `pulumi:"inheritEnvironment,optional"`
+	Verify             *Verify   `pulumi:"verify,optional"`
 }
 
 func (b *BaseInputs) Annotate(a infer.Annotator) {
 	a.Describe(&b.UpdateCommands, "Optional Commands to run to update the program")
 	a.Describe(&b.UninstallCommands, "Optional Commands to run to uninstall the program")
+	a.Describe(&b.UpdateSteps, "Optional steps to run to update the program. These are run after updateCommands")
+	a.Describe(&b.UninstallSteps, `Optional steps to run to uninstall the program. These are run after uninstallCommands.
+				A failing step fails the delete unless it sets continueOnError`)
+	a.Describe(&b.Become, `Whether to run the install, update and uninstall commands as root. If the become method
+				needs a password then it is read from the becomePassword provider config`)
+	a.Describe(&b.BecomeMethod, "The method to use to become root. Either sudo or doas. Defaults to sudo")
+	a.Describe(&b.Stdin, `Input to write to the stdin of the install, update and uninstall commands. This can be used
+				to answer the prompts of interactive installers, e.g. "y\n" to accept a license`)
+	a.Describe(&b.OutputsFrom, `How to create the outputs map from the install and update commands. The only supported
+				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+				even if it sets continueOnError`)
+	a.Describe(&b.SecretOutputs, "Whether to mark stdout, stderr and outputs as secret")
+	a.Describe(&b.InheritEnvironment, `Whether commands inherit the environment of the provider. If this is false then
+				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+				directories. Defaults to true`)
+	a.Describe(&b.Verify, `A command to check that the program works after it is installed or updated. If this
+				fails then a create is cleaned up and an update is rolled back`)
 }
 
 type CommandInputs struct {
@@ -26,12 +57,82 @@ func (c *CommandInputs) Annotate(a infer.Annotator) {
 
 type CommandOutputs struct {
 	CommandInputs
+	Steps   *[]StepResult   `pulumi:"steps,optional"`
+	Stdout  *string         `pulumi:"stdout,optional"`
+	Stderr  *string         `pulumi:"stderr,optional"`
+	Outputs *map[string]any `pulumi:"outputs,optional"`
+}
+
+func (c *CommandOutputs) Annotate(a infer.Annotator) {
+	a.Describe(&c.Steps, "The results of the steps that were run by the last install or update")
+	a.Describe(&c.Stdout, "The stdout of the last install or update")
+	a.Describe(&c.Stderr, "The stderr of the last install or update")
+	a.Describe(&c.Outputs, "The outputs parsed from the last install or update. See outputsFrom")
 }
 
 type BaseOutputs struct {
-	Version *string `pulumi:"version"`
+	Version *string `pulumi:"version,optional"`
 }
 
 func (b *BaseOutputs) Annotate(a infer.Annotator) {
-	a.Describe(&b.Version, "The version of the program")
+	a.Describe(&b.Version, `The version of the program. This is the version reported by versionCommand, otherwise
+				the desiredVersion`)
+}
+
+// checkBase validates the inputs that every installer has
+func checkBase(ctx p.Context, inputs resource.PropertyMap) []p.CheckFailure {
+	return append(checkBecome(ctx, inputs), checkVerify(inputs)...)
+}
+
+// diff adds the inputs that changed to diff. None of them change what is
+// installed, they only affect how later commands are run
+func (b *BaseInputs) diff(olds BaseInputs, diff map[string]p.PropertyDiff) {
+	if !commandsEqual(b.UpdateCommands, olds.UpdateCommands) {
+		diff["updateCommands"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !commandsEqual(b.UninstallCommands, olds.UninstallCommands) {
+		diff["uninstallCommands"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !stepsEqual(b.UpdateSteps, olds.UpdateSteps) {
+		diff["updateSteps"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !stepsEqual(b.UninstallSteps, olds.UninstallSteps) {
+		diff["uninstallSteps"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(b.Become, olds.Become) {
+		diff["become"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(b.BecomeMethod, olds.BecomeMethod) {
+		diff["becomeMethod"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(b.Stdin, olds.Stdin) {
+		diff["stdin"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(b.OutputsFrom, olds.OutputsFrom) {
+		diff["outputsFrom"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(b.SecretOutputs, olds.SecretOutputs) {
+		diff["secretOutputs"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(b.InheritEnvironment, olds.InheritEnvironment) {
+		diff["inheritEnvironment"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !verifyEqual(b.Verify, olds.Verify) {
+		diff["verify"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	b.LifecycleHooks.diff(olds.LifecycleHooks, diff)
+}
+
+// updateSteps returns the steps to run on update and whether any update
+// commands or steps were provided
+func (b *BaseInputs) updateSteps() ([]Step, bool) {
+	if b.UpdateCommands == nil && b.UpdateSteps == nil {
+		return nil, false
+	}
+	return newSteps(b.UpdateCommands, b.UpdateSteps), true
+}
+
+// uninstallSteps returns the steps to run on uninstall
+func (b *BaseInputs) uninstallSteps() []Step {
+	return newSteps(b.UninstallCommands, b.UninstallSteps)
 }
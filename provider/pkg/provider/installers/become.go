@@ -0,0 +1,182 @@
+package installers
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/corymhall/pulumi-provider-pde/provider/pkg/provider/config"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+const (
+	becomeSudo = "sudo"
+	becomeDoas = "doas"
+
+	// becomePasswordEnv is only set in the environment of the become method
+	// so that the askpass helper can read it. sudo resets the environment
+	// before running the command so it never reaches the command itself
+	becomePasswordEnv = "PDE_BECOME_PASSWORD"
+)
+
+// askpassScript is a non-interactive askpass helper which prints the password
+// from the environment. The password itself is never written to disk
+const askpassScript = "#!/bin/sh\nprintf '%s\\n' \"$" + becomePasswordEnv + "\"\n"
+
+// becomeMethod returns the become method to use, or an error if it is unknown
+// or can't be used with the password
+func becomeMethod(method, password *string) (string, error) {
+	m := becomeSudo
+	if method != nil {
+		m = *method
+	}
+	switch m {
+	case becomeSudo:
+		return m, nil
+	case becomeDoas:
+		if password != nil {
+			return "", errors.New("doas does not support reading a password non-interactively, configure doas with nopass or use sudo")
+		}
+		return m, nil
+	default:
+		return "", fmt.Errorf("unknown becomeMethod %q, must be one of %s or %s", m, becomeSudo, becomeDoas)
+	}
+}
+
+// checkBecome validates becomeMethod so that a typo fails before anything
+// is installed rather than part way through
+func checkBecome(ctx p.Context, inputs resource.PropertyMap) []p.CheckFailure {
+	v, ok := inputs["becomeMethod"]
+	if !ok || !v.IsString() {
+		return nil
+	}
+	method := v.StringValue()
+	var password *string
+	if b, ok := inputs["become"]; ok && b.IsBool() && b.BoolValue() {
+		password = infer.GetConfig[config.Config](ctx).BecomePassword
+	}
+	if _, err := becomeMethod(&method, password); err != nil {
+		return []p.CheckFailure{{Property: "becomeMethod", Reason: err.Error()}}
+	}
+	return nil
+}
+
+// becomeArgs wraps args with the become method. It returns the extra
+// environment variables needed to run the command and a cleanup function
+// which must be called once the command has finished
+func becomeArgs(ctx p.Context, method *string, args []string) ([]string, []string, func(), error) {
+	password := infer.GetConfig[config.Config](ctx).BecomePassword
+	m, err := becomeMethod(method, password)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	switch m {
+	case becomeSudo:
+		if password == nil {
+			// without a password sudo must not prompt, otherwise it would hang
+			return append([]string{"sudo", "-n", "--"}, args...), nil, func() {}, nil
+		}
+		askpass, err := writeAskpass()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		env := []string{
+			fmt.Sprintf("SUDO_ASKPASS=%s", askpass),
+			fmt.Sprintf("%s=%s", becomePasswordEnv, *password),
+		}
+		cleanup := func() {
+			os.Remove(askpass)
+		}
+		return append([]string{"sudo", "-A", "--"}, args...), env, cleanup, nil
+	default:
+		return append([]string{"doas", "-n", "--"}, args...), nil, func() {}, nil
+	}
+}
+
+// writeAskpass writes the askpass helper to a private temp file
+func writeAskpass() (string, error) {
+	f, err := os.CreateTemp("", "pde-askpass-*")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if err := f.Chmod(0700); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	if _, err := f.WriteString(askpassScript); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// moveExecutable moves src to target and makes it executable. If become is
+// set then this is done as root so that target can be a location like
+// /usr/local/bin
+func (c *CommandOutputs) moveExecutable(ctx p.Context, b BaseInputs, src, target string) error {
+	if b.Become == nil || !*b.Become {
+		if err := os.Rename(src, target); err != nil {
+			return err
+		}
+		return os.Chmod(target, 0777)
+	}
+	command := fmt.Sprintf("mv %s %s && chmod 0755 %s", shellQuote(src), shellQuote(target), shellQuote(target))
+	_, err := c.runWith(ctx, BaseInputs{Become: b.Become, BecomeMethod: b.BecomeMethod}, command, "")
+	return err
+}
+
+// moveFile moves src to target, as root if become is set
+func (c *CommandOutputs) moveFile(ctx p.Context, b BaseInputs, src, target string) error {
+	if b.Become == nil || !*b.Become {
+		return os.Rename(src, target)
+	}
+	command := fmt.Sprintf("mv -f %s %s", shellQuote(src), shellQuote(target))
+	_, err := c.runWith(ctx, BaseInputs{Become: b.Become, BecomeMethod: b.BecomeMethod}, command, "")
+	return err
+}
+
+// linkFile creates a symlink at target that points to src, replacing the
+// link that is already there, as root if become is set
+func (c *CommandOutputs) linkFile(ctx p.Context, b BaseInputs, src, target string) error {
+	if b.Become == nil || !*b.Become {
+		if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return os.Symlink(src, target)
+	}
+	command := fmt.Sprintf("ln -sfn %s %s", shellQuote(src), shellQuote(target))
+	_, err := c.runWith(ctx, BaseInputs{Become: b.Become, BecomeMethod: b.BecomeMethod}, command, "")
+	return err
+}
+
+// removeFile removes a file that was installed, as root if become is set
+func (c *CommandOutputs) removeFile(ctx p.Context, b BaseInputs, location string) error {
+	if b.Become == nil || !*b.Become {
+		if err := os.Remove(location); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	_, err := c.runWith(ctx, BaseInputs{Become: b.Become, BecomeMethod: b.BecomeMethod}, fmt.Sprintf("rm -f %s", shellQuote(location)), "")
+	return err
+}
+
+// removePath removes a file or directory that was installed, as root if
+// become is set
+func (c *CommandOutputs) removePath(ctx p.Context, b BaseInputs, location string) error {
+	if b.Become == nil || !*b.Become {
+		return os.RemoveAll(location)
+	}
+	_, err := c.runWith(ctx, BaseInputs{Become: b.Become, BecomeMethod: b.BecomeMethod}, fmt.Sprintf("rm -rf %s", shellQuote(location)), "")
+	return err
+}
+
+// shellQuote quotes s so that it is treated as a single word by the shell
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,161 @@
+package installers
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strings"
+)
+
+// elfMachines maps ELF machine types to go architectures
+var elfMachines = map[elf.Machine]string{
+	elf.EM_X86_64:  "amd64",
+	elf.EM_AARCH64: "arm64",
+	elf.EM_386:     "386",
+	elf.EM_ARM:     "arm",
+	elf.EM_RISCV:   "riscv64",
+	elf.EM_S390:    "s390x",
+}
+
+// elfOSABIs maps ELF OS ABIs to go operating systems. Most binaries use
+// ELFOSABI_NONE which says nothing about the OS, so it isn't in here
+var elfOSABIs = map[elf.OSABI]string{
+	elf.ELFOSABI_LINUX:   "linux",
+	elf.ELFOSABI_FREEBSD: "freebsd",
+	elf.ELFOSABI_NETBSD:  "netbsd",
+	elf.ELFOSABI_OPENBSD: "openbsd",
+	elf.ELFOSABI_SOLARIS: "solaris",
+}
+
+// machoCpus maps Mach-O cpu types to go architectures
+var machoCpus = map[macho.Cpu]string{
+	macho.CpuAmd64: "amd64",
+	macho.CpuArm64: "arm64",
+	macho.Cpu386:   "386",
+	macho.CpuArm:   "arm",
+}
+
+// peMachines maps PE machine types to go architectures
+var peMachines = map[uint16]string{
+	pe.IMAGE_FILE_MACHINE_AMD64: "amd64",
+	pe.IMAGE_FILE_MACHINE_ARM64: "arm64",
+	pe.IMAGE_FILE_MACHINE_I386:  "386",
+	pe.IMAGE_FILE_MACHINE_ARMNT: "arm",
+}
+
+// binaryPlatform is the platform a binary is built for. os is empty for ELF
+// binaries that don't say which OS they are for and archs has more than one
+// entry for universal binaries
+type binaryPlatform struct {
+	format string
+	os     string
+	archs  []string
+}
+
+// checkPlatform returns an error if the file at location is a binary that
+// can't run on platform, see parsePlatform
+func checkPlatform(location string, platform *string) error {
+	goos, arch, err := parsePlatform(platform)
+	if err != nil {
+		return err
+	}
+	return checkBinary(location, goos, arch)
+}
+
+// checkBinary returns an error if the file at location is a binary that can't
+// run on the goos/arch platform. Files that aren't ELF, Mach-O or PE binaries,
+// e.g. scripts, are not checked
+func checkBinary(location, goos, arch string) error {
+	f, err := os.Open(location)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	bp := readBinaryPlatform(f)
+	if bp == nil {
+		return nil
+	}
+	if bp.runsOn(goos) && slices.Contains(bp.archs, arch) {
+		return nil
+	}
+	return fmt.Errorf("%s is a %s binary for %s, not %s", path.Base(location), bp.format, bp, goos+"/"+arch)
+}
+
+// runsOn returns whether the binary can be run by goos
+func (bp *binaryPlatform) runsOn(goos string) bool {
+	if bp.format == "ELF" {
+		// darwin and windows don't run ELF binaries at all
+		if goos == "darwin" || goos == "windows" {
+			return false
+		}
+		return bp.os == "" || bp.os == goos
+	}
+	return bp.os == goos
+}
+
+func (bp *binaryPlatform) String() string {
+	goos := bp.os
+	if goos == "" {
+		goos = "any"
+	}
+	return goos + "/" + strings.Join(bp.archs, ",")
+}
+
+// readBinaryPlatform reads the platform from the headers of the binary in r.
+// It returns nil if r isn't a binary
+func readBinaryPlatform(r io.ReaderAt) *binaryPlatform {
+	if f, err := elf.NewFile(r); err == nil {
+		return &binaryPlatform{format: "ELF", os: elfOSABIs[f.OSABI], archs: []string{elfMachine(f)}}
+	}
+	if f, err := macho.NewFile(r); err == nil {
+		return &binaryPlatform{format: "Mach-O", os: "darwin", archs: []string{machoCpu(f.Cpu)}}
+	}
+	if f, err := macho.NewFatFile(r); err == nil {
+		bp := &binaryPlatform{format: "Mach-O", os: "darwin"}
+		for _, a := range f.Arches {
+			bp.archs = append(bp.archs, machoCpu(a.Cpu))
+		}
+		return bp
+	}
+	if f, err := pe.NewFile(r); err == nil {
+		arch, ok := peMachines[f.Machine]
+		if !ok {
+			arch = fmt.Sprintf("0x%x", f.Machine)
+		}
+		return &binaryPlatform{format: "PE", os: "windows", archs: []string{arch}}
+	}
+	return nil
+}
+
+func elfMachine(f *elf.File) string {
+	switch f.Machine {
+	case elf.EM_PPC64:
+		if f.ByteOrder == binary.LittleEndian {
+			return "ppc64le"
+		}
+		return "ppc64"
+	case elf.EM_MIPS:
+		if f.Class == elf.ELFCLASS64 {
+			return "mips64"
+		}
+		return "mips"
+	}
+	if arch, ok := elfMachines[f.Machine]; ok {
+		return arch
+	}
+	return f.Machine.String()
+}
+
+func machoCpu(cpu macho.Cpu) string {
+	if arch, ok := machoCpus[cpu]; ok {
+		return arch
+	}
+	return cpu.String()
+}
@@ -0,0 +1,113 @@
+package installers
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/corymhall/pulumi-provider-pde/provider/pkg/provider/config"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// allowedEnv are the only variables from the environment of the provider
+// that are passed to commands when inheritEnvironment is false
+var allowedEnv = []string{"HOME", "USER", "LANG", "TERM"}
+
+// systemPath is where PATH ends when inheritEnvironment is false
+var systemPath = []string{"/usr/local/sbin", "/usr/local/bin", "/usr/sbin", "/usr/bin", "/sbin", "/bin"}
+
+// binDirs are the bin directories of the resources that the provider has
+// seen, in the order it saw them. Resources are checked in the order they
+// are declared, so a tool installed earlier in the stack can be found by the
+// commands of later ones
+var binDirs struct {
+	sync.Mutex
+	dirs []string
+}
+
+// addBinDirs records the bin directories of a resource. Directories that
+// aren't set are skipped
+func addBinDirs(dirs ...*string) {
+	binDirs.Lock()
+	defer binDirs.Unlock()
+	for _, d := range dirs {
+		if d == nil || *d == "" {
+			continue
+		}
+		if dir := filepath.Clean(*d); !slices.Contains(binDirs.dirs, dir) {
+			binDirs.dirs = append(binDirs.dirs, dir)
+		}
+	}
+}
+
+// binDirsKey is the context key for the bin directories of the resource
+// that runs commands with the context
+type binDirsKey struct{}
+
+// withBinDirs returns a context whose commands find the programs in dirs,
+// the bin directories of the resource, when they don't inherit the
+// environment. The directories are also recorded for the commands of other
+// resources. Directories that aren't set are skipped
+func withBinDirs(ctx p.Context, dirs ...*string) p.Context {
+	addBinDirs(dirs...)
+	var ds []string
+	for _, d := range dirs {
+		if d != nil && *d != "" {
+			ds = append(ds, *d)
+		}
+	}
+	return p.CtxWithValue(ctx, binDirsKey{}, ds)
+}
+
+// managedPath returns the PATH for commands that don't inherit the
+// environment: the bin directories of the resource, the bin directories of
+// the other resources the provider has seen, the binPaths from the provider
+// config, $HOME/.local/bin and then the system directories so that installed
+// tools win over the ones from the OS. Only the first two groups depend on
+// which resources the provider has seen, e.g. a destroy only sees the
+// resources it deletes, so binPaths can be used to pin the others
+func managedPath(ctx p.Context) string {
+	var dirs []string
+	add := func(d string) {
+		if d == "" {
+			return
+		}
+		if d, err := expandHome(d); err == nil && !slices.Contains(dirs, d) {
+			dirs = append(dirs, d)
+		}
+	}
+	own, _ := ctx.Value(binDirsKey{}).([]string)
+	for _, d := range own {
+		add(d)
+	}
+	binDirs.Lock()
+	for _, d := range binDirs.dirs {
+		add(d)
+	}
+	binDirs.Unlock()
+	if binPaths := infer.GetConfig[config.Config](ctx).BinPaths; binPaths != nil {
+		for _, d := range *binPaths {
+			add(d)
+		}
+	}
+	add(filepath.Join("~", ".local", "bin"))
+	for _, d := range systemPath {
+		add(d)
+	}
+	return strings.Join(dirs, string(os.PathListSeparator))
+}
+
+// cleanEnv returns the minimal environment used when inheritEnvironment is
+// false
+func cleanEnv(ctx p.Context) []string {
+	env := []string{"PATH=" + managedPath(ctx)}
+	for _, k := range allowedEnv {
+		if v, ok := os.LookupEnv(k); ok {
+			env = append(env, k+"="+v)
+		}
+	}
+	return env
+}
@@ -0,0 +1,625 @@
+package installers
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+)
+
+var (
+	// ErrGitAuth is returned when the remote rejects the credentials, or
+	// needs some and none were given
+	ErrGitAuth = errors.New("git authentication failed")
+	// ErrGitRefNotFound is returned when a branch, tag or commit doesn't
+	// exist
+	ErrGitRefNotFound = errors.New("git reference not found")
+	// ErrGitNetwork is returned when the remote can't be reached, which
+	// includes there being no repository at its URL
+	ErrGitNetwork = errors.New("git network failure")
+)
+
+// GitError is returned by the git operations of GitHubRepo. Kind is one of
+// ErrGitAuth, ErrGitRefNotFound or ErrGitNetwork, or nil if the failure is
+// something else, so errors.Is can be used to check what went wrong. Target
+// is the remote, ref or directory that the operation was run on
+type GitError struct {
+	Op     string
+	Target string
+	Kind   error
+	Err    error
+}
+
+func (e *GitError) Error() string {
+	msg := "git " + e.Op
+	if e.Target != "" {
+		msg += " " + e.Target
+	}
+	return msg + ": " + e.Err.Error()
+}
+
+func (e *GitError) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// gitError wraps err from the op git operation in a GitError
+func gitError(op, target string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &GitError{Op: op, Target: target, Kind: gitErrorKind(err), Err: err}
+}
+
+// gitErrorKind returns what kind of failure err is. go-git wraps transport
+// errors in types that can't be unwrapped so those are unwrapped here
+func gitErrorKind(err error) error {
+	for err != nil {
+		var netErr net.Error
+		var refSpecErr git.NoMatchingRefSpecError
+		switch {
+		case errors.Is(err, transport.ErrAuthenticationRequired),
+			errors.Is(err, transport.ErrAuthorizationFailed),
+			errors.Is(err, transport.ErrInvalidAuthMethod):
+			return ErrGitAuth
+		case errors.Is(err, plumbing.ErrReferenceNotFound),
+			errors.Is(err, plumbing.ErrObjectNotFound),
+			errors.As(err, &refSpecErr):
+			return ErrGitRefNotFound
+		case errors.As(err, &netErr),
+			errors.Is(err, transport.ErrRepositoryNotFound):
+			return ErrGitNetwork
+		}
+		switch e := err.(type) {
+		case *plumbing.PermanentError:
+			err = e.Err
+		case *plumbing.UnexpectedError:
+			err = e.Err
+		default:
+			err = errors.Unwrap(err)
+		}
+	}
+	return nil
+}
+
+// gitCredentials are the credentials used to talk to remotes
+type gitCredentials struct {
+	// sshKey is the path to a private key. If it isn't set then the SSH
+	// agent is used
+	sshKey         *string
+	sshKeyPassword *string
+}
+
+// auth returns the auth method for the remote at url. SSH remotes use the
+// sshKey or the SSH agent and the host key must be in known_hosts. GitHub
+// https remotes use GITHUB_TOKEN if it is set so that private repos can be
+// cloned. Other remotes don't use any credentials
+func (c gitCredentials) auth(url string) (transport.AuthMethod, error) {
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		return nil, err
+	}
+	switch ep.Protocol {
+	case "ssh":
+		user := ep.User
+		if user == "" {
+			user = gitssh.DefaultUsername
+		}
+		hostKeys, err := gitssh.NewKnownHostsCallback()
+		if err != nil {
+			return nil, fmt.Errorf("reading known_hosts to check the host key of %s: %w", ep.Host, err)
+		}
+		helper := gitssh.HostKeyCallbackHelper{HostKeyCallback: hostKeys}
+		if c.sshKey != nil {
+			password := ""
+			if c.sshKeyPassword != nil {
+				password = *c.sshKeyPassword
+			}
+			keys, err := gitssh.NewPublicKeysFromFile(user, *c.sshKey, password)
+			if err != nil {
+				return nil, fmt.Errorf("reading sshKey: %w", err)
+			}
+			keys.HostKeyCallbackHelper = helper
+			return keys, nil
+		}
+		agent, err := gitssh.NewSSHAgentAuth(user)
+		if err != nil {
+			return nil, fmt.Errorf("no sshKey is set and the SSH agent can't be used: %w", err)
+		}
+		agent.HostKeyCallbackHelper = helper
+		return agent, nil
+	case "http", "https":
+		if token, ok := os.LookupEnv("GITHUB_TOKEN"); ok && token != "" && ep.Host == "github.com" {
+			return &githttp.BasicAuth{Username: "x-access-token", Password: token}, nil
+		}
+	}
+	return nil, nil
+}
+
+// env returns the environment that gives the git CLI the same credentials as
+// auth. The CLI can't be given the password of an encrypted sshKey so the key
+// has to be added to the SSH agent instead
+func (c gitCredentials) env(url string) ([]string, error) {
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		return nil, err
+	}
+	switch ep.Protocol {
+	case "ssh":
+		command := "ssh -o BatchMode=yes -o StrictHostKeyChecking=yes"
+		if c.sshKey != nil {
+			if c.sshKeyPassword != nil {
+				return nil, fmt.Errorf("sshKeyPassword can't be used with filter or sparsePaths, add sshKey to the SSH agent instead")
+			}
+			command += fmt.Sprintf(" -o IdentitiesOnly=yes -i '%s'", strings.ReplaceAll(*c.sshKey, "'", `'\''`))
+		}
+		return []string{"GIT_SSH_COMMAND=" + command}, nil
+	case "http", "https":
+		if token, ok := os.LookupEnv("GITHUB_TOKEN"); ok && token != "" && ep.Host == "github.com" {
+			// config from the environment keeps the token out of the
+			// arguments, which other users can see
+			basic := base64.StdEncoding.EncodeToString([]byte("x-access-token:" + token))
+			return []string{
+				"GIT_CONFIG_COUNT=1",
+				"GIT_CONFIG_KEY_0=http.https://github.com/.extraheader",
+				"GIT_CONFIG_VALUE_0=Authorization: Basic " + basic,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// gitProgress logs the progress messages of the remote. The remote sends an
+// update for every percent so only the first and last line of each phase,
+// e.g. "Receiving objects", are logged
+type gitProgress struct {
+	ctx   p.Context
+	phase string
+	buf   []byte
+}
+
+func (g *gitProgress) Write(b []byte) (int, error) {
+	g.buf = append(g.buf, b...)
+	for {
+		i := strings.IndexAny(string(g.buf), "\r\n")
+		if i < 0 {
+			break
+		}
+		g.log(strings.TrimSpace(string(g.buf[:i])))
+		g.buf = g.buf[i+1:]
+	}
+	return len(b), nil
+}
+
+func (g *gitProgress) log(line string) {
+	if line == "" {
+		return
+	}
+	phase, _, _ := strings.Cut(line, ":")
+	if phase != g.phase || strings.HasSuffix(line, "done.") {
+		g.phase = phase
+		g.ctx.Logf(diag.Info, "%s", line)
+	}
+}
+
+// gitInfiniteDepth is the depth git uses to mean all of the history
+const gitInfiniteDepth = 2147483647
+
+// gitOptions are the options used to clone a repo and keep it up to date
+type gitOptions struct {
+	creds gitCredentials
+	// depth is the number of commits of history to fetch, or 0 for all of it
+	depth int
+	// filter is the partial clone filter, e.g. blob:none
+	filter string
+	// sparsePaths are the directories to check out, or empty for all of them
+	sparsePaths []string
+	// submodules is one of the gitSubmodules modes, empty is the same as none
+	submodules string
+	// lfs pulls the Git LFS files of the commit that is checked out
+	lfs bool
+	// force checks out over local changes, throwing them away
+	force bool
+}
+
+const (
+	// gitSubmodulesNone leaves submodules out
+	gitSubmodulesNone = "none"
+	// gitSubmodulesShallow checks out the submodules of the repo, each
+	// with only the commit that it points to
+	gitSubmodulesShallow = "shallow"
+	// gitSubmodulesRecursive checks out the submodules of the repo and
+	// their submodules, with all of their history
+	gitSubmodulesRecursive = "recursive"
+)
+
+// gitSubmodules are the submodule modes that can be used
+var gitSubmodules = []string{gitSubmodulesNone, gitSubmodulesShallow, gitSubmodulesRecursive}
+
+// useCLI returns whether clone, fetch and checkout use the git CLI instead of
+// go-git. go-git is the default so that a clone works the same on every host,
+// whether git is installed or not, and gives typed errors. It can't make
+// partial clones though, its sparse checkouts still write every file and it
+// would see the files that LFS pulls as local changes
+func (o gitOptions) useCLI() bool {
+	return o.filter != "" || len(o.sparsePaths) > 0 || o.lfs
+}
+
+// hasGitCLI returns whether the git CLI is installed. Looking for local
+// changes, i.e. status and the unpushed commits, uses it whenever it is
+// installed since go-git hashes every file and walks the history in Go, which
+// is slow for large clones. go-git is the fallback for hosts without git and
+// the tests check that both find the same changes. Stashing needs the CLI
+func hasGitCLI() bool {
+	_, err := exec.LookPath("git")
+	return err == nil
+}
+
+// hasSubmodules returns whether submodules are checked out
+func (o gitOptions) hasSubmodules() bool {
+	return o.submodules != "" && o.submodules != gitSubmodulesNone
+}
+
+// clone clones url to dir. All of the branches and tags are fetched, down to
+// depth if it is set, but nothing is checked out until checkout is called. If
+// ref is empty then HEAD is the default branch of the remote
+func (o gitOptions) clone(ctx p.Context, url string, ref plumbing.ReferenceName, dir string) error {
+	ctx.Logf(diag.Info, "Cloning %s into %s", url, dir)
+	if o.useCLI() {
+		args := []string{"clone", "--no-checkout", "--no-single-branch"}
+		if o.depth > 0 {
+			args = append(args, fmt.Sprintf("--depth=%d", o.depth))
+		}
+		if o.filter != "" {
+			args = append(args, "--filter="+o.filter)
+		}
+		if ref != "" {
+			args = append(args, "--branch="+ref.Short())
+		}
+		if err := o.git(ctx, "clone", url, url, "", append(args, "--", url, dir)...); err != nil {
+			return err
+		}
+		if len(o.sparsePaths) > 0 {
+			return o.sparseCheckout(ctx, dir)
+		}
+		return nil
+	}
+
+	auth, err := o.creds.auth(url)
+	if err != nil {
+		return gitError("clone", url, err)
+	}
+	_, err = git.PlainCloneContext(ctx, dir, false, &git.CloneOptions{
+		URL:           url,
+		Auth:          auth,
+		ReferenceName: ref,
+		Depth:         o.depth,
+		NoCheckout:    true,
+		Tags:          git.AllTags,
+		Progress:      &gitProgress{ctx: ctx},
+	})
+	return gitError("clone", url, err)
+}
+
+// gitOpen opens the repository that was cloned to dir
+func gitOpen(dir string) (*git.Repository, error) {
+	repo, err := git.PlainOpen(dir)
+	return repo, gitError("open", dir, err)
+}
+
+// fetch fetches all of the remotes of the repository in dir and their tags,
+// like git fetch --all --tags. A shallow clone only fetches the new commits
+// down to depth
+func (o gitOptions) fetch(ctx p.Context, dir string) error {
+	return o.fetchDepth(ctx, dir, o.depth)
+}
+
+func (o gitOptions) fetchDepth(ctx p.Context, dir string, depth int) error {
+	repo, err := gitOpen(dir)
+	if err != nil {
+		return err
+	}
+	remotes, err := repo.Remotes()
+	if err != nil {
+		return gitError("fetch", "", err)
+	}
+	for _, r := range remotes {
+		name, url := r.Config().Name, r.Config().URLs[0]
+		ctx.Logf(diag.Info, "Fetching %s", name)
+		if o.useCLI() {
+			args := []string{"fetch", "--tags"}
+			if depth > 0 {
+				args = append(args, fmt.Sprintf("--depth=%d", depth))
+			}
+			if err := o.git(ctx, "fetch", name, url, dir, append(args, name)...); err != nil {
+				return err
+			}
+			continue
+		}
+		auth, err := o.creds.auth(url)
+		if err != nil {
+			return gitError("fetch", name, err)
+		}
+		err = repo.FetchContext(ctx, &git.FetchOptions{
+			RemoteName: name,
+			Auth:       auth,
+			Depth:      depth,
+			Tags:       git.AllTags,
+			Progress:   &gitProgress{ctx: ctx},
+		})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return gitError("fetch", name, err)
+		}
+	}
+	return nil
+}
+
+// deepen fetches more of the history of a shallow clone until it has rev. The
+// depth is doubled each time so that checking out an older commit only
+// fetches about as much history as it needs
+func (o gitOptions) deepen(ctx p.Context, dir, rev string) error {
+	for depth := o.depth; depth > 0 && depth < gitInfiniteDepth; {
+		repo, err := gitOpen(dir)
+		if err != nil {
+			return err
+		}
+		if _, err := gitRevParse(repo, rev); err == nil {
+			return nil
+		}
+		depth *= 2
+		if depth > 1024 {
+			depth = gitInfiniteDepth
+		}
+		ctx.Logf(diag.Info, "%s is not in the shallow clone, fetching %d commits of history", rev, depth)
+		if err := o.fetchDepth(ctx, dir, depth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sparseCheckout sets the directories that are checked out to sparsePaths,
+// or checks out all of them again if it is empty
+func (o gitOptions) sparseCheckout(ctx p.Context, dir string) error {
+	if len(o.sparsePaths) == 0 {
+		return o.git(ctx, "sparse-checkout", dir, "", dir, "sparse-checkout", "disable")
+	}
+	return o.git(ctx, "sparse-checkout", dir, "", dir, append([]string{"sparse-checkout", "set", "--"}, o.sparsePaths...)...)
+}
+
+// gitRevParse returns the commit that rev points to, e.g. a commit hash,
+// a tag or origin/main
+func gitRevParse(repo *git.Repository, rev string) (string, error) {
+	hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return "", gitError("rev-parse", rev, err)
+	}
+	return hash.String(), nil
+}
+
+// checkout checks out the commit that rev points to, deepening a shallow
+// clone if it doesn't have it. This leaves the worktree at a detached HEAD,
+// the same as git checkout <commit>
+func (o gitOptions) checkout(ctx p.Context, dir, rev string) error {
+	if err := o.deepen(ctx, dir, rev); err != nil {
+		return err
+	}
+	repo, err := gitOpen(dir)
+	if err != nil {
+		return err
+	}
+	hash, err := gitRevParse(repo, rev)
+	if err != nil {
+		return err
+	}
+	ctx.Logf(diag.Info, "Checking out %s", hash)
+	if o.useCLI() {
+		args := []string{"checkout", "--quiet", "--detach"}
+		if o.force {
+			args = append(args, "--force")
+		}
+		err = o.git(ctx, "checkout", rev, "", dir, append(args, hash)...)
+	} else {
+		var w *git.Worktree
+		if w, err = repo.Worktree(); err == nil {
+			err = w.Checkout(&git.CheckoutOptions{Hash: plumbing.NewHash(hash), Force: o.force})
+		}
+		err = gitError("checkout", rev, err)
+	}
+	if err != nil {
+		return err
+	}
+	remote, err := repo.Remote("origin")
+	if err != nil {
+		return gitError("checkout", rev, err)
+	}
+	return o.updateWorktree(ctx, remote.Config().URLs[0], dir)
+}
+
+// updateWorktree brings the submodules and LFS files of the worktree in dir
+// in line with the commit that is checked out. go-git can't sync submodules
+// or pull LFS files so the git CLI is used for both. url is the remote of
+// the repo, the same credentials are used for the remotes of submodules
+func (o gitOptions) updateWorktree(ctx p.Context, url, dir string) error {
+	if o.hasSubmodules() {
+		ctx.Logf(diag.Info, "Updating %s submodules", o.submodules)
+		// the url of a submodule can change between versions
+		if err := o.git(ctx, "submodule", "sync", url, dir, "submodule", "sync", "--recursive"); err != nil {
+			return err
+		}
+		args := []string{"submodule", "update", "--init", "--force"}
+		if o.submodules == gitSubmodulesShallow {
+			args = append(args, "--depth=1")
+		} else {
+			args = append(args, "--recursive")
+		}
+		if err := o.git(ctx, "submodule", "update", url, dir, args...); err != nil {
+			return err
+		}
+	}
+	if !o.lfs {
+		return nil
+	}
+	if _, err := exec.LookPath("git-lfs"); err != nil {
+		return gitError("lfs", "pull", fmt.Errorf("lfs needs git-lfs to be installed: %w", err))
+	}
+	ctx.Logf(diag.Info, "Pulling LFS files")
+	if err := o.git(ctx, "lfs", "pull", url, dir, "lfs", "pull"); err != nil {
+		return err
+	}
+	if o.hasSubmodules() {
+		args := []string{"submodule", "foreach", "--quiet"}
+		if o.submodules == gitSubmodulesRecursive {
+			args = append(args, "--recursive")
+		}
+		return o.git(ctx, "lfs", "pull", url, dir, append(args, "git lfs pull")...)
+	}
+	return nil
+}
+
+// deinitSubmodules removes the submodules from the worktree in dir when they
+// are no longer wanted
+func (o gitOptions) deinitSubmodules(ctx p.Context, dir string) error {
+	return o.git(ctx, "submodule", "deinit", "", dir, "submodule", "deinit", "--all", "--force")
+}
+
+// status returns the files in the worktree in dir that have local changes.
+// Untracked files are left out because builds usually create some. The git
+// CLI is used if it is installed, see hasGitCLI, and always for sparse
+// checkouts which go-git doesn't know about
+func (o gitOptions) status(ctx p.Context, dir string) ([]string, error) {
+	var changed []string
+	if o.useCLI() || hasGitCLI() {
+		stdout, _, err := (&CommandOutputs{}).exec(ctx, execOptions{
+			args:    []string{"git", "status", "--porcelain", "-z", "--untracked-files=no"},
+			dir:     dir,
+			command: "git status",
+		})
+		if err != nil {
+			return nil, gitError("status", "", err)
+		}
+		// entries are "XY path" and renames and copies are followed by an
+		// entry with the old path. A renamed file is reported under both of
+		// its paths, which is also what go-git does
+		entries := strings.Split(stdout, "\x00")
+		for i := 0; i < len(entries); i++ {
+			if len(entries[i]) < 4 {
+				continue
+			}
+			changed = append(changed, entries[i][3:])
+			switch entries[i][0] {
+			case 'R':
+				i++
+				if i < len(entries) {
+					changed = append(changed, entries[i])
+				}
+			case 'C':
+				i++
+			}
+		}
+		sort.Strings(changed)
+		return changed, nil
+	}
+
+	repo, err := gitOpen(dir)
+	if err != nil {
+		return nil, err
+	}
+	w, err := repo.Worktree()
+	if err != nil {
+		return nil, gitError("status", "", err)
+	}
+	status, err := w.Status()
+	if err != nil {
+		return nil, gitError("status", "", err)
+	}
+	for file, s := range status {
+		if s.Worktree != git.Untracked && (s.Worktree != git.Unmodified || s.Staging != git.Unmodified) {
+			changed = append(changed, file)
+		}
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
+
+// git runs the git CLI in dir. url is the remote that it talks to, if any, so
+// that it is given the same credentials that go-git would use. Messages are
+// kept in English since gitCLIErrorKind matches them
+func (o gitOptions) git(ctx p.Context, op, target, url, dir string, args ...string) error {
+	env := []string{"GIT_TERMINAL_PROMPT=0", "LC_ALL=C"}
+	if url != "" {
+		credEnv, err := o.creds.env(url)
+		if err != nil {
+			return gitError(op, target, err)
+		}
+		env = append(env, credEnv...)
+	}
+	_, stderr, err := (&CommandOutputs{}).exec(ctx, execOptions{
+		args:    append([]string{"git"}, args...),
+		env:     env,
+		dir:     dir,
+		command: "git " + strings.Join(args, " "),
+	})
+	if err != nil {
+		return &GitError{Op: op, Target: target, Kind: gitCLIErrorKind(stderr), Err: err}
+	}
+	return nil
+}
+
+// gitCLIErrorKind returns what kind of failure the git CLI had from what it
+// wrote to stderr
+func gitCLIErrorKind(stderr string) error {
+	switch {
+	case strings.Contains(stderr, "Authentication failed"),
+		strings.Contains(stderr, "Permission denied"),
+		strings.Contains(stderr, "could not read Username"),
+		strings.Contains(stderr, "Host key verification failed"):
+		return ErrGitAuth
+	case strings.Contains(stderr, "not found in upstream"),
+		strings.Contains(stderr, "did not match any"),
+		strings.Contains(stderr, "reference is not a tree"),
+		strings.Contains(stderr, "couldn't find remote ref"):
+		return ErrGitRefNotFound
+	case strings.Contains(stderr, "Could not resolve host"),
+		strings.Contains(stderr, "Connection refused"),
+		strings.Contains(stderr, "Connection timed out"),
+		strings.Contains(stderr, "Network is unreachable"),
+		strings.Contains(stderr, "does not appear to be a git repository"),
+		strings.Contains(stderr, "Could not read from remote repository"):
+		return ErrGitNetwork
+	}
+	return nil
+}
+
+// gitLsRemote returns the branches and tags of the remote without cloning it
+func gitLsRemote(ctx p.Context, creds gitCredentials, url string) ([]gitRef, error) {
+	auth, err := creds.auth(url)
+	if err != nil {
+		return nil, gitError("ls-remote", url, err)
+	}
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{url},
+	})
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: auth, PeelingOption: git.AppendPeeled})
+	if err != nil {
+		return nil, gitError("ls-remote", url, err)
+	}
+	return newGitRefs(refs, "refs/heads/"), nil
+}
@@ -1,18 +1,31 @@
 package installers
 
 import (
+	"fmt"
+
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
 type GitHubBaseInputs struct {
 	BaseInputs
 	InstallCommands *[]string `pulumi:"installCommands,optional"`
-	Org             string    `pulumi:"org"`
-	Repo            string    `pulumi:"repo"`
+	InstallSteps    *[]Step   `pulumi:"installSteps,optional"`
 }
 
 func (g *GitHubBaseInputs) Annotate(a infer.Annotator) {
-	a.Describe(&g.InstallCommands, "The commands to run to install the program")
-	a.Describe(&g.Org, "The GitHub organization the repo belongs to")
-	a.Describe(&g.Repo, "The GitHub repository name")
+	a.Describe(&g.InstallCommands, "The commands to run to install the program. Each command is run as a separate step")
+	a.Describe(&g.InstallSteps, "The steps to run to install the program. These are run after installCommands")
+}
+
+// requireOrgAndRepo returns a failure for org and repo if they are missing
+func requireOrgAndRepo(inputs resource.PropertyMap) []p.CheckFailure {
+	var failures []p.CheckFailure
+	for _, k := range []resource.PropertyKey{"org", "repo"} {
+		if v, ok := inputs[k]; !ok || v.IsNull() {
+			failures = append(failures, p.CheckFailure{Property: string(k), Reason: fmt.Sprintf("%s is required", k)})
+		}
+	}
+	return failures
 }
@@ -6,8 +6,8 @@ import (
 	"os"
 	"path"
 	"regexp"
-	"runtime"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v55/github"
 	p "github.com/pulumi/pulumi-go-provider"
@@ -20,18 +20,25 @@ type GitHubRelease struct{}
 
 type GitHubReleaseArgs struct {
 	GitHubBaseInputs
-	AssetName      *string `pulumi:"assetName,optional"`
-	Executable     *string `pulumi:"executable,optional"`
-	ReleaseVersion *string `pulumi:"releaseVersion,optional"`
-	BinLocation    *string `pulumi:"binLocation,optional"`
-	BinFolder      *string `pulumi:"binFolder,optional"`
+	Org            string    `pulumi:"org"`
+	Repo           string    `pulumi:"repo"`
+	AssetName      *string   `pulumi:"assetName,optional"`
+	Executable     *string   `pulumi:"executable,optional"`
+	ReleaseVersion *string   `pulumi:"releaseVersion,optional"`
+	BinLocation    *string   `pulumi:"binLocation,optional"`
+	BinFolder      *string   `pulumi:"binFolder,optional"`
+	Platform       *string   `pulumi:"platform,optional"`
+	TrackChanges   *bool     `pulumi:"trackChanges,optional"`
+	TrackPaths     *[]string `pulumi:"trackPaths,optional"`
 }
 
 type GitHubReleaseState struct {
 	GitHubReleaseArgs
 	CommandOutputs
-	DownloadURL *string   `pulumi:"downloadURL"`
-	Locations   *[]string `pulumi:"locations,optional"`
+	DownloadURL  *string   `pulumi:"downloadURL"`
+	Locations    *[]string `pulumi:"locations,optional"`
+	Plan         *Plan     `pulumi:"plan,optional"`
+	CreatedPaths *[]string `pulumi:"createdPaths,optional"`
 }
 
 func (l *GitHubRelease) Annotate(a infer.Annotator) {
@@ -39,6 +46,8 @@ func (l *GitHubRelease) Annotate(a infer.Annotator) {
 }
 
 func (l *GitHubReleaseArgs) Annotate(a infer.Annotator) {
+	a.Describe(&l.Org, "The GitHub organization the repo belongs to")
+	a.Describe(&l.Repo, "The GitHub repository name")
 	a.Describe(&l.AssetName, `The name of the release asset to install. If this is not provided then
 				the resource will try and find the correct asset name to install. Supports regex`)
 	a.Describe(&l.Executable, "The name of the executable to create a symlink for. If not provided then the executable name will be the same as the repo name")
@@ -48,65 +57,64 @@ func (l *GitHubReleaseArgs) Annotate(a infer.Annotator) {
 	a.Describe(&l.BinFolder, `Sometimes release assets contain a folder containing
 				program binaries which can just be copied. If that is the case, then provide the
 				location here. This will copy all files in the directory to the bin_location`)
+	a.Describe(&l.Platform, `The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+				used to find the release asset and executables that are built for another platform are
+				refused. Defaults to the platform of the host`)
+	a.Describe(&l.TrackChanges, `Whether to record every path that the install creates in binLocation, $HOME/.local/share
+				and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+				commands while a tracked install runs`)
+	a.Describe(&l.TrackPaths, `The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+				set, e.g. ~/.local/share/tool. binLocation is always watched`)
 }
 
 func (l *GitHubReleaseState) Annotate(a infer.Annotator) {
 	a.Describe(&l.DownloadURL, "The URL of the GitHub release asset")
 	a.Describe(&l.Locations, "The locations the program was installed to")
+	a.Describe(&l.Plan, "What the last create or update did. During preview this is what it will do")
+	a.Describe(&l.CreatedPaths, "The paths that were created by the install when trackChanges is set")
 }
 
 var _ = (infer.CustomUpdate[GitHubReleaseArgs, GitHubReleaseState])((*GitHubRelease)(nil))
 var _ = (infer.CustomDiff[GitHubReleaseArgs, GitHubReleaseState])((*GitHubRelease)(nil))
 var _ = (infer.CustomDelete[GitHubReleaseState])((*GitHubRelease)(nil))
 var _ = (infer.CustomCheck[GitHubReleaseArgs])((*GitHubRelease)(nil))
+var _ = (infer.ExplicitDependencies[GitHubReleaseArgs, GitHubReleaseState])((*GitHubRelease)(nil))
+
+// WireDependencies keeps the plan known during preview so that it can be
+// reviewed before it is applied
+func (l *GitHubRelease) WireDependencies(f infer.FieldSelector, args *GitHubReleaseArgs, state *GitHubReleaseState) {
+	f.OutputField(&state.Plan).AlwaysKnown()
+}
 
 func (l *GitHubRelease) Diff(ctx p.Context, id string, olds GitHubReleaseState, news GitHubReleaseArgs) (p.DiffResponse, error) {
 	diff := map[string]p.PropertyDiff{}
 
-	var newInstall string
-	var oldInstall string
-	if news.InstallCommands != nil {
-		newInstall = strings.Join(*news.InstallCommands, " && ")
-	}
-	if olds.InstallCommands != nil {
-		oldInstall = strings.Join(*olds.InstallCommands, " && ")
-	}
-
-	if newInstall != oldInstall {
+	if !commandsEqual(news.InstallCommands, olds.InstallCommands) {
 		diff["installCommands"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-	var newUninstall string
-	var oldUninstall string
-	if news.UninstallCommands != nil {
-		newUninstall = strings.Join(*news.UninstallCommands, " && ")
-	}
-	if olds.UninstallCommands != nil {
-		oldUninstall = strings.Join(*olds.UninstallCommands, " && ")
-	}
-	if newUninstall != oldUninstall {
-		diff["uninstallCommands"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	if !stepsEqual(news.InstallSteps, olds.InstallSteps) {
+		diff["installSteps"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
 
-	var newUpdate string
-	var oldUpdate string
-	if news.UpdateCommands != nil {
-		newUpdate = strings.Join(*news.UpdateCommands, " && ")
-	}
-	if olds.UpdateCommands != nil {
-		oldUpdate = strings.Join(*olds.UpdateCommands, " && ")
+	news.BaseInputs.diff(olds.BaseInputs, diff)
+	if !ptrEqual(news.TrackChanges, olds.TrackChanges) {
+		diff["trackChanges"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-	if newUpdate != oldUpdate {
-		diff["updateCommands"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	if !commandsEqual(news.TrackPaths, olds.TrackPaths) {
+		diff["trackPaths"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
 
 	pdiff := p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
-	if newUpdate != "" {
+	if _, ok := news.updateSteps(); ok {
 		pdiff = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
 
 	if *news.AssetName != *news.AssetName {
 		diff["assetName"] = pdiff
 	}
+	if !ptrEqual(news.Platform, olds.Platform) {
+		diff["platform"] = pdiff
+	}
 
 	if news.Org != olds.Org {
 		diff["org"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
@@ -130,6 +138,7 @@ func (l *GitHubRelease) Diff(ctx p.Context, id string, olds GitHubReleaseState,
 
 // All resources must implement Create at a minumum.
 func (l *GitHubRelease) Create(ctx p.Context, name string, input GitHubReleaseArgs, preview bool) (string, GitHubReleaseState, error) {
+	ctx = withBinDirs(ctx, input.BinLocation)
 	state := &GitHubReleaseState{
 		GitHubReleaseArgs: input,
 	}
@@ -148,32 +157,45 @@ func (l *GitHubRelease) Create(ctx p.Context, name string, input GitHubReleaseAr
 		return "", GitHubReleaseState{}, errors.New("assetName not defined, something went wrong!")
 	}
 
+	steps := newSteps(input.InstallCommands, input.InstallSteps)
+	state.setPlan(steps, &input)
 	if preview {
 		return name, *state, nil
 	}
 
-	commands := []string{}
-	if input.InstallCommands != nil {
-		commands = append(commands, *input.InstallCommands...)
+	ctx, t, err := input.track(ctx)
+	if err != nil {
+		return "", GitHubReleaseState{}, err
 	}
-	if err := state.createOrUpdate(ctx, commands, &input); err != nil {
+	if err := state.createOrUpdate(ctx, steps, &input); err != nil {
+		return "", GitHubReleaseState{}, errors.Join(err, t.cleanup(ctx, &state.CommandOutputs, input.BaseInputs))
+	}
+	env := hookEnv(input.hookLocation(), input.ReleaseVersion)
+	if err := state.verify(ctx, input.BaseInputs, input.Verify, env, os.TempDir()); err != nil {
+		// don't leave a broken program behind
+		err = errors.Join(err, state.removeLocations(ctx))
+		return "", GitHubReleaseState{}, errors.Join(err, t.cleanup(ctx, &state.CommandOutputs, input.BaseInputs))
+	}
+	if err := t.record(&state.CreatedPaths); err != nil {
+		return "", GitHubReleaseState{}, err
+	}
+	if err := state.runHook(ctx, input.BaseInputs, "postInstall", input.PostInstall, env, os.TempDir()); err != nil {
 		return "", GitHubReleaseState{}, err
 	}
 
 	return name, *state, nil
 }
 
-func (o *GitHubReleaseState) createOrUpdate(ctx p.Context, commands []string, input *GitHubReleaseArgs) error {
-	if o.DownloadURL == nil {
-		return errors.New("Couldn't find a GitHub release to use")
-	}
-
+// shellArgs returns the Shell inputs and steps that install the release
+// asset
+func (o *GitHubReleaseState) shellArgs(steps []Step, input *GitHubReleaseArgs) (ShellArgs, []Step) {
 	ext := path.Ext(*o.AssetName)
+	extract := "extract"
 	switch ext {
 	case ".gz":
-		commands = append(commands, fmt.Sprintf("tar -xzvf %s", *o.AssetName))
+		steps = append(steps, Step{Name: &extract, Command: fmt.Sprintf("tar -xzvf %s", *o.AssetName)})
 	case ".zip":
-		commands = append(commands, fmt.Sprintf("unzip -o %s", *o.AssetName))
+		steps = append(steps, Step{Name: &extract, Command: fmt.Sprintf("unzip -o %s", *o.AssetName)})
 	}
 
 	exName := input.Repo
@@ -185,35 +207,69 @@ func (o *GitHubReleaseState) createOrUpdate(ctx p.Context, commands []string, in
 		exName = parts[len(parts)-1]
 		ex = true
 	}
-	shellInputs := &ShellArgs{
-		BaseInputs:      input.BaseInputs,
-		BinLocation:     input.BinLocation,
-		InstallCommands: *input.InstallCommands,
-		ProgramName:     exName,
-		DownloadURL:     *o.DownloadURL,
-		Executable:      &ex,
+	shellInputs := ShellArgs{
+		BaseInputs:     input.BaseInputs,
+		BinLocation:    input.BinLocation,
+		ProgramName:    exName,
+		DownloadURL:    *o.DownloadURL,
+		DesiredVersion: input.ReleaseVersion,
+		Platform:       input.Platform,
+		Executable:     &ex,
 	}
+	return shellInputs, steps
+}
 
-	shellOutputs := &ShellState{
-		ShellArgs: *shellInputs,
+// copyBinFolderStep returns the step that copies the binFolder to binLocation
+func copyBinFolderStep(input *GitHubReleaseArgs) Step {
+	copyBinFolder := "copy binFolder"
+	return Step{
+		Name:    &copyBinFolder,
+		Command: fmt.Sprintf("cp -r %s/* %s", *input.BinFolder, *input.BinLocation),
 	}
-	locations := []string{}
+}
+
+// setPlan sets the plan for createOrUpdate. There is nothing to plan if the
+// release asset could not be found
+func (o *GitHubReleaseState) setPlan(steps []Step, input *GitHubReleaseArgs) {
+	if o.DownloadURL == nil || *o.DownloadURL == "" {
+		o.Plan = nil
+		return
+	}
+	shellInputs, steps := o.shellArgs(steps, input)
 	if input.BinFolder != nil {
-		commands = append(commands, fmt.Sprintf("cp -r %s/* %s", *input.BinFolder, *input.BinLocation))
+		steps = append(steps, copyBinFolderStep(input))
 	}
-	if err := shellOutputs.createOrUpdate(ctx, *shellInputs, commands); err != nil {
+	shellOutputs := &ShellState{ShellArgs: shellInputs}
+	o.Plan = shellOutputs.plan(shellInputs, steps, shellOutputs.commandDir())
+	o.Plan.Asset = input.AssetName
+	if input.BinFolder != nil {
+		o.Plan.Writes = append(o.Plan.Writes, path.Join(*input.BinLocation, "*"))
+	}
+}
+
+func (o *GitHubReleaseState) createOrUpdate(ctx p.Context, steps []Step, input *GitHubReleaseArgs) error {
+	if o.DownloadURL == nil {
+		return errors.New("Couldn't find a GitHub release to use")
+	}
+
+	shellInputs, steps := o.shellArgs(steps, input)
+	shellOutputs := &ShellState{
+		ShellArgs: shellInputs,
+	}
+	locations := []string{}
+	err := shellOutputs.createOrUpdate(ctx, shellInputs, steps)
+	o.CommandOutputs = shellOutputs.CommandOutputs
+	if err != nil {
 		return err
 	}
 
 	if input.BinFolder != nil {
-		ls, err := shellOutputs.run(ctx, fmt.Sprintf("ls -l -1 %s", *input.BinFolder), os.TempDir())
+		copied, err := o.copyBinFolder(ctx, shellOutputs, input)
+		o.CommandOutputs = shellOutputs.CommandOutputs
 		if err != nil {
 			return err
 		}
-		for _, l := range strings.Split(ls, "\n") {
-			locations = append(locations, path.Join(*input.BinLocation, l))
-		}
-
+		locations = append(locations, copied...)
 	}
 	if shellOutputs.Location != nil {
 		locations = append(locations, *shellOutputs.Location)
@@ -223,6 +279,53 @@ func (o *GitHubReleaseState) createOrUpdate(ctx p.Context, commands []string, in
 	return nil
 }
 
+// copyBinFolder copies the extracted binFolder to binLocation and returns the
+// copied paths. Every binary is checked before anything is copied so that an
+// asset for the wrong platform doesn't overwrite the installed programs
+func (o *GitHubReleaseState) copyBinFolder(ctx p.Context, s *ShellState, input *GitHubReleaseArgs) ([]string, error) {
+	dir := s.commandDir()
+	ls, err := s.run(ctx, input.BaseInputs, fmt.Sprintf("ls -1 %s", *input.BinFolder), dir)
+	if err != nil {
+		return nil, err
+	}
+	folder := *input.BinFolder
+	if !path.IsAbs(folder) {
+		folder = path.Join(dir, folder)
+	}
+	var names []string
+	for _, l := range strings.Split(ls, "\n") {
+		if l == "" {
+			continue
+		}
+		if err := checkPlatform(path.Join(folder, l), input.Platform); err != nil {
+			return nil, fmt.Errorf("asset %s: %w", *input.AssetName, err)
+		}
+		names = append(names, l)
+	}
+
+	step := copyBinFolderStep(input)
+	start := time.Now()
+	_, _, err = s.execWith(ctx, input.BaseInputs, s.args(step.Command), nil, step.Command, dir)
+	results := []StepResult{}
+	if s.Steps != nil {
+		results = *s.Steps
+	}
+	results = append(results, StepResult{
+		Name:     step.name(),
+		ExitCode: exitCode(err),
+		Duration: time.Since(start).Round(time.Millisecond).String(),
+	})
+	s.Steps = &results
+	if err != nil {
+		return nil, fmt.Errorf("step %d (%s) failed: %w", len(results), step.name(), err)
+	}
+	locations := []string{}
+	for _, n := range names {
+		locations = append(locations, path.Join(*input.BinLocation, n))
+	}
+	return locations, nil
+}
+
 func getReleaseDownloadURL(ctx p.Context, client *github.Client, org, repo, tag, assetName string) (string, error) {
 	release, _, err := client.Repositories.GetReleaseByTag(ctx, org, repo, tag)
 	if err != nil {
@@ -244,6 +347,7 @@ func getReleaseAssetName(
 	repo,
 	tag,
 	assetName string,
+	platform *string,
 ) (string, error) {
 	release, _, err := client.Repositories.GetReleaseByTag(ctx, org, repo, tag)
 	if err != nil {
@@ -253,8 +357,10 @@ func getReleaseAssetName(
 	// darwin/arm64
 	// linux/amd64
 	// linux/arm64
-	oss := runtime.GOOS
-	arch := runtime.GOARCH
+	oss, arch, err := parsePlatform(platform)
+	if err != nil {
+		return "", err
+	}
 	// loop over the release assets and try to find the correct one based on
 	// the runtime and arch
 	for _, ra := range release.Assets {
@@ -280,6 +386,7 @@ func getReleaseAssetName(
 
 func (l *GitHubRelease) Read(ctx p.Context, id string, inputs GitHubReleaseArgs, state GitHubReleaseState) (
 	canonicalID string, normalizedInputs GitHubReleaseArgs, normalizedState GitHubReleaseState, err error) {
+	ctx = withBinDirs(ctx, inputs.BinLocation)
 
 	if inputs.ReleaseVersion != nil {
 		return id, inputs, state, nil
@@ -295,7 +402,7 @@ func (l *GitHubRelease) Read(ctx p.Context, id string, inputs GitHubReleaseArgs,
 	}
 	inputs.ReleaseVersion = release.TagName
 
-	assetName, err := getReleaseAssetName(ctx, client, inputs.Org, inputs.Repo, *state.ReleaseVersion, *inputs.AssetName)
+	assetName, err := getReleaseAssetName(ctx, client, inputs.Org, inputs.Repo, *state.ReleaseVersion, *inputs.AssetName, inputs.Platform)
 	inputs.AssetName = &assetName
 
 	return id, inputs, state, nil
@@ -306,19 +413,29 @@ func (l *GitHubRelease) Check(ctx p.Context, name string, oldInputs, newInputs r
 	if val, ok := os.LookupEnv("GITHUB_TOKEN"); ok {
 		client.WithAuthToken(val)
 	}
-	failures := []p.CheckFailure{}
+	failures := append(requireOrgAndRepo(newInputs), checkBase(ctx, newInputs)...)
+	if len(failures) > 0 {
+		return GitHubReleaseArgs{}, failures, nil
+	}
 
 	// then this is a create operation
 	assetName := newInputs["assetName"].StringValue()
 	releaseVersion := newInputs["releaseVersion"].StringValue()
+	var platform *string
+	if v, ok := newInputs["platform"]; ok && v.IsString() {
+		ps := v.StringValue()
+		if _, _, err := parsePlatform(&ps); err != nil {
+			return GitHubReleaseArgs{}, []p.CheckFailure{{Property: "platform", Reason: err.Error()}}, nil
+		}
+		platform = &ps
+	}
 	if _, ok := oldInputs["org"]; !ok {
 		_, inputs, _, err := l.Read(ctx, name, GitHubReleaseArgs{
-			GitHubBaseInputs: GitHubBaseInputs{
-				Org:  newInputs["org"].StringValue(),
-				Repo: newInputs["repo"].StringValue(),
-			},
+			Org:            newInputs["org"].StringValue(),
+			Repo:           newInputs["repo"].StringValue(),
 			AssetName:      &assetName,
 			ReleaseVersion: &releaseVersion,
+			Platform:       platform,
 		}, GitHubReleaseState{})
 		if err != nil {
 			return GitHubReleaseArgs{}, nil, err
@@ -338,14 +455,17 @@ func (l *GitHubRelease) Check(ctx p.Context, name string, oldInputs, newInputs r
 		}
 	}
 	inputs, fails, err := infer.DefaultCheck[GitHubReleaseArgs](newInputs)
+	addBinDirs(inputs.BinLocation)
 	return inputs, append(failures, fails...), err
 }
 
 func (l *GitHubRelease) Update(ctx p.Context, name string, olds GitHubReleaseState, news GitHubReleaseArgs, preview bool) (GitHubReleaseState, error) {
+	ctx = withBinDirs(ctx, news.BinLocation)
 	state := &GitHubReleaseState{
 		GitHubReleaseArgs: news,
 		DownloadURL:       olds.DownloadURL,
 		Locations:         olds.Locations,
+		CreatedPaths:      olds.CreatedPaths,
 	}
 
 	if news.AssetName == nil {
@@ -361,17 +481,44 @@ func (l *GitHubRelease) Update(ctx p.Context, name string, olds GitHubReleaseSta
 	}
 	state.DownloadURL = &downloadUrl
 
+	steps, ok := news.updateSteps()
+	if !ok {
+		steps = newSteps(news.InstallCommands, news.InstallSteps)
+	}
+	state.setPlan(steps, &news)
 	if preview {
 		return *state, nil
 	}
 
-	var commands []string
-	if news.UpdateCommands != nil {
-		commands = *news.UninstallCommands
-	} else if news.InstallCommands != nil {
-		commands = *news.InstallCommands
+	// the installed files are moved aside so that they can be put back if
+	// the new release is broken
+	bk := &backup{}
+	if news.Verify != nil && olds.Locations != nil {
+		bk, err = state.backupFiles(ctx, news.BaseInputs, *olds.Locations)
+		if err != nil {
+			return GitHubReleaseState{}, err
+		}
 	}
-	if err := state.createOrUpdate(ctx, commands, &news); err != nil {
+	ctx, t, err := news.track(ctx)
+	if err != nil {
+		return GitHubReleaseState{}, errors.Join(err, state.restore(ctx, bk))
+	}
+	env := hookEnv(news.hookLocation(), news.ReleaseVersion)
+	err = state.createOrUpdate(ctx, steps, &news)
+	if err == nil {
+		err = state.verify(ctx, news.BaseInputs, news.Verify, env, os.TempDir())
+	}
+	if err != nil {
+		err = errors.Join(err, t.cleanup(ctx, &state.CommandOutputs, news.BaseInputs))
+		return GitHubReleaseState{}, errors.Join(err, state.restore(ctx, bk))
+	}
+	if err := t.record(&state.CreatedPaths); err != nil {
+		return GitHubReleaseState{}, err
+	}
+	if err := state.discard(ctx, bk); err != nil {
+		return GitHubReleaseState{}, err
+	}
+	if err := state.runHook(ctx, news.BaseInputs, "postInstall", news.PostInstall, env, os.TempDir()); err != nil {
 		return GitHubReleaseState{}, err
 	}
 
@@ -379,17 +526,51 @@ func (l *GitHubRelease) Update(ctx p.Context, name string, olds GitHubReleaseSta
 }
 
 func (l *GitHubRelease) Delete(ctx p.Context, id string, props GitHubReleaseState) error {
-	if props.UninstallCommands != nil {
-		_, err := props.run(ctx, strings.Join(*props.UninstallCommands, " && "), "")
-		if err != nil {
-			return err
-		}
+	ctx = withBinDirs(ctx, props.BinLocation)
+	env := hookEnv(props.hookLocation(), props.ReleaseVersion)
+	if err := props.runHook(ctx, props.BaseInputs, "preUninstall", props.PreUninstall, env, ""); err != nil {
+		return err
+	}
+	if err := props.runSteps(ctx, props.BaseInputs, props.uninstallSteps(), ""); err != nil {
+		return err
+	}
+	if err := props.removeLocations(ctx); err != nil {
+		return err
+	}
+	if err := props.removeCreated(ctx, props.BaseInputs, props.CreatedPaths); err != nil {
+		return err
+	}
+	return props.runHook(ctx, props.BaseInputs, "postUninstall", props.PostUninstall, env, "")
+}
 
+// removeLocations removes the files that were installed
+func (o *GitHubReleaseState) removeLocations(ctx p.Context) error {
+	if o.Locations == nil {
+		return nil
 	}
-	for _, l := range *props.Locations {
-		if err := os.Remove(l); err != nil && !os.IsNotExist(err) {
+	for _, l := range *o.Locations {
+		if err := o.removeFile(ctx, o.BaseInputs, l); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// track starts tracking the paths that the install creates if trackChanges
+// is set
+func (l *GitHubReleaseArgs) track(ctx p.Context) (p.Context, *tracker, error) {
+	if l.TrackChanges == nil || !*l.TrackChanges {
+		return ctx, nil, nil
+	}
+	return startTracking(ctx, l.BinLocation, l.TrackPaths)
+}
+
+// hookLocation returns the PDE_LOCATION for the lifecycle hooks. This matches
+// the location used by the install hooks, see ShellState.hookEnv
+func (l *GitHubReleaseArgs) hookLocation() *string {
+	if l.BinLocation == nil || l.Executable == nil {
+		return l.BinLocation
+	}
+	location := path.Join(*l.BinLocation, path.Base(*l.Executable))
+	return &location
+}
@@ -1,27 +1,23 @@
 package installers
 
 import (
-	// "errors"
-
+	"errors"
 	"fmt"
 	"os"
 	"path"
+	"slices"
 	"strings"
 
+	"github.com/corymhall/pulumi-provider-pde/provider/pkg/provider/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	p "github.com/pulumi/pulumi-go-provider"
 
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
-func versionCommand(branch string) string {
-	return fmt.Sprintf("git log origin/%s --pretty=format:\"%%H\" -1", branch)
-}
-
-const (
-	fetchCmd = "git fetch --all"
-)
-
 type GitHubRepo struct{}
 
 var _ = (infer.CustomUpdate[GitHubRepoArgs, GitHubRepoState])((*GitHubRepo)(nil))
@@ -31,15 +27,35 @@ var _ = (infer.CustomCheck[GitHubRepoArgs])((*GitHubRepo)(nil))
 
 type GitHubRepoArgs struct {
 	GitHubBaseInputs
-	FolderName *string `pulumi:"folderName,optional"`
-	Branch     *string `pulumi:"branch,optional"`
-	Version    *string `pulumi:"version,optional"`
+	Org            string                `pulumi:"org,optional"`
+	Repo           string                `pulumi:"repo,optional"`
+	URL            *string               `pulumi:"url,optional"`
+	SSHKey         *string               `pulumi:"sshKey,optional"`
+	SSHKeyPassword *string               `pulumi:"sshKeyPassword,optional" provider:"secret"`
+	FolderName     *string               `pulumi:"folderName,optional"`
+	Branch         *string               `pulumi:"branch,optional"`
+	Ref            *string               `pulumi:"ref,optional"`
+	Version        *string               `pulumi:"version,optional"`
+	Tag            *string               `pulumi:"tag,optional"`
+	Depth          *int                  `pulumi:"depth,optional"`
+	Filter         *string               `pulumi:"filter,optional"`
+	SparsePaths    *[]string             `pulumi:"sparsePaths,optional"`
+	Submodules     *string               `pulumi:"submodules,optional"`
+	LFS            *bool                 `pulumi:"lfs,optional"`
+	OnLocalChanges *string               `pulumi:"onLocalChanges,optional"`
+	RetainOnDelete *bool                 `pulumi:"retainOnDelete,optional"`
+	Remotes        *map[string]GitRemote `pulumi:"remotes,optional"`
+	TrackRemote    *string               `pulumi:"trackRemote,optional"`
+	Executables    *[]string             `pulumi:"executables,optional"`
+	BinLocation    *string               `pulumi:"binLocation,optional"`
 }
 
 type GitHubRepoState struct {
 	CommandOutputs
 	GitHubRepoArgs
-	AbsFolderName *string `pulumi:"absFolderName"`
+	AbsFolderName *string       `pulumi:"absFolderName,optional"`
+	LocalChanges  *LocalChanges `pulumi:"localChanges,optional"`
+	Locations     *[]string     `pulumi:"locations,optional"`
 }
 
 func (l *GitHubRepo) Annotate(a infer.Annotator) {
@@ -47,12 +63,54 @@ func (l *GitHubRepo) Annotate(a infer.Annotator) {
 }
 
 func (l *GitHubRepoArgs) Annotate(a infer.Annotator) {
+	a.Describe(&l.Org, "The GitHub organization the repo belongs to. Not needed if url is set")
+	a.Describe(&l.Repo, "The GitHub repository name. Not needed if url is set")
+	a.Describe(&l.URL, `The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO`)
+	a.Describe(&l.SSHKey, `The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+				is used. The host key of the remote must be in known_hosts`)
+	a.Describe(&l.SSHKeyPassword, "The password of sshKey if it is encrypted")
 	a.Describe(&l.Branch, "The branch to clone from. Default to main")
-	a.Describe(&l.FolderName, "The folder to clone the repo to. By default this is will be $HOME/$REPO_NAME")
+	a.Describe(&l.Ref, `The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+				develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+				the highest tag that matches. Prerelease tags are never matched`)
+	a.Describe(&l.Version, `The commit to check out. If this is not set then it is the commit that ref or the head of
+				branch resolves to`)
+	a.Describe(&l.Tag, "The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint")
+	a.Describe(&l.FolderName, `The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+				otherwise $HOME/$REPO`)
+	a.Describe(&l.Depth, `Only fetch this many commits of history. Updates fetch more history if the new version
+				is not in it`)
+	a.Describe(&l.Filter, `Make a partial clone that fetches files as they are needed, either blob:none to leave out
+				file contents or tree:0 to also leave out directories. This needs the git CLI`)
+	a.Describe(&l.SparsePaths, `Only check out these directories of the repo, along with the files at its root.
+				This needs the git CLI`)
+	a.Describe(&l.Submodules, `How to check out submodules whenever the version changes. One of none, shallow to check
+				out only the commit each submodule points to, or recursive to also check out their submodules.
+				Defaults to none. This needs the git CLI`)
+	a.Describe(&l.LFS, "Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs")
+	a.Describe(&l.OnLocalChanges, `What to do when an update or delete would lose changed files, unpushed commits or
+				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+				Defaults to fail`)
+	a.Describe(&l.RetainOnDelete, `Leave the clone on disk when the resource is deleted. The uninstall commands
+				are still run`)
+	a.Describe(&l.Remotes, `More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+				removed from the clone, remotes that were added by hand are left alone`)
+	a.Describe(&l.TrackRemote, `The remote whose branches and tags define version, either origin or one of remotes.
+				Defaults to origin`)
+	a.Describe(&l.Executables, `The programs that the install commands build, relative to the clone, e.g.
+				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+				can't run on the host, e.g. from a cross-compile, are refused before anything is linked`)
+	a.Describe(&l.BinLocation, "The location to link executables into. Defaults to $HOME/.local/bin")
 }
 
 func (l *GitHubRepoState) Annotate(a infer.Annotator) {
 	a.Describe(&l.AbsFolderName, "The absolute path to the folder the repo was cloned to")
+	a.Describe(&l.LocalChanges, "The work in the clone that is not on the remote, as of the last refresh")
+	a.Describe(&l.Locations, "The links to executables that were created in binLocation")
 }
 
 func (l *GitHubRepo) Diff(ctx p.Context, id string, olds GitHubRepoState, news GitHubRepoArgs) (p.DiffResponse, error) {
@@ -61,44 +119,82 @@ func (l *GitHubRepo) Diff(ctx p.Context, id string, olds GitHubRepoState, news G
 	if news.Branch == nil || *news.Branch != *olds.Branch {
 		diff["branch"] = p.PropertyDiff{Kind: p.Update}
 	}
-	if news.FolderName == nil || *news.FolderName != *olds.FolderName {
-		diff["folderName"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	if !ptrEqual(news.Ref, olds.Ref) {
+		diff["ref"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-	var newInstall string
-	var oldInstall string
-	if news.InstallCommands != nil {
-		newInstall = strings.Join(*news.InstallCommands, " && ")
+	if !ptrEqual(news.Tag, olds.Tag) {
+		diff["tag"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-	if olds.InstallCommands != nil {
-		oldInstall = strings.Join(*olds.InstallCommands, " && ")
+	if news.FolderName == nil || *news.FolderName != *olds.FolderName {
+		diff["folderName"] = p.PropertyDiff{Kind: p.UpdateReplace}
 	}
-
-	if newInstall != oldInstall {
+	if !commandsEqual(news.InstallCommands, olds.InstallCommands) {
 		diff["installCommands"] = p.PropertyDiff{Kind: p.Update}
 	}
+	if !stepsEqual(news.InstallSteps, olds.InstallSteps) {
+		diff["installSteps"] = p.PropertyDiff{Kind: p.Update}
+	}
 	if news.Org != olds.Org {
 		diff["org"] = p.PropertyDiff{Kind: p.UpdateReplace}
 	}
+	if !ptrEqual(news.URL, olds.URL) {
+		diff["url"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
+	}
+	if !ptrEqual(news.SSHKey, olds.SSHKey) {
+		diff["sshKey"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.SSHKeyPassword, olds.SSHKeyPassword) {
+		diff["sshKeyPassword"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.Depth, olds.Depth) {
+		diff["depth"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	// a clone can't be made partial, or complete, without fetching it again
+	if !ptrEqual(news.Filter, olds.Filter) {
+		diff["filter"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
+	}
+	if !commandsEqual(news.SparsePaths, olds.SparsePaths) {
+		diff["sparsePaths"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.Submodules, olds.Submodules) {
+		diff["submodules"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.LFS, olds.LFS) {
+		diff["lfs"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.OnLocalChanges, olds.OnLocalChanges) {
+		diff["onLocalChanges"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.RetainOnDelete, olds.RetainOnDelete) {
+		diff["retainOnDelete"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !remotesEqual(news.Remotes, olds.Remotes) {
+		diff["remotes"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.TrackRemote, olds.TrackRemote) {
+		diff["trackRemote"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !commandsEqual(news.Executables, olds.Executables) {
+		diff["executables"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.BinLocation, olds.BinLocation) {
+		diff["binLocation"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
 
 	if news.Repo != olds.Repo {
 		diff["repo"] = p.PropertyDiff{Kind: p.UpdateReplace}
 	}
 
-	var newUpdate string
-	var oldUpdate string
-	if news.UpdateCommands != nil {
-		newUpdate = strings.Join(*news.UpdateCommands, " && ")
-	}
-	if olds.UpdateCommands != nil {
-		oldUpdate = strings.Join(*olds.UpdateCommands, " && ")
-	}
-	if newUpdate != oldUpdate {
-		diff["updateCommands"] = p.PropertyDiff{Kind: p.Update}
-	}
+	news.BaseInputs.diff(olds.BaseInputs, diff)
 
 	if news.Version == nil || *news.Version != *olds.Version {
 		diff["version"] = p.PropertyDiff{Kind: p.Update}
 	}
+	// Read clears this if the clone was removed outside of pulumi, in
+	// which case it needs to be cloned again
+	if olds.AbsFolderName == nil {
+		diff["absFolderName"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
 
 	return p.DiffResponse{
 		DeleteBeforeReplace: true,
@@ -109,6 +205,7 @@ func (l *GitHubRepo) Diff(ctx p.Context, id string, olds GitHubRepoState, news G
 
 // All resources must implement Create at a minumum.
 func (l *GitHubRepo) Create(ctx p.Context, name string, input GitHubRepoArgs, preview bool) (string, GitHubRepoState, error) {
+	ctx = withBinDirs(ctx, input.BinLocation)
 	state := &GitHubRepoState{
 		GitHubRepoArgs: input,
 	}
@@ -120,21 +217,43 @@ func (l *GitHubRepo) Create(ctx p.Context, name string, input GitHubRepoArgs, pr
 		return name, *state, nil
 	}
 
+	env := hookEnv(state.AbsFolderName, input.Version)
+	if err := state.runHook(ctx, input.BaseInputs, "preInstall", input.PreInstall, env, path.Dir(*state.AbsFolderName)); err != nil {
+		return "", GitHubRepoState{}, err
+	}
 	if err := state.clone(ctx, input); err != nil {
 		return "", GitHubRepoState{}, err
 	}
+	if input.Remotes != nil {
+		if err := gitSetRemotes(*state.AbsFolderName, *input.Remotes); err != nil {
+			return "", GitHubRepoState{}, err
+		}
+		// the version may only be on one of the other remotes
+		if err := input.gitOptions().fetch(ctx, *state.AbsFolderName); err != nil {
+			return "", GitHubRepoState{}, err
+		}
+	}
 
 	// Checkout version (commit)
-	_, err := state.run(ctx, fmt.Sprintf("git checkout %s", *input.Version), *state.AbsFolderName)
-	if err != nil {
+	if err := input.gitOptions().checkout(ctx, *state.AbsFolderName, *input.Version); err != nil {
 		return "", GitHubRepoState{}, err
 	}
 
-	if input.InstallCommands != nil {
-		_, err := state.run(ctx, strings.Join(*input.InstallCommands, " && "), *state.AbsFolderName)
-		if err != nil {
-			return "", GitHubRepoState{}, err
-		}
+	steps := newSteps(input.InstallCommands, input.InstallSteps)
+	if err := state.runSteps(ctx, input.BaseInputs, steps, *state.AbsFolderName); err != nil {
+		return "", GitHubRepoState{}, err
+	}
+	err := state.linkExecutables(ctx, input)
+	if err == nil {
+		err = state.verify(ctx, input.BaseInputs, input.Verify, env, *state.AbsFolderName)
+	}
+	if err != nil {
+		// don't leave a broken clone behind
+		err = errors.Join(err, state.removeLocations(ctx, nil))
+		return "", GitHubRepoState{}, errors.Join(err, os.RemoveAll(*state.AbsFolderName))
+	}
+	if err := state.runHook(ctx, input.BaseInputs, "postInstall", input.PostInstall, env, *state.AbsFolderName); err != nil {
+		return "", GitHubRepoState{}, err
 	}
 
 	return name, *state, nil
@@ -144,61 +263,149 @@ func (l *GitHubRepo) Check(ctx p.Context, name string, oldInputs, newInputs reso
 	if _, ok := newInputs["branch"]; !ok {
 		newInputs["branch"] = resource.NewStringProperty("main")
 	}
-	repo := newInputs["repo"].StringValue()
+	// org and repo are shorthand for a GitHub url so they are only needed
+	// if url isn't set. If it is set they default to the parts of its path
+	if url, ok := newInputs["url"]; ok && url.IsString() {
+		org, repo, err := splitRemoteURL(url.StringValue())
+		if err != nil {
+			return GitHubRepoArgs{}, []p.CheckFailure{{Property: "url", Reason: err.Error()}}, nil
+		}
+		if _, ok := newInputs["org"]; !ok {
+			newInputs["org"] = resource.NewStringProperty(org)
+		}
+		if _, ok := newInputs["repo"]; !ok {
+			newInputs["repo"] = resource.NewStringProperty(repo)
+		}
+	} else if !ok {
+		if failures := requireOrgAndRepo(newInputs); len(failures) > 0 {
+			return GitHubRepoArgs{}, failures, nil
+		}
+	}
+	if failures := checkRemotes(newInputs); len(failures) > 0 {
+		return GitHubRepoArgs{}, failures, nil
+	}
+	if failures := append(checkCloneOptions(newInputs), checkBase(ctx, newInputs)...); len(failures) > 0 {
+		return GitHubRepoArgs{}, failures, nil
+	}
 	if _, ok := newInputs["folderName"]; !ok {
-		newInputs["folderName"] = resource.NewStringProperty(repo)
-	}
-
-	// this is a create operation
-	if _, ok := oldInputs["org"]; !ok {
-		if _, ok := newInputs["version"]; !ok {
-			branch := newInputs["branch"].StringValue()
-			folderName := newInputs["folderName"].StringValue()
-			_, inputs, _, err := l.Read(ctx, name, GitHubRepoArgs{
-				FolderName: &folderName,
-				Branch:     &branch,
-				GitHubBaseInputs: GitHubBaseInputs{
-					Org:  newInputs["org"].StringValue(),
-					Repo: newInputs["repo"].StringValue(),
-				},
-			}, GitHubRepoState{})
-			if err != nil {
-				return GitHubRepoArgs{}, nil, err
-			}
-			newInputs["version"] = resource.NewStringProperty(*inputs.Version)
+		if folder, ok := defaultFolderName(ctx, newInputs); ok {
+			newInputs["folderName"] = resource.NewStringProperty(folder)
+		}
+	}
+
+	// the version is found when this is a create operation, when there is
+	// a ref which may now resolve to a newer tag or when another remote is
+	// tracked. Otherwise the branch only moves to a new commit on refresh
+	_, isUpdate := oldInputs["org"]
+	ref, hasRef := newInputs["ref"]
+	retrack := !newInputs["trackRemote"].DeepEquals(oldInputs["trackRemote"])
+	delete(newInputs, "tag")
+	if _, ok := newInputs["version"]; !ok && (!isUpdate || (hasRef && ref.IsString()) || retrack) {
+		args, _, err := infer.DefaultCheck[GitHubRepoArgs](newInputs)
+		if err != nil {
+			return GitHubRepoArgs{}, nil, err
+		}
+		_, inputs, _, err := l.Read(ctx, name, args, GitHubRepoState{})
+		if err != nil {
+			return GitHubRepoArgs{}, nil, err
+		}
+		newInputs["version"] = resource.NewStringProperty(*inputs.Version)
+		if inputs.Tag != nil {
+			newInputs["tag"] = resource.NewStringProperty(*inputs.Tag)
 		}
 	}
 	if _, ok := newInputs["version"]; !ok {
 		newInputs["version"] = oldInputs["version"]
 	}
-	return infer.DefaultCheck[GitHubRepoArgs](newInputs)
+	if _, ok := newInputs["executables"]; ok {
+		if _, ok := newInputs["binLocation"]; !ok {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return GitHubRepoArgs{}, []p.CheckFailure{{Property: "binLocation", Reason: err.Error()}}, nil
+			}
+			newInputs["binLocation"] = resource.NewStringProperty(path.Join(home, ".local", "bin"))
+		}
+	}
+	inputs, failures, err := infer.DefaultCheck[GitHubRepoArgs](newInputs)
+	addBinDirs(inputs.BinLocation)
+	return inputs, failures, err
 }
 
 func (l *GitHubRepo) Read(ctx p.Context, id string, inputs GitHubRepoArgs, state GitHubRepoState) (
 	canonicalID string, normalizedInputs GitHubRepoArgs, normalizedState GitHubRepoState, err error) {
+	ctx = withBinDirs(ctx, inputs.BinLocation)
 
-	_, err = state.run(ctx, fetchCmd, *state.AbsFolderName)
+	// nothing has been cloned yet, e.g. when Check finds the version, so the
+	// remote is asked for the latest commit instead
+	if state.AbsFolderName == nil {
+		if inputs.Version == nil {
+			refs, err := gitLsRemote(ctx, inputs.gitOptions().creds, inputs.trackURL())
+			if err != nil {
+				return "", GitHubRepoArgs{}, GitHubRepoState{}, err
+			}
+			if err := inputs.resolve(refs); err != nil {
+				return "", GitHubRepoArgs{}, GitHubRepoState{}, err
+			}
+		}
+		return id, inputs, state, nil
+	}
+
+	if _, err := os.Stat(*state.AbsFolderName); err != nil {
+		if !os.IsNotExist(err) {
+			return "", GitHubRepoArgs{}, GitHubRepoState{}, err
+		}
+		ctx.Logf(diag.Warning, "the clone at %s no longer exists", *state.AbsFolderName)
+		state.AbsFolderName = nil
+		return id, inputs, state, nil
+	}
+	opts := state.gitOptions()
+	if err := opts.fetch(ctx, *state.AbsFolderName); err != nil {
+		return "", GitHubRepoArgs{}, GitHubRepoState{}, err
+	}
+	if err := state.readDrift(ctx); err != nil {
+		return "", GitHubRepoArgs{}, GitHubRepoState{}, err
+	}
+	if state.Remotes != nil {
+		remotes, err := gitReadRemotes(*state.AbsFolderName, *state.Remotes)
+		if err != nil {
+			return "", GitHubRepoArgs{}, GitHubRepoState{}, err
+		}
+		if !remotesEqual(&remotes, state.Remotes) {
+			ctx.Logf(diag.Warning, "the remotes of %s were changed", *state.AbsFolderName)
+			state.Remotes = &remotes
+		}
+	}
+	state.LocalChanges, err = opts.localChanges(ctx, *state.AbsFolderName)
 	if err != nil {
 		return "", GitHubRepoArgs{}, GitHubRepoState{}, err
 	}
+	if state.LocalChanges != nil {
+		ctx.Logf(diag.Warning, "%s has local changes: %s", *state.AbsFolderName, state.LocalChanges)
+	}
 
 	if inputs.Version == nil {
-		versionCmd := fmt.Sprintf("git rev-parse origin/%s", *state.Branch)
-		version, err := state.run(ctx, versionCmd, *state.AbsFolderName)
+		repo, err := gitOpen(*state.AbsFolderName)
+		if err != nil {
+			return "", GitHubRepoArgs{}, GitHubRepoState{}, err
+		}
+		refs, err := localGitRefs(repo, inputs.trackRemote())
 		if err != nil {
 			return "", GitHubRepoArgs{}, GitHubRepoState{}, err
 		}
-		inputs.Version = &version
+		if err := inputs.resolve(refs); err != nil {
+			return "", GitHubRepoArgs{}, GitHubRepoState{}, err
+		}
 	}
 	return id, inputs, state, nil
-
 }
 
 func (l *GitHubRepo) Update(ctx p.Context, name string, olds GitHubRepoState, news GitHubRepoArgs, preview bool) (GitHubRepoState, error) {
+	ctx = withBinDirs(ctx, news.BinLocation)
 	state := &GitHubRepoState{
 		GitHubRepoArgs: news,
 		AbsFolderName:  olds.AbsFolderName,
 		CommandOutputs: olds.CommandOutputs,
+		Locations:      olds.Locations,
 	}
 
 	if preview {
@@ -209,73 +416,441 @@ func (l *GitHubRepo) Update(ctx p.Context, name string, olds GitHubRepoState, ne
 		return GitHubRepoState{}, err
 	}
 
-	_, err := state.run(ctx, fetchCmd, *state.AbsFolderName)
+	// everything below can change the files in the clone, e.g. a new
+	// sparsePaths or a submodule update, so local changes are dealt with first
+	opts := news.gitOptions()
+	discard, err := opts.protectLocalChanges(ctx, *state.AbsFolderName, "update", news.OnLocalChanges)
 	if err != nil {
 		return GitHubRepoState{}, err
 	}
-	// Checkout new version (commit)
-	_, err = state.run(ctx, fmt.Sprintf("git checkout %s", *news.Version), *state.AbsFolderName)
-	if err != nil {
+	opts.force = discard
+
+	env := hookEnv(state.AbsFolderName, news.Version)
+	if err := state.runHook(ctx, news.BaseInputs, "preInstall", news.PreInstall, env, *state.AbsFolderName); err != nil {
 		return GitHubRepoState{}, err
 	}
+	if !remotesEqual(news.Remotes, olds.Remotes) {
+		if err := gitSetRemotes(*state.AbsFolderName, news.remotes()); err != nil {
+			return GitHubRepoState{}, err
+		}
+	}
+	if err := opts.fetch(ctx, *state.AbsFolderName); err != nil {
+		return GitHubRepoState{}, err
+	}
+	if !commandsEqual(news.SparsePaths, olds.SparsePaths) {
+		if err := opts.sparseCheckout(ctx, *state.AbsFolderName); err != nil {
+			return GitHubRepoState{}, err
+		}
+	}
+	if !opts.hasSubmodules() && olds.gitOptions().hasSubmodules() {
+		if err := opts.deinitSubmodules(ctx, *state.AbsFolderName); err != nil {
+			return GitHubRepoState{}, err
+		}
+	}
+	// the branch only differs if Read found HEAD on another branch
+	if ptrEqual(news.Version, olds.Version) && ptrEqual(news.Branch, olds.Branch) {
+		if err := opts.updateWorktree(ctx, news.remoteURL(), *state.AbsFolderName); err != nil {
+			return GitHubRepoState{}, err
+		}
+	} else {
+		// Checkout new version (commit). A shallow clone is deepened if it
+		// doesn't have it
+		if err := opts.checkout(ctx, *state.AbsFolderName, *news.Version); err != nil {
+			return GitHubRepoState{}, err
+		}
+	}
+	// remotes are removed once HEAD has moved off of their branches so that
+	// its commit isn't mistaken for local work
+	if !remotesEqual(news.Remotes, olds.Remotes) {
+		if err := gitRemoveRemotes(*state.AbsFolderName, news.remotes(), olds.remotes()); err != nil {
+			return GitHubRepoState{}, err
+		}
+	}
 
-	if state.UpdateCommands != nil {
-		_, err := state.run(ctx, strings.Join(*state.UpdateCommands, " && "), *state.AbsFolderName)
-		if err != nil {
+	if steps, ok := news.updateSteps(); ok {
+		if err := state.runSteps(ctx, news.BaseInputs, steps, *state.AbsFolderName); err != nil {
 			return GitHubRepoState{}, err
 		}
 	}
+	if err := state.linkExecutables(ctx, news); err != nil {
+		return GitHubRepoState{}, err
+	}
+	if err := state.verify(ctx, news.BaseInputs, news.Verify, env, *state.AbsFolderName); err != nil {
+		// go back to the commit and executables that were working. The
+		// update commands are not undone
+		if !ptrEqual(news.Version, olds.Version) {
+			err = errors.Join(err, opts.checkout(ctx, *state.AbsFolderName, *olds.Version))
+		}
+		return GitHubRepoState{}, errors.Join(err, state.linkExecutables(ctx, olds.GitHubRepoArgs))
+	}
+	if err := state.runHook(ctx, news.BaseInputs, "postInstall", news.PostInstall, env, *state.AbsFolderName); err != nil {
+		return GitHubRepoState{}, err
+	}
 
 	return *state, nil
 }
 
+// Delete runs the uninstall commands in the repo before it is removed so that
+// they can use anything in it, e.g. make uninstall. Nothing is run if the
+// clone has local changes that would be lost
 func (l *GitHubRepo) Delete(ctx p.Context, id string, props GitHubRepoState) error {
-	if err := os.RemoveAll(*props.AbsFolderName); err != nil {
+	ctx = withBinDirs(ctx, props.BinLocation)
+	if props.AbsFolderName == nil {
+		ctx.Logf(diag.Info, "the clone no longer exists so there is nothing to uninstall")
+		return props.removeLocations(ctx, nil)
+	}
+	retain := props.RetainOnDelete != nil && *props.RetainOnDelete
+	if _, err := os.Stat(*props.AbsFolderName); err == nil && !retain {
+		if _, err := props.gitOptions().protectLocalChanges(ctx, *props.AbsFolderName, "delete", props.OnLocalChanges); err != nil {
+			return err
+		}
+	}
+	env := hookEnv(props.AbsFolderName, props.Version)
+	if err := props.runHook(ctx, props.BaseInputs, "preUninstall", props.PreUninstall, env, *props.AbsFolderName); err != nil {
 		return err
 	}
-	if props.UninstallCommands != nil {
-		_, err := props.run(ctx, strings.Join(*props.UninstallCommands, " && "), "")
-		if err != nil {
+	if err := props.runSteps(ctx, props.BaseInputs, props.uninstallSteps(), *props.AbsFolderName); err != nil {
+		return err
+	}
+	if err := props.removeLocations(ctx, nil); err != nil {
+		return err
+	}
+	if retain {
+		ctx.Logf(diag.Info, "Leaving %s on disk because retainOnDelete is set", *props.AbsFolderName)
+	} else if err := os.RemoveAll(*props.AbsFolderName); err != nil {
+		return err
+	}
+	return props.runHook(ctx, props.BaseInputs, "postUninstall", props.PostUninstall, env, path.Dir(*props.AbsFolderName))
+}
+
+// linkExecutables links the executables that were built in the clone into
+// binLocation. Every binary is checked before anything is linked so that one
+// built for another platform doesn't replace the links that work. Links from
+// an earlier install that are no longer wanted are removed. Files in
+// binLocation that aren't links are never replaced
+func (o *GitHubRepoState) linkExecutables(ctx p.Context, inputs GitHubRepoArgs) error {
+	var locations []string
+	if inputs.Executables != nil {
+		for _, exe := range *inputs.Executables {
+			src := path.Join(*o.AbsFolderName, exe)
+			if _, err := os.Stat(src); err != nil {
+				return fmt.Errorf("executable %s was not built: %w", exe, err)
+			}
+			if err := checkPlatform(src, nil); err != nil {
+				return fmt.Errorf("executable %s: %w", exe, err)
+			}
+		}
+		for _, exe := range *inputs.Executables {
+			src := path.Join(*o.AbsFolderName, exe)
+			target := path.Join(*inputs.BinLocation, path.Base(exe))
+			if fi, err := os.Lstat(target); err == nil && fi.Mode()&os.ModeSymlink == 0 {
+				return fmt.Errorf("can't link executable %s: %s already exists and is not a link", exe, target)
+			}
+			if err := os.MkdirAll(*inputs.BinLocation, 0777); err != nil {
+				return err
+			}
+			ctx.Logf(diag.Info, "Linking %s to %s", target, src)
+			if err := o.linkFile(ctx, inputs.BaseInputs, src, target); err != nil {
+				return fmt.Errorf("linking executable %s: %w", exe, err)
+			}
+			locations = append(locations, target)
+		}
+	}
+	if err := o.removeLocations(ctx, locations); err != nil {
+		return err
+	}
+	o.Locations = nil
+	if len(locations) > 0 {
+		o.Locations = &locations
+	}
+	return nil
+}
+
+// removeLocations removes the links to executables, except the ones in keep
+func (o *GitHubRepoState) removeLocations(ctx p.Context, keep []string) error {
+	if o.Locations == nil {
+		return nil
+	}
+	for _, l := range *o.Locations {
+		if slices.Contains(keep, l) {
+			continue
+		}
+		if err := o.removeFile(ctx, o.BaseInputs, l); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// getLocation sets AbsFolderName to folderName, see expandHome, and creates
+// the directories it is in
 func (o *GitHubRepoState) getLocation(inputs *GitHubRepoArgs) error {
-	dir, err := os.UserHomeDir()
+	absPath, err := expandHome(*inputs.FolderName)
 	if err != nil {
 		return err
 	}
-	parent := path.Dir(*inputs.FolderName)
-	absPath := path.Join(dir, parent)
-	_, err = os.Lstat(absPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			os.MkdirAll(absPath, 0777)
-		} else {
-			return err
-		}
+	if err := os.MkdirAll(path.Dir(absPath), 0777); err != nil {
+		return fmt.Errorf("creating the parent directory of %s: %w", absPath, err)
 	}
-	absPath = path.Join(dir, *inputs.FolderName)
 	o.AbsFolderName = &absPath
 	return nil
 }
 
-func (o *GitHubRepoState) clone(ctx p.Context, inputs GitHubRepoArgs) error {
+// expandHome returns the absolute path of dir. ~ is the home directory and
+// relative paths are relative to it
+func expandHome(dir string) (string, error) {
+	if path.IsAbs(dir) {
+		return path.Clean(dir), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	if dir == "~" || strings.HasPrefix(dir, "~/") {
+		return path.Join(home, dir[1:]), nil
+	}
+	return path.Join(home, dir), nil
+}
 
-	command := fmt.Sprintf(
-		"git clone -b %s https://github.com/%s/%s %s",
-		*inputs.Branch,
-		inputs.Org,
-		inputs.Repo,
-		path.Base(*inputs.FolderName),
-	)
+// defaultFolderName returns the folder to clone to when folderName isn't
+// set. This is $REPO in the home directory unless the provider has a
+// sourceRoot, then it is $SOURCE_ROOT/$HOST/$ORG/$REPO. It returns false if
+// the inputs it needs aren't known yet
+func defaultFolderName(ctx p.Context, inputs resource.PropertyMap) (string, bool) {
+	repo, ok := inputs["repo"]
+	if !ok || !repo.IsString() {
+		return "", false
+	}
+	sourceRoot := infer.GetConfig[config.Config](ctx).SourceRoot
+	if sourceRoot == nil {
+		return repo.StringValue(), true
+	}
+	org, ok := inputs["org"]
+	if !ok || !org.IsString() {
+		return "", false
+	}
+	host := "github.com"
+	if url, ok := inputs["url"]; ok {
+		if !url.IsString() {
+			return "", false
+		}
+		if ep, err := transport.NewEndpoint(url.StringValue()); err == nil {
+			host = ep.Host
+		}
+	}
+	return path.Join(*sourceRoot, host, org.StringValue(), repo.StringValue()), true
+}
 
-	// clone the repo
-	_, err := o.run(ctx, command, path.Dir(*o.AbsFolderName))
+// readDrift records the commit and branch that HEAD is on, and the url of
+// origin, if they aren't the ones in the state so that changes made outside
+// of pulumi show up in the diff. HEAD is detached after a clone or update so
+// it is only on a branch if something else checked one out
+func (o *GitHubRepoState) readDrift(ctx p.Context) error {
+	repo, err := gitOpen(*o.AbsFolderName)
 	if err != nil {
 		return err
 	}
+	head, err := repo.Head()
+	if err != nil {
+		return gitError("rev-parse", "HEAD", err)
+	}
+	if o.Version != nil {
+		if want, err := gitRevParse(repo, *o.Version); err != nil || want != head.Hash().String() {
+			ctx.Logf(diag.Warning, "HEAD of %s is %s, not %s", *o.AbsFolderName, head.Hash(), *o.Version)
+			version := head.Hash().String()
+			o.Version = &version
+		}
+	}
+	if head.Name().IsBranch() && (o.Branch == nil || head.Name().Short() != *o.Branch) {
+		ctx.Logf(diag.Warning, "%s has branch %s checked out", *o.AbsFolderName, head.Name().Short())
+		branch := head.Name().Short()
+		o.Branch = &branch
+	}
+	url := ""
+	if remote, err := repo.Remote("origin"); err == nil {
+		url = remote.Config().URLs[0]
+	}
+	if url != o.remoteURL() {
+		ctx.Logf(diag.Warning, "origin of %s is %q, not %s", *o.AbsFolderName, url, o.remoteURL())
+		o.URL = &url
+	}
 	return nil
 }
+
+// clone clones the repo to AbsFolderName. The branch is checked out unless
+// there is a ref, which may be a tag, in which case the default branch of the
+// remote is checked out
+func (o *GitHubRepoState) clone(ctx p.Context, inputs GitHubRepoArgs) error {
+	var ref plumbing.ReferenceName
+	if inputs.Ref == nil {
+		ref = plumbing.NewBranchReferenceName(*inputs.Branch)
+	}
+	return inputs.gitOptions().clone(ctx, inputs.remoteURL(), ref, *o.AbsFolderName)
+}
+
+// resolve sets the version to the commit that ref resolves to in refs, or to
+// the head of the branch if there is no ref. The tag is set if ref resolved
+// to a tag
+func (l *GitHubRepoArgs) resolve(refs []gitRef) error {
+	var r gitRef
+	if l.Ref != nil {
+		var err error
+		if r, err = resolveRef(refs, *l.Ref); err != nil {
+			return err
+		}
+	} else {
+		i := slices.IndexFunc(refs, func(r gitRef) bool { return !r.tag && r.name == *l.Branch })
+		if i < 0 {
+			return gitError("resolve", *l.Branch, fmt.Errorf("no branch is called %s: %w", *l.Branch, plumbing.ErrReferenceNotFound))
+		}
+		r = refs[i]
+	}
+	l.Version = &r.commit
+	l.Tag = nil
+	if r.tag {
+		l.Tag = &r.name
+	}
+	return nil
+}
+
+// remoteURL returns the url to clone from. org and repo are shorthand for a
+// repo on GitHub
+func (l *GitHubRepoArgs) remoteURL() string {
+	if l.URL != nil {
+		return *l.URL
+	}
+	return fmt.Sprintf("https://github.com/%s/%s", l.Org, l.Repo)
+}
+
+func (l *GitHubRepoArgs) remotes() map[string]GitRemote {
+	if l.Remotes == nil {
+		return nil
+	}
+	return *l.Remotes
+}
+
+func (l *GitHubRepoArgs) trackRemote() string {
+	if l.TrackRemote == nil {
+		return gitOrigin
+	}
+	return *l.TrackRemote
+}
+
+// trackURL returns the url of the remote that defines the version
+func (l *GitHubRepoArgs) trackURL() string {
+	if r, ok := l.remotes()[l.trackRemote()]; ok {
+		return r.URL
+	}
+	return l.remoteURL()
+}
+
+func (l *GitHubRepoArgs) gitOptions() gitOptions {
+	opts := gitOptions{creds: gitCredentials{sshKey: l.SSHKey, sshKeyPassword: l.SSHKeyPassword}}
+	if l.Depth != nil {
+		opts.depth = *l.Depth
+	}
+	if l.Filter != nil {
+		opts.filter = *l.Filter
+	}
+	if l.SparsePaths != nil {
+		opts.sparsePaths = *l.SparsePaths
+	}
+	if l.Submodules != nil {
+		opts.submodules = *l.Submodules
+	}
+	opts.lfs = l.LFS != nil && *l.LFS
+	return opts
+}
+
+// checkRemotes checks that trackRemote is one of the remotes and that the
+// url of origin, if it is listed, is the url of the repo
+func checkRemotes(inputs resource.PropertyMap) []p.CheckFailure {
+	var failures []p.CheckFailure
+	remotes := resource.PropertyMap{}
+	if r, ok := inputs["remotes"]; ok {
+		if !r.IsObject() {
+			return nil
+		}
+		remotes = r.ObjectValue()
+	}
+	if origin, ok := remotes[gitOrigin]; ok && origin.IsObject() {
+		url := origin.ObjectValue()["url"]
+		args := GitHubRepoArgs{URL: stringProperty(inputs["url"])}
+		if org, repo := inputs["org"], inputs["repo"]; org.IsString() && repo.IsString() {
+			args.Org, args.Repo = org.StringValue(), repo.StringValue()
+		}
+		if url.IsString() && url.StringValue() != args.remoteURL() {
+			failures = append(failures, p.CheckFailure{
+				Property: "remotes",
+				Reason:   fmt.Sprintf("the url of origin must be %s, the url of the repo", args.remoteURL()),
+			})
+		}
+	}
+	if track, ok := inputs["trackRemote"]; ok && track.IsString() && track.StringValue() != gitOrigin {
+		if _, ok := remotes[resource.PropertyKey(track.StringValue())]; !ok {
+			failures = append(failures, p.CheckFailure{
+				Property: "trackRemote",
+				Reason:   fmt.Sprintf("trackRemote must be %s or one of remotes", gitOrigin),
+			})
+		}
+	}
+	return failures
+}
+
+func stringProperty(v resource.PropertyValue) *string {
+	if !v.IsString() {
+		return nil
+	}
+	s := v.StringValue()
+	return &s
+}
+
+// gitFilters are the partial clone filters that can be used
+var gitFilters = []string{"blob:none", "tree:0"}
+
+// checkCloneOptions checks depth, filter, submodules and onLocalChanges, which
+// would otherwise only be rejected once the repo is cloned
+func checkCloneOptions(inputs resource.PropertyMap) []p.CheckFailure {
+	var failures []p.CheckFailure
+	if depth, ok := inputs["depth"]; ok && depth.IsNumber() && depth.NumberValue() < 1 {
+		failures = append(failures, p.CheckFailure{Property: "depth", Reason: "depth must be at least 1"})
+	}
+	if filter, ok := inputs["filter"]; ok && filter.IsString() && !slices.Contains(gitFilters, filter.StringValue()) {
+		failures = append(failures, p.CheckFailure{
+			Property: "filter",
+			Reason:   fmt.Sprintf("filter must be one of %s", strings.Join(gitFilters, ", ")),
+		})
+	}
+	if submodules, ok := inputs["submodules"]; ok && submodules.IsString() && !slices.Contains(gitSubmodules, submodules.StringValue()) {
+		failures = append(failures, p.CheckFailure{
+			Property: "submodules",
+			Reason:   fmt.Sprintf("submodules must be one of %s", strings.Join(gitSubmodules, ", ")),
+		})
+	}
+	if mode, ok := inputs["onLocalChanges"]; ok && mode.IsString() && !slices.Contains(onLocalChangesModes, mode.StringValue()) {
+		failures = append(failures, p.CheckFailure{
+			Property: "onLocalChanges",
+			Reason:   fmt.Sprintf("onLocalChanges must be one of %s", strings.Join(onLocalChangesModes, ", ")),
+		})
+	}
+	return failures
+}
+
+// splitRemoteURL returns the org and repo of a git url, e.g. org and repo for
+// git@host:org/repo.git. The org is everything before the repo so it can have
+// more than one part, e.g. for GitLab subgroups
+func splitRemoteURL(url string) (string, string, error) {
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		return "", "", err
+	}
+	p := strings.TrimSuffix(strings.Trim(ep.Path, "/"), ".git")
+	if p == "" {
+		return "", "", fmt.Errorf("%s has no repo in its path", url)
+	}
+	org := path.Dir(p)
+	if org == "." {
+		org = ""
+	}
+	return org, path.Base(p), nil
+}
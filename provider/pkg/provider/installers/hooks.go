@@ -0,0 +1,77 @@
+package installers
+
+import (
+	"fmt"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// LifecycleHooks are commands that every installer runs around installs and
+// uninstalls. Hooks run in this order:
+//
+//	create/update: preInstall, download or clone, install or update commands, verify, postInstall
+//	delete:        preUninstall, uninstall commands, remove the installed files, postUninstall
+//
+// Each hook command is run with PDE_LOCATION and PDE_VERSION set to the
+// location and version of the install, when they are known
+type LifecycleHooks struct {
+	PreInstall    *[]string `pulumi:"preInstall,optional"`
+	PostInstall   *[]string `pulumi:"postInstall,optional"`
+	PreUninstall  *[]string `pulumi:"preUninstall,optional"`
+	PostUninstall *[]string `pulumi:"postUninstall,optional"`
+}
+
+func (h *LifecycleHooks) Annotate(a infer.Annotator) {
+	a.Describe(&h.PreInstall, `Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+				are set to the location and version that will be installed`)
+	a.Describe(&h.PostInstall, `Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+				are set to the location and version that were installed`)
+	a.Describe(&h.PreUninstall, `Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+				and PDE_VERSION are set to the location and version that are installed`)
+	a.Describe(&h.PostUninstall, `Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+				to the location and version that were removed`)
+}
+
+// diff adds the hooks that changed to diff. Hooks don't change what is
+// installed so a change only needs to be stored in state
+func (h *LifecycleHooks) diff(olds LifecycleHooks, diff map[string]p.PropertyDiff) {
+	if !commandsEqual(h.PreInstall, olds.PreInstall) {
+		diff["preInstall"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !commandsEqual(h.PostInstall, olds.PostInstall) {
+		diff["postInstall"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !commandsEqual(h.PreUninstall, olds.PreUninstall) {
+		diff["preUninstall"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !commandsEqual(h.PostUninstall, olds.PostUninstall) {
+		diff["postUninstall"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+}
+
+// hookEnv returns the environment that describes the install to the hooks
+func hookEnv(location, version *string) []string {
+	env := []string{}
+	if location != nil {
+		env = append(env, "PDE_LOCATION="+*location)
+	}
+	if version != nil {
+		env = append(env, "PDE_VERSION="+*version)
+	}
+	return env
+}
+
+// runHook runs the commands of the hook called name one at a time using the
+// options in b
+func (c *CommandOutputs) runHook(ctx p.Context, b BaseInputs, name string, commands *[]string, env []string, dir string) error {
+	if commands == nil {
+		return nil
+	}
+	for _, command := range *commands {
+		if _, _, err := c.execWith(ctx, b, c.args(command), env, command, dir); err != nil {
+			return fmt.Errorf("%s hook: %w", name, err)
+		}
+	}
+	return nil
+}
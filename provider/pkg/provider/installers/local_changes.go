@@ -0,0 +1,378 @@
+package installers
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+)
+
+// ErrGitLocalChanges is returned when an update or delete would lose changes
+// that were made to a clone
+var ErrGitLocalChanges = errors.New("git worktree has local changes")
+
+const (
+	// onLocalChangesFail refuses to update or delete a clone with local
+	// changes
+	onLocalChangesFail = "fail"
+	// onLocalChangesStash stashes changed files, and puts commits on a
+	// detached HEAD on a branch, before an update
+	onLocalChangesStash = "stash"
+	// onLocalChangesDiscard throws local changes away
+	onLocalChangesDiscard = "discard"
+)
+
+// onLocalChangesModes are the values that onLocalChanges can have
+var onLocalChangesModes = []string{onLocalChangesFail, onLocalChangesStash, onLocalChangesDiscard}
+
+// LocalChanges is the work in a clone that only exists on this machine
+type LocalChanges struct {
+	Files           []string `pulumi:"files,optional"`
+	UnpushedCommits []string `pulumi:"unpushedCommits,optional"`
+	Stashes         int      `pulumi:"stashes"`
+}
+
+func (c *LocalChanges) Annotate(a infer.Annotator) {
+	a.Describe(&c.Files, "The tracked files that have uncommitted changes")
+	a.Describe(&c.UnpushedCommits, `The commits on HEAD or a local branch that are not on any remote branch or tag,
+				as the short hash and subject`)
+	a.Describe(&c.Stashes, "The number of stash entries")
+}
+
+func (c *LocalChanges) String() string {
+	var parts []string
+	if len(c.Files) > 0 {
+		parts = append(parts, fmt.Sprintf("changes to %s", strings.Join(c.Files, ", ")))
+	}
+	if n := len(c.UnpushedCommits); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d unpushed %s", n, plural(n, "commit")))
+	}
+	if c.Stashes > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s", c.Stashes, plural(c.Stashes, "stash")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return word
+	}
+	if strings.HasSuffix(word, "sh") {
+		return word + "es"
+	}
+	return word + "s"
+}
+
+// localChanges returns the changed files, unpushed commits and stashes of the
+// clone in dir, or nil if there aren't any
+func (o gitOptions) localChanges(ctx p.Context, dir string) (*LocalChanges, error) {
+	files, err := o.status(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := gitOpen(dir)
+	if err != nil {
+		return nil, err
+	}
+	unpushed, err := gitUnpushed(ctx, repo, dir)
+	if err != nil {
+		return nil, err
+	}
+	stashes, err := gitStashes(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 && len(unpushed) == 0 && stashes == 0 {
+		return nil, nil
+	}
+	return &LocalChanges{Files: files, UnpushedCommits: unpushed, Stashes: stashes}, nil
+}
+
+// gitUnpushed returns the commits that are reachable from HEAD or a local
+// branch but not from a remote branch or a tag. The history of a shallow
+// clone ends at the commits that it has
+func gitUnpushed(ctx p.Context, repo *git.Repository, dir string) ([]string, error) {
+	var commits []commitSummary
+	if hasGitCLI() {
+		var err error
+		commits, err = gitLog(ctx, dir, "--branches", "HEAD", "--not", "--remotes", "--tags")
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		local, remote, err := gitTips(repo)
+		if err != nil {
+			return nil, err
+		}
+		commits = walkCommits(repo, local, remote)
+	}
+	var unpushed []string
+	for _, c := range commits {
+		unpushed = append(unpushed, c.hash.String()[:7]+" "+c.subject)
+	}
+	return unpushed, nil
+}
+
+// gitLog returns the commits that git log finds for revs, newest first
+func gitLog(ctx p.Context, dir string, revs ...string) ([]commitSummary, error) {
+	args := append([]string{"git", "log", "--format=%H %s"}, revs...)
+	stdout, _, err := (&CommandOutputs{}).exec(ctx, execOptions{
+		args:    append(args, "--"),
+		dir:     dir,
+		command: "git log",
+	})
+	if err != nil {
+		return nil, gitError("log", "", err)
+	}
+	var commits []commitSummary
+	for _, line := range strings.Split(stdout, "\n") {
+		if line == "" {
+			continue
+		}
+		hash, subject, _ := strings.Cut(line, " ")
+		commits = append(commits, commitSummary{hash: plumbing.NewHash(hash), subject: subject})
+	}
+	return commits, nil
+}
+
+// gitTips returns the commits that HEAD and the local branches point to, and
+// the commits that the remote branches and tags point to
+func gitTips(repo *git.Repository) (local, remote []plumbing.Hash, err error) {
+	refs, err := repo.References()
+	if err != nil {
+		return nil, nil, gitError("log", "", err)
+	}
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		switch {
+		case ref.Type() != plumbing.HashReference:
+		case ref.Name().IsBranch():
+			local = append(local, ref.Hash())
+		case ref.Name().IsRemote(), ref.Name().IsTag():
+			remote = append(remote, ref.Hash())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, gitError("log", "", err)
+	}
+	if head, err := repo.Head(); err == nil {
+		local = append(local, head.Hash())
+	}
+	return local, remote, nil
+}
+
+// commitSummary is a commit that walkCommits found
+type commitSummary struct {
+	hash    plumbing.Hash
+	subject string
+}
+
+// uninteresting marks the commits that walkCommits leaves out
+const uninteresting = 1
+
+// commitWalk is the state of walkCommits
+type commitWalk struct {
+	repo  *git.Repository
+	flags map[plumbing.Hash]int
+	// queue is ordered by commit time, newest first
+	queue []*object.Commit
+}
+
+// walkCommits returns the commits reachable from include but not from
+// exclude, newest first, like git rev-list include --not exclude does. The
+// commits are visited in order of commit time so that the walk stops once
+// only excluded commits are left instead of going through all of their
+// history. Tags that point to something other than a commit and commits that
+// a shallow clone doesn't have are skipped
+func walkCommits(repo *git.Repository, include, exclude []plumbing.Hash) []commitSummary {
+	w := &commitWalk{repo: repo, flags: map[plumbing.Hash]int{}}
+	for _, hash := range exclude {
+		w.push(hash, uninteresting)
+	}
+	for _, hash := range include {
+		w.push(hash, 0)
+	}
+	var found []*object.Commit
+	for !w.done() {
+		c := w.queue[0]
+		w.queue = w.queue[1:]
+		flags := w.flags[c.Hash]
+		for _, parent := range c.ParentHashes {
+			w.push(parent, flags)
+		}
+		if flags&uninteresting == 0 {
+			found = append(found, c)
+		}
+	}
+	// commits with a wrong commit time can be found before they are known
+	// to be excluded
+	var commits []commitSummary
+	for _, c := range found {
+		if w.flags[c.Hash]&uninteresting == 0 {
+			subject, _, _ := strings.Cut(c.Message, "\n")
+			commits = append(commits, commitSummary{hash: c.Hash, subject: subject})
+		}
+	}
+	return commits
+}
+
+// push queues the commit that hash points to with flags. A commit that was
+// already seen is only updated when it becomes uninteresting, along with the
+// parents that were seen through it
+func (w *commitWalk) push(hash plumbing.Hash, flags int) {
+	if tag, err := w.repo.TagObject(hash); err == nil {
+		hash = tag.Target
+	}
+	if old, ok := w.flags[hash]; ok {
+		if flags&uninteresting != 0 && old&uninteresting == 0 {
+			w.markUninteresting(hash)
+		}
+		return
+	}
+	c, err := w.repo.CommitObject(hash)
+	if err != nil {
+		return
+	}
+	w.flags[hash] = flags
+	i := sort.Search(len(w.queue), func(i int) bool {
+		return w.queue[i].Committer.When.Before(c.Committer.When)
+	})
+	w.queue = slices.Insert(w.queue, i, c)
+}
+
+// markUninteresting marks hash and the ancestors of it that were already
+// seen as uninteresting
+func (w *commitWalk) markUninteresting(hash plumbing.Hash) {
+	stack := []plumbing.Hash{hash}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		flags, ok := w.flags[hash]
+		if !ok || flags&uninteresting != 0 {
+			continue
+		}
+		w.flags[hash] = flags | uninteresting
+		if c, err := w.repo.CommitObject(hash); err == nil {
+			stack = append(stack, c.ParentHashes...)
+		}
+	}
+}
+
+// done returns whether only uninteresting commits are left to visit
+func (w *commitWalk) done() bool {
+	for _, c := range w.queue {
+		if w.flags[c.Hash]&uninteresting == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// gitStashes returns the number of stash entries. They are the entries of the
+// reflog of refs/stash, which go-git can't read
+func gitStashes(dir string) (int, error) {
+	f, err := os.Open(filepath.Join(dir, ".git", "logs", "refs", "stash"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, gitError("stash", "list", err)
+	}
+	defer f.Close()
+	n := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if scanner.Text() != "" {
+			n++
+		}
+	}
+	return n, gitError("stash", "list", scanner.Err())
+}
+
+// protectLocalChanges checks the clone in dir for local changes before op,
+// an update or delete, would lose them. What happens to them depends on mode,
+// see onLocalChangesModes. Deletes can't keep a stash so they fail instead.
+// It returns whether the changes can be discarded
+func (o gitOptions) protectLocalChanges(ctx p.Context, dir, op string, mode *string) (bool, error) {
+	changes, err := o.localChanges(ctx, dir)
+	if err != nil || changes == nil {
+		return false, err
+	}
+	m := onLocalChangesFail
+	if mode != nil {
+		m = *mode
+	}
+	switch {
+	case m == onLocalChangesDiscard:
+		ctx.Logf(diag.Warning, "Discarding local changes in %s: %s", dir, changes)
+		return true, nil
+	case m == onLocalChangesStash && op == "update":
+		return false, o.stash(ctx, dir, changes)
+	}
+	hint := "set onLocalChanges to stash or discard them"
+	if op == "delete" {
+		hint = "set onLocalChanges to discard them or retainOnDelete to keep the clone"
+	}
+	return false, &GitError{
+		Op:     op,
+		Target: dir,
+		Kind:   ErrGitLocalChanges,
+		Err:    fmt.Errorf("local changes would be lost: %s. Push or remove them, or %s", changes, hint),
+	}
+}
+
+// stash keeps local changes safe before an update checks out another commit.
+// Changed files are stashed, which needs the git CLI, and unpushed commits on
+// a detached HEAD are put on a new branch so that they can still be found.
+// Commits on local branches and existing stashes are already safe
+func (o gitOptions) stash(ctx p.Context, dir string, changes *LocalChanges) error {
+	if len(changes.Files) > 0 {
+		ctx.Logf(diag.Warning, "Stashing changes to %s in %s", strings.Join(changes.Files, ", "), dir)
+		if err := o.git(ctx, "stash", "push", "", dir, "stash", "push", "--message", "pulumi-pde: local changes before update"); err != nil {
+			return err
+		}
+	}
+	repo, err := gitOpen(dir)
+	if err != nil {
+		return err
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return gitError("stash", "HEAD", err)
+	}
+	if head.Name() != plumbing.HEAD || len(changes.UnpushedCommits) == 0 {
+		return nil
+	}
+	// the commit on HEAD is only at risk if no branch, local or remote, has it
+	var commits []commitSummary
+	if hasGitCLI() {
+		commits, err = gitLog(ctx, dir, "HEAD", "--not", "--branches", "--remotes", "--tags")
+		if err != nil {
+			return err
+		}
+	} else {
+		branches, remote, err := gitTips(repo)
+		if err != nil {
+			return err
+		}
+		// the last local tip is HEAD itself
+		commits = walkCommits(repo, []plumbing.Hash{head.Hash()}, append(branches[:len(branches)-1], remote...))
+	}
+	if len(commits) == 0 {
+		return nil
+	}
+	branch := plumbing.NewBranchReferenceName("pde-" + head.Hash().String()[:7])
+	ctx.Logf(diag.Warning, "Saving unpushed commits on HEAD in %s as branch %s", dir, branch.Short())
+	return gitError("branch", branch.Short(), repo.Storer.SetReference(plumbing.NewHashReference(branch, head.Hash())))
+}
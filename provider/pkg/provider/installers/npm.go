@@ -1,8 +1,10 @@
 package installers
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path"
 
 	p "github.com/pulumi/pulumi-go-provider"
 
@@ -18,6 +20,7 @@ var _ = (infer.CustomDelete[NpmState])((*Npm)(nil))
 var _ = (infer.CustomCheck[NpmArgs])((*Npm)(nil))
 
 type NpmArgs struct {
+	BaseInputs
 	Location string  `pulumi:"location"`
 	Package  string  `pulumi:"package"`
 	Version  *string `pulumi:"version,optional"`
@@ -25,6 +28,7 @@ type NpmArgs struct {
 
 type NpmState struct {
 	NpmArgs
+	CommandOutputs
 }
 
 func (s *Npm) Annotate(a infer.Annotator) {
@@ -45,6 +49,9 @@ func (s *NpmArgs) Annotate(a infer.Annotator) {
 func (s *NpmState) Annotate(a infer.Annotator) {}
 
 func (s *Npm) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (NpmArgs, []p.CheckFailure, error) {
+	if failures := checkBase(ctx, newInputs); len(failures) > 0 {
+		return NpmArgs{}, failures, nil
+	}
 	if _, ok := newInputs["version"]; !ok {
 		// if package is not in oldInputs, then this is a create operation and the read method is not
 		// called
@@ -61,17 +68,19 @@ func (s *Npm) Check(ctx p.Context, name string, oldInputs, newInputs resource.Pr
 			newInputs["version"] = oldInputs["version"]
 		}
 	}
-	return infer.DefaultCheck[NpmArgs](newInputs)
+	inputs, failures, err := infer.DefaultCheck[NpmArgs](newInputs)
+	addBinDirs(inputs.binDir())
+	return inputs, failures, err
 }
 
 // Read is only called during --refresh operations
 func (s *Npm) Read(ctx p.Context, id string, inputs NpmArgs, state NpmState) (
 	canonicalID string, normalizedInputs NpmArgs, normalizedState NpmState, err error,
 ) {
-	c := &CommandOutputs{}
+	ctx = withBinDirs(ctx, inputs.binDir())
 	if inputs.Version == nil {
 		cmd := fmt.Sprintf("npm view %s version", inputs.Package)
-		v, err := c.run(ctx, cmd, state.Location)
+		v, err := state.run(ctx, inputs.BaseInputs, cmd, state.Location)
 		if err != nil {
 			return "", NpmArgs{}, NpmState{}, err
 		}
@@ -94,6 +103,8 @@ func (s *Npm) Diff(ctx p.Context, id string, olds NpmState, news NpmArgs) (p.Dif
 		diff["version"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
 
+	news.BaseInputs.diff(olds.BaseInputs, diff)
+
 	return p.DiffResponse{
 		DeleteBeforeReplace: true,
 		HasChanges:          len(diff) > 0,
@@ -102,6 +113,7 @@ func (s *Npm) Diff(ctx p.Context, id string, olds NpmState, news NpmArgs) (p.Dif
 }
 
 func (s *Npm) Create(ctx p.Context, name string, input NpmArgs, preview bool) (string, NpmState, error) {
+	ctx = withBinDirs(ctx, input.binDir())
 	state := &NpmState{
 		NpmArgs: input,
 	}
@@ -115,43 +127,98 @@ func (s *Npm) Create(ctx p.Context, name string, input NpmArgs, preview bool) (s
 		return "", NpmState{}, fmt.Errorf("location %s does not exist", input.Location)
 	}
 
-	if err := state.install(ctx); err != nil {
+	if err := state.install(ctx, state.installSteps(), func() error {
+		// don't leave a broken package behind
+		_, err := state.runWith(ctx, input.BaseInputs, fmt.Sprintf("npm uninstall %s", input.Package), input.Location)
+		return err
+	}); err != nil {
 		return "", NpmState{}, err
 	}
 	return name, *state, nil
 }
 
 func (s *Npm) Update(ctx p.Context, name string, olds NpmState, news NpmArgs, preview bool) (NpmState, error) {
+	ctx = withBinDirs(ctx, news.binDir())
 	state := &NpmState{
-		NpmArgs: news,
+		NpmArgs:        news,
+		CommandOutputs: olds.CommandOutputs,
 	}
 
 	if preview {
 		return *state, nil
 	}
 
-	if err := state.install(ctx); err != nil {
+	steps := state.installSteps()
+	if update, ok := news.updateSteps(); ok {
+		steps = append(steps, update...)
+	}
+	if err := state.install(ctx, steps, func() error {
+		// go back to the version that was working. A new package is
+		// installed next to the old one so it is removed first
+		if news.Package != olds.Package {
+			if _, err := state.runWith(ctx, news.BaseInputs, fmt.Sprintf("npm uninstall %s", news.Package), news.Location); err != nil {
+				return err
+			}
+		}
+		_, err := state.runWith(ctx, news.BaseInputs, fmt.Sprintf("npm install %s@%s", olds.Package, *olds.Version), olds.Location)
+		return err
+	}); err != nil {
 		return NpmState{}, err
 	}
 	return *state, nil
 }
 
 func (s *Npm) Delete(ctx p.Context, id string, props NpmState) error {
-	c := &CommandOutputs{}
+	ctx = withBinDirs(ctx, props.binDir())
+	env := hookEnv(&props.Location, props.Version)
 
-	if _, err := c.run(ctx, fmt.Sprintf("npm uninstall %s", props.Package), props.Location); err != nil {
+	if err := props.runHook(ctx, props.BaseInputs, "preUninstall", props.PreUninstall, env, props.Location); err != nil {
+		return err
+	}
+	steps := append(props.uninstallSteps(), npmStep("uninstall", props.Package))
+	if err := props.runSteps(ctx, props.BaseInputs, steps, props.Location); err != nil {
 		return err
 	}
-	return nil
+	return props.runHook(ctx, props.BaseInputs, "postUninstall", props.PostUninstall, env, props.Location)
 }
 
-// Install a npm package to a local directory
-func (n *NpmState) install(ctx p.Context) error {
-	c := &CommandOutputs{}
+// binDir returns the directory that npm links the executables of the
+// installed packages into
+func (n *NpmArgs) binDir() *string {
+	if n.Location == "" {
+		return nil
+	}
+	dir := path.Join(n.Location, "node_modules", ".bin")
+	return &dir
+}
 
-	if _, err := c.run(ctx, fmt.Sprintf("npm install %s@%s", n.Package, *n.Version), n.Location); err != nil {
-		return err
+// installSteps returns the step that installs the package
+func (n *NpmState) installSteps() []Step {
+	return []Step{npmStep("install", fmt.Sprintf("%s@%s", n.Package, *n.Version))}
+}
+
+// npmStep returns a step that runs npm command with args
+func npmStep(command, args string) Step {
+	name := "npm " + command
+	return Step{
+		Name:    &name,
+		Command: fmt.Sprintf("npm %s %s", command, args),
 	}
+}
+
+// Install a npm package to a local directory by running steps. rollback is
+// called if the package fails verification
+func (n *NpmState) install(ctx p.Context, steps []Step, rollback func() error) error {
+	env := hookEnv(&n.Location, n.Version)
 
-	return nil
+	if err := n.runHook(ctx, n.BaseInputs, "preInstall", n.PreInstall, env, n.Location); err != nil {
+		return err
+	}
+	if err := n.runSteps(ctx, n.BaseInputs, steps, n.Location); err != nil {
+		return err
+	}
+	if err := n.verify(ctx, n.BaseInputs, n.Verify, env, n.Location); err != nil {
+		return errors.Join(err, rollback())
+	}
+	return n.runHook(ctx, n.BaseInputs, "postInstall", n.PostInstall, env, n.Location)
 }
@@ -0,0 +1,85 @@
+package installers
+
+import (
+	"net/url"
+	"os"
+	"path"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// Plan describes what a create or update does. It is set during preview so
+// that a change can be reviewed before it is applied
+type Plan struct {
+	DownloadURL *string  `pulumi:"downloadURL,optional"`
+	Asset       *string  `pulumi:"asset,optional"`
+	Commands    []string `pulumi:"commands"`
+	Writes      []string `pulumi:"writes"`
+	Removes     []string `pulumi:"removes"`
+}
+
+func (p *Plan) Annotate(a infer.Annotator) {
+	a.Describe(&p.DownloadURL, "The resolved URL the program is downloaded from")
+	a.Describe(&p.Asset, "The release asset that is installed")
+	a.Describe(&p.Commands, "The commands that are run, in order")
+	a.Describe(&p.Writes, "The files that are written")
+	a.Describe(&p.Removes, "The files that are removed")
+}
+
+// plan returns the plan for createOrUpdate. dir is the directory the
+// commands run in. There is no plan while the downloadURL is unknown
+func (s *ShellState) plan(input ShellArgs, steps []Step, dir string) *Plan {
+	downloadURL := input.DownloadURL
+	if downloadURL == "" {
+		return nil
+	}
+	plan := &Plan{
+		DownloadURL: &downloadURL,
+		Commands:    []string{},
+		Writes:      []string{path.Join(dir, downloadName(downloadURL))},
+		Removes:     []string{},
+	}
+	if input.PreInstall != nil {
+		plan.Commands = append(plan.Commands, *input.PreInstall...)
+	}
+	plan.Commands = append(plan.Commands, downloadCommand(downloadURL))
+	for _, step := range steps {
+		plan.Commands = append(plan.Commands, step.Command)
+	}
+	if input.Executable != nil && *input.Executable {
+		plan.Writes = append(plan.Writes, path.Join(*input.BinLocation, input.ProgramName))
+		plan.Removes = append(plan.Removes, path.Join(dir, input.ProgramName))
+	}
+	if input.VersionCommand != nil {
+		plan.Commands = append(plan.Commands, *input.VersionCommand)
+	}
+	if input.Verify != nil {
+		plan.Commands = append(plan.Commands, input.Verify.Command)
+	}
+	if input.PostInstall != nil {
+		plan.Commands = append(plan.Commands, *input.PostInstall...)
+	}
+	return plan
+}
+
+// commandDir returns the directory that createOrUpdate runs commands in
+func (s *ShellState) commandDir() string {
+	if s.WorkspaceDir != nil {
+		return *s.WorkspaceDir
+	}
+	return os.TempDir()
+}
+
+func downloadCommand(downloadURL string) string {
+	return "curl -OL " + downloadURL
+}
+
+// downloadName returns the name of the file that curl -O writes, which is
+// the last part of the URL path
+func downloadName(downloadURL string) string {
+	u, err := url.Parse(downloadURL)
+	if err != nil || path.Base(u.Path) == "/" || path.Base(u.Path) == "." {
+		return path.Base(downloadURL)
+	}
+	return path.Base(u.Path)
+}
@@ -0,0 +1,108 @@
+package installers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"text/template"
+)
+
+// archAliases maps the name a project uses for amd64 to the names it uses
+// for the other architectures. This covers the common naming schemes used by
+// release assets, e.g. x86_64/aarch64 (uname) or x64/arm64 (node)
+var archAliases = map[string]map[string]string{
+	"x86_64": {
+		"amd64": "x86_64",
+		"arm64": "aarch64",
+		"386":   "i386",
+		"arm":   "armv7",
+	},
+	"x64": {
+		"amd64": "x64",
+		"arm64": "arm64",
+		"386":   "x86",
+		"arm":   "armv7l",
+	},
+	"64bit": {
+		"amd64": "64bit",
+		"arm64": "arm64",
+		"386":   "32bit",
+		"arm":   "arm",
+	},
+}
+
+// platformData is the data that is available to templated inputs like
+// downloadURL
+type platformData struct {
+	version *string
+	os      string
+	arch    string
+}
+
+func newPlatformData(version *string, goos, arch string) *platformData {
+	return &platformData{
+		version: version,
+		os:      goos,
+		arch:    arch,
+	}
+}
+
+// parsePlatform splits a platform of the form os/arch, e.g. linux/arm64, into
+// its os and arch. If platform is not set then the platform of the host is
+// used
+func parsePlatform(platform *string) (string, string, error) {
+	if platform == nil || *platform == "" {
+		return runtime.GOOS, runtime.GOARCH, nil
+	}
+	goos, arch, ok := strings.Cut(*platform, "/")
+	if !ok || goos == "" || arch == "" || strings.Contains(arch, "/") {
+		return "", "", fmt.Errorf("platform %q must be of the form os/arch, e.g. linux/arm64", *platform)
+	}
+	return goos, arch, nil
+}
+
+// Version is the version of the program to install
+func (d *platformData) Version() (string, error) {
+	if d.version == nil {
+		return "", errors.New("{{.Version}} is used but version is not set")
+	}
+	return *d.version, nil
+}
+
+// OS is the operating system of the platform, e.g. linux or darwin
+func (d *platformData) OS() string {
+	return d.os
+}
+
+// Arch is the architecture of the platform, e.g. amd64 or arm64
+func (d *platformData) Arch() string {
+	return d.arch
+}
+
+// ArchAlias returns the architecture of the platform using the naming scheme
+// where amd64 is called `alias`. For example {{.ArchAlias "x86_64"}} will
+// return x86_64 on amd64 hosts and aarch64 on arm64 hosts. If the naming
+// scheme is not known then the go architecture name is returned
+func (d *platformData) ArchAlias(alias string) string {
+	if aliases, ok := archAliases[alias]; ok {
+		if a, ok := aliases[d.arch]; ok {
+			return a
+		}
+	}
+	return d.arch
+}
+
+// renderTemplate renders a go template string using the platform data
+func (d *platformData) renderTemplate(name, text string) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, d); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,50 @@
+package installers
+
+import (
+	"sync"
+	"time"
+)
+
+// killGracePeriod is how long a cancelled command has to exit after it is
+// asked to stop before its whole process tree is killed
+const killGracePeriod = 10 * time.Second
+
+// running are the commands that are currently running. They are tracked so
+// that Cancel can stop them
+var running struct {
+	sync.Mutex
+	groups map[*processGroup]chan struct{}
+}
+
+// track records that the command of g has started. The returned func must be
+// called once it has exited
+func track(g *processGroup) func() {
+	done := make(chan struct{})
+	running.Lock()
+	if running.groups == nil {
+		running.groups = map[*processGroup]chan struct{}{}
+	}
+	running.groups[g] = done
+	running.Unlock()
+	return func() {
+		running.Lock()
+		delete(running.groups, g)
+		running.Unlock()
+		close(done)
+	}
+}
+
+// Cancel stops every running command along with any processes they started
+// and waits for them to exit
+func Cancel() {
+	running.Lock()
+	var waits []chan struct{}
+	for g, done := range running.groups {
+		_ = g.terminate()
+		waits = append(waits, done)
+	}
+	running.Unlock()
+	for _, done := range waits {
+		<-done
+	}
+}
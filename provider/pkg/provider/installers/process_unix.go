@@ -0,0 +1,99 @@
+//go:build !windows
+
+package installers
+
+import (
+	"errors"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// processGroup is a command that runs in its own process group so that
+// cancelling it also stops the processes it starts, e.g. the npm started by
+// /bin/sh -c
+type processGroup struct {
+	cmd *exec.Cmd
+
+	mu sync.Mutex
+	// gone is set once no process of the group is left, after that the
+	// process group id can be reused by the OS
+	gone   bool
+	kill   *time.Timer
+	killed chan struct{}
+}
+
+func newProcessGroup(cmd *exec.Cmd) *processGroup {
+	g := &processGroup{cmd: cmd, killed: make(chan struct{})}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = g.terminate
+	cmd.WaitDelay = killGracePeriod
+	return g
+}
+
+func (g *processGroup) start() error {
+	return g.cmd.Start()
+}
+
+// wait waits for the command to exit. If it was terminated then the processes
+// it started may still be running, e.g. because they ignore SIGTERM, so this
+// also waits until the group is empty or the SIGKILL was sent
+func (g *processGroup) wait() error {
+	err := g.cmd.Wait()
+	g.mu.Lock()
+	kill := g.kill
+	if kill == nil {
+		g.gone = true
+	}
+	g.mu.Unlock()
+	if kill == nil {
+		return err
+	}
+
+	pgid := g.cmd.Process.Pid
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		// the process group id is not reused while any process of the group
+		// is left, so it is safe to signal it until this fails
+		if err := syscall.Kill(-pgid, 0); errors.Is(err, syscall.ESRCH) {
+			break
+		}
+		select {
+		case <-g.killed:
+			return err
+		case <-ticker.C:
+		}
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.gone = true
+	kill.Stop()
+	return err
+}
+
+// terminate sends SIGTERM to the process group and then SIGKILL once the
+// grace period is over, which also gets processes that ignore SIGTERM
+func (g *processGroup) terminate() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.gone || g.cmd.Process == nil {
+		return nil
+	}
+	pgid := g.cmd.Process.Pid
+	if g.kill == nil {
+		g.kill = time.AfterFunc(killGracePeriod, func() {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			if !g.gone {
+				_ = syscall.Kill(-pgid, syscall.SIGKILL)
+			}
+			close(g.killed)
+		})
+	}
+	if err := syscall.Kill(-pgid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+//go:build windows
+
+package installers
+
+import (
+	"os/exec"
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// processGroup is a command that runs in a job object so that cancelling it
+// also stops the processes it starts, e.g. the npm started by cmd /C
+type processGroup struct {
+	cmd *exec.Cmd
+
+	mu  sync.Mutex
+	job windows.Handle
+}
+
+func newProcessGroup(cmd *exec.Cmd) *processGroup {
+	g := &processGroup{cmd: cmd}
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+	cmd.Cancel = g.terminate
+	cmd.WaitDelay = killGracePeriod
+	return g
+}
+
+// start starts the command and puts it in a job object, which the processes
+// it starts join too. If that fails the command still runs and terminate
+// falls back to killing just the command
+func (g *processGroup) start() error {
+	if err := g.cmd.Start(); err != nil {
+		return err
+	}
+	job, err := windows.CreateJobObject(nil, nil)
+	if err != nil {
+		return nil
+	}
+	process, err := windows.OpenProcess(windows.PROCESS_SET_QUOTA|windows.PROCESS_TERMINATE, false, uint32(g.cmd.Process.Pid))
+	if err != nil {
+		_ = windows.CloseHandle(job)
+		return nil
+	}
+	defer windows.CloseHandle(process)
+	if err := windows.AssignProcessToJobObject(job, process); err != nil {
+		_ = windows.CloseHandle(job)
+		return nil
+	}
+	g.mu.Lock()
+	g.job = job
+	g.mu.Unlock()
+	return nil
+}
+
+func (g *processGroup) wait() error {
+	err := g.cmd.Wait()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.job != 0 {
+		_ = windows.CloseHandle(g.job)
+		g.job = 0
+	}
+	return err
+}
+
+// terminate kills the process tree of the command. Windows has no equivalent
+// of SIGTERM for console processes so there is no grace period
+func (g *processGroup) terminate() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.job != 0 {
+		return windows.TerminateJobObject(g.job, 1)
+	}
+	if g.cmd.Process == nil {
+		return nil
+	}
+	return g.cmd.Process.Kill()
+}
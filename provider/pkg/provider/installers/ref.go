@@ -0,0 +1,189 @@
+package installers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// gitRef is a branch or tag and the commit it points to
+type gitRef struct {
+	// name is the short name of the ref, e.g. main or v1.2.3
+	name   string
+	tag    bool
+	commit string
+}
+
+// referenceName returns the full name of the ref, e.g. refs/tags/v1.2.3
+func (r gitRef) referenceName() plumbing.ReferenceName {
+	if r.tag {
+		return plumbing.NewTagReferenceName(r.name)
+	}
+	return plumbing.NewBranchReferenceName(r.name)
+}
+
+// newGitRefs returns the branches and tags in refs. Annotated tags point to a
+// tag object so the peeled refs, e.g. refs/tags/v1.2.3^{}, are used to find
+// their commit. remote is the prefix of remote branches, e.g. refs/remotes/origin/,
+// or refs/heads/ for the refs of a remote
+func newGitRefs(refs []*plumbing.Reference, remote string) []gitRef {
+	byName := map[string]gitRef{}
+	peeled := map[string]string{}
+	for _, ref := range refs {
+		name := ref.Name().String()
+		switch {
+		case strings.HasPrefix(name, "refs/tags/") && strings.HasSuffix(name, "^{}"):
+			peeled[strings.TrimSuffix(strings.TrimPrefix(name, "refs/tags/"), "^{}")] = ref.Hash().String()
+		case ref.Name().IsTag():
+			byName["tag:"+ref.Name().Short()] = gitRef{name: ref.Name().Short(), tag: true, commit: ref.Hash().String()}
+		case strings.HasPrefix(name, remote) && ref.Type() == plumbing.HashReference:
+			short := strings.TrimPrefix(name, remote)
+			byName["branch:"+short] = gitRef{name: short, commit: ref.Hash().String()}
+		}
+	}
+	var result []gitRef
+	for _, r := range byName {
+		if commit, ok := peeled[r.name]; ok && r.tag {
+			r.commit = commit
+		}
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].name < result[j].name })
+	return result
+}
+
+// localGitRefs returns the tags and the branches of remote in a clone. The
+// tags are peeled so that they point to a commit
+func localGitRefs(repo *git.Repository, remote string) ([]gitRef, error) {
+	iter, err := repo.References()
+	if err != nil {
+		return nil, gitError("show-ref", "", err)
+	}
+	var refs []*plumbing.Reference
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Name().IsTag() {
+			if tag, err := repo.TagObject(ref.Hash()); err == nil {
+				commit, err := tag.Commit()
+				if err != nil {
+					return err
+				}
+				refs = append(refs, plumbing.NewReferenceFromStrings(ref.Name().String()+"^{}", commit.Hash.String()))
+			}
+		}
+		refs = append(refs, ref)
+		return nil
+	})
+	if err != nil {
+		return nil, gitError("show-ref", "", err)
+	}
+	return newGitRefs(refs, "refs/remotes/"+remote+"/"), nil
+}
+
+// isConstraint returns whether ref is a semver constraint, e.g. ~0.10 or
+// >=1.2.0 <2.0.0, rather than the name of a tag or branch
+func isConstraint(ref string) bool {
+	return strings.ContainsAny(ref[:1], "~^<>=!") || strings.Contains(ref, " ")
+}
+
+// resolveRef finds ref in refs. ref is a tag, a branch or a semver constraint
+// over the tags. Tags are preferred over branches with the same name and the
+// highest tag that matches a constraint is used. Tags that aren't a semver
+// version, or are a prerelease, never match a constraint
+func resolveRef(refs []gitRef, ref string) (gitRef, error) {
+	if ref == "" {
+		return gitRef{}, fmt.Errorf("ref is empty")
+	}
+	if !isConstraint(ref) {
+		for _, tag := range []bool{true, false} {
+			for _, r := range refs {
+				if r.name == ref && r.tag == tag {
+					return r, nil
+				}
+			}
+		}
+		return gitRef{}, gitError("resolve", ref, fmt.Errorf("no tag or branch is called %s: %w", ref, plumbing.ErrReferenceNotFound))
+	}
+
+	constraint, err := parseConstraint(ref)
+	if err != nil {
+		return gitRef{}, err
+	}
+	var best *gitRef
+	var bestVersion semver.Version
+	for i, r := range refs {
+		if !r.tag {
+			continue
+		}
+		v, err := semver.ParseTolerant(r.name)
+		if err != nil || len(v.Pre) > 0 || !constraint(v) {
+			continue
+		}
+		if best == nil || v.GT(bestVersion) {
+			best = &refs[i]
+			bestVersion = v
+		}
+	}
+	if best == nil {
+		return gitRef{}, gitError("resolve", ref, fmt.Errorf("no tag matches %s: %w", ref, plumbing.ErrReferenceNotFound))
+	}
+	return *best, nil
+}
+
+// parseConstraint parses a semver constraint. This is a range as understood by
+// semver.ParseRange, e.g. ">=1.2.0 <2.0.0", where each version can also use the
+// ~ and ^ operators from npm. ~1.2.3 allows patch releases (>=1.2.3 <1.3.0) and
+// ^1.2.3 allows minor releases (>=1.2.3 <2.0.0) unless the major version is 0.
+// Versions can be partial and have a v prefix, e.g. ~v0.10 is >=0.10.0 <0.11.0
+func parseConstraint(constraint string) (semver.Range, error) {
+	var parts []string
+	for _, field := range strings.Fields(constraint) {
+		version := strings.TrimLeft(field, "~^<>=!")
+		op := strings.TrimSuffix(field, version)
+		switch {
+		case field == "||":
+			parts = append(parts, field)
+		case op == "~" || op == "^":
+			lower, upper, err := expandConstraint(op, version)
+			if err != nil {
+				return nil, fmt.Errorf("invalid constraint %q: %w", constraint, err)
+			}
+			parts = append(parts, ">="+lower.String(), "<"+upper.String())
+		default:
+			v, err := semver.ParseTolerant(version)
+			if err != nil {
+				return nil, fmt.Errorf("invalid constraint %q: %w", constraint, err)
+			}
+			parts = append(parts, op+v.String())
+		}
+	}
+	r, err := semver.ParseRange(strings.Join(parts, " "))
+	if err != nil {
+		return nil, fmt.Errorf("invalid constraint %q: %w", constraint, err)
+	}
+	return r, nil
+}
+
+// expandConstraint returns the bounds of a ~ or ^ constraint. The upper bound
+// is exclusive
+func expandConstraint(op, version string) (semver.Version, semver.Version, error) {
+	segments := len(strings.Split(strings.TrimPrefix(version, "v"), "."))
+	lower, err := semver.ParseTolerant(version)
+	if err != nil {
+		return semver.Version{}, semver.Version{}, err
+	}
+	upper := semver.Version{Major: lower.Major + 1}
+	switch {
+	case op == "~" && segments > 1:
+		upper = semver.Version{Major: lower.Major, Minor: lower.Minor + 1}
+	case op == "^" && lower.Major == 0 && segments > 1:
+		upper = semver.Version{Minor: lower.Minor + 1}
+		if lower.Minor == 0 && segments > 2 {
+			upper = semver.Version{Patch: lower.Patch + 1}
+		}
+	}
+	return lower, upper, nil
+}
@@ -0,0 +1,164 @@
+package installers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// gitOrigin is the remote that a repo is cloned from
+const gitOrigin = "origin"
+
+// GitRemote is a remote of a clone, e.g. the upstream of a fork
+type GitRemote struct {
+	URL     string  `pulumi:"url"`
+	PushURL *string `pulumi:"pushUrl,optional"`
+}
+
+func (r *GitRemote) Annotate(a infer.Annotator) {
+	a.Describe(&r.URL, "The URL to fetch from")
+	a.Describe(&r.PushURL, "The URL to push to. Defaults to url")
+}
+
+func remotesEqual(a, b *map[string]GitRemote) bool {
+	var as, bs map[string]GitRemote
+	if a != nil {
+		as = *a
+	}
+	if b != nil {
+		bs = *b
+	}
+	if len(as) != len(bs) {
+		return false
+	}
+	for name, r := range as {
+		other, ok := bs[name]
+		if !ok || r.URL != other.URL || !ptrEqual(r.PushURL, other.PushURL) {
+			return false
+		}
+	}
+	return true
+}
+
+// gitSetRemotes adds remotes to the clone in dir, or changes their urls if
+// they already exist
+func gitSetRemotes(dir string, remotes map[string]GitRemote) error {
+	repo, err := gitOpen(dir)
+	if err != nil {
+		return err
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return gitError("remote", "", err)
+	}
+	for name, r := range remotes {
+		rc, ok := cfg.Remotes[name]
+		if !ok {
+			rc = &config.RemoteConfig{
+				Name:  name,
+				Fetch: []config.RefSpec{config.RefSpec(fmt.Sprintf("+refs/heads/*:refs/remotes/%s/*", name))},
+			}
+			cfg.Remotes[name] = rc
+		}
+		rc.URLs = []string{r.URL}
+	}
+	if err := repo.SetConfig(cfg); err != nil {
+		return gitError("remote", "", err)
+	}
+
+	// go-git doesn't know about push urls so they are set in the raw config.
+	// It only keeps the raw options of remotes that were already written
+	cfg, err = repo.Config()
+	if err != nil {
+		return gitError("remote", "", err)
+	}
+	for name, r := range remotes {
+		sub := cfg.Raw.Section("remote").Subsection(name)
+		if r.PushURL != nil {
+			sub.SetOption("pushurl", *r.PushURL)
+		} else {
+			sub.RemoveOption("pushurl")
+		}
+	}
+	return gitError("remote", "", repo.SetConfig(cfg))
+}
+
+// gitRemoveRemotes removes the remotes in previous that aren't in remotes
+// any more from the clone in dir, along with their branches. Remotes that
+// were added by hand are left alone
+func gitRemoveRemotes(dir string, remotes, previous map[string]GitRemote) error {
+	repo, err := gitOpen(dir)
+	if err != nil {
+		return err
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return gitError("remote", "", err)
+	}
+	for name := range previous {
+		if _, ok := remotes[name]; ok || name == gitOrigin {
+			continue
+		}
+		delete(cfg.Remotes, name)
+		if err := gitRemoveRemoteBranches(repo, name); err != nil {
+			return err
+		}
+	}
+	return gitError("remote", "", repo.SetConfig(cfg))
+}
+
+// gitRemoveRemoteBranches removes the remote branches of a remote, like git
+// remote remove does
+func gitRemoveRemoteBranches(repo *git.Repository, name string) error {
+	refs, err := repo.References()
+	if err != nil {
+		return gitError("remote", name, err)
+	}
+	var branches []plumbing.ReferenceName
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if strings.HasPrefix(ref.Name().String(), "refs/remotes/"+name+"/") {
+			branches = append(branches, ref.Name())
+		}
+		return nil
+	})
+	if err != nil {
+		return gitError("remote", name, err)
+	}
+	for _, branch := range branches {
+		if err := repo.Storer.RemoveReference(branch); err != nil {
+			return gitError("remote", name, err)
+		}
+	}
+	return nil
+}
+
+// gitReadRemotes returns the remotes of the clone in dir that are in
+// remotes, as they are actually configured. Remotes that were removed are
+// left out
+func gitReadRemotes(dir string, remotes map[string]GitRemote) (map[string]GitRemote, error) {
+	repo, err := gitOpen(dir)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return nil, gitError("remote", "", err)
+	}
+	actual := map[string]GitRemote{}
+	for name := range remotes {
+		rc, ok := cfg.Remotes[name]
+		if !ok || len(rc.URLs) == 0 {
+			continue
+		}
+		r := GitRemote{URL: rc.URLs[0]}
+		if push := cfg.Raw.Section("remote").Subsection(name).Option("pushurl"); push != "" {
+			r.PushURL = &push
+		}
+		actual[name] = r
+	}
+	return actual, nil
+}
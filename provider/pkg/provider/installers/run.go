@@ -16,7 +16,65 @@ import (
 	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
 )
 
-func (c *CommandOutputs) run(ctx p.Context, command, dir string) (string, error) {
+// execOptions are the options used to execute a command
+type execOptions struct {
+	args  []string
+	env   []string
+	stdin *string
+	dir   string
+	// clean runs the command with cleanEnv instead of the environment of
+	// the provider
+	clean bool
+	// command is only used for error messages so that it is never
+	// something sensitive
+	command string
+}
+
+// run runs a command that the provider needs, e.g. a download or a version
+// probe, as the user that runs the provider. Only inheritEnvironment is used
+// from b
+func (c *CommandOutputs) run(ctx p.Context, b BaseInputs, command, dir string) (string, error) {
+	stdout, _, err := c.execWith(ctx, BaseInputs{InheritEnvironment: b.InheritEnvironment}, c.args(command), nil, command, dir)
+	return stdout, err
+}
+
+// runWith runs user provided commands using the options in b, e.g. with
+// elevated privileges if become is set and writing stdin to the process
+func (c *CommandOutputs) runWith(ctx p.Context, b BaseInputs, command, dir string) (string, error) {
+	stdout, _, err := c.execWith(ctx, b, c.args(command), nil, command, dir)
+	return stdout, err
+}
+
+// execWith executes args using the options in b and returns the stdout and
+// stderr of the command. env is added to the environment of the command and
+// command is what is shown in error messages
+func (c *CommandOutputs) execWith(ctx p.Context, b BaseInputs, args, env []string, command, dir string) (string, string, error) {
+	opts := execOptions{
+		args:    args,
+		env:     env,
+		stdin:   b.Stdin,
+		dir:     dir,
+		command: command,
+		clean:   b.InheritEnvironment != nil && !*b.InheritEnvironment,
+	}
+	if b.Become != nil && *b.Become {
+		// sudo resets the environment so env is passed through env(1)
+		if len(env) > 0 {
+			opts.args = append(append([]string{"env"}, env...), opts.args...)
+		}
+		args, becomeEnv, cleanup, err := becomeArgs(ctx, b.BecomeMethod, opts.args)
+		if err != nil {
+			return "", "", err
+		}
+		defer cleanup()
+		opts.args = args
+		opts.env = append(opts.env, becomeEnv...)
+	}
+	return c.exec(ctx, opts)
+}
+
+// args returns the interpreter arguments used to run the command
+func (c *CommandOutputs) args(command string) []string {
 	var args []string
 	if c.Interpreter != nil && len(*c.Interpreter) > 0 {
 		args = append(args, *c.Interpreter...)
@@ -27,20 +85,31 @@ func (c *CommandOutputs) run(ctx p.Context, command, dir string) (string, error)
 			args = []string{"/bin/sh", "-c"}
 		}
 	}
-	args = append(args, command)
+	return append(args, command)
+}
 
+func (c *CommandOutputs) exec(ctx p.Context, opts execOptions) (string, string, error) {
 	var err error
 	var stdoutbuf, stderrbuf, stdouterrbuf bytes.Buffer
 	stdouterrwriter := util.ConcurrentWriter{Writer: &stdouterrbuf}
 	r, w := io.Pipe()
 
-	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
-	if dir != "" {
-		cmd.Dir = dir
+	cmd := exec.CommandContext(ctx, opts.args[0], opts.args[1:]...)
+	if opts.dir != "" {
+		cmd.Dir = opts.dir
+	}
+	// the whole input is available before the process starts so that
+	// installers which prompt for input read it straight away
+	if opts.stdin != nil {
+		cmd.Stdin = strings.NewReader(*opts.stdin)
 	}
 	cmd.Stdout = io.MultiWriter(&stdoutbuf, &stdouterrwriter, w)
 	cmd.Stderr = io.MultiWriter(&stderrbuf, &stdouterrwriter, w)
-	cmd.Env = os.Environ()
+	if opts.clean {
+		cmd.Env = append(cleanEnv(ctx), opts.env...)
+	} else {
+		cmd.Env = append(os.Environ(), opts.env...)
+	}
 	// if c.Environment != nil {
 	// 	for k, v := range *c.Environment {
 	// 		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
@@ -50,18 +119,22 @@ func (c *CommandOutputs) run(ctx p.Context, command, dir string) (string, error)
 	stdouterrch := make(chan struct{})
 	go util.CopyOutput(ctx, r, stdouterrch, diag.Debug)
 
-	err = cmd.Start()
+	defer lockInstalls(ctx)()
+	g := newProcessGroup(cmd)
+	err = g.start()
 	if err == nil {
-		err = cmd.Wait()
+		done := track(g)
+		err = g.wait()
+		done()
 	}
 
 	w.Close()
 	<-stdouterrch
 
 	if err != nil {
-		return "", fmt.Errorf("%w: running %q:\n%s", err, command, stdouterrbuf.String())
+		return "", stderrbuf.String(), fmt.Errorf("%w: running %q:\n%s", err, opts.command, stdouterrbuf.String())
 	}
 
-	return strings.TrimSuffix(stdoutbuf.String(), "\n"), nil
+	return strings.TrimSuffix(stdoutbuf.String(), "\n"), strings.TrimSuffix(stderrbuf.String(), "\n"), nil
 	// return nil
 }
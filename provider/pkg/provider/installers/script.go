@@ -0,0 +1,320 @@
+package installers
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+type Script struct{}
+
+var _ = (infer.CustomRead[ScriptArgs, ScriptState])((*Script)(nil))
+var _ = (infer.CustomUpdate[ScriptArgs, ScriptState])((*Script)(nil))
+var _ = (infer.CustomDiff[ScriptArgs, ScriptState])((*Script)(nil))
+var _ = (infer.CustomDelete[ScriptState])((*Script)(nil))
+var _ = (infer.CustomCheck[ScriptArgs])((*Script)(nil))
+
+type ScriptArgs struct {
+	BaseInputs
+	Create      string             `pulumi:"create"`
+	Update      *string            `pulumi:"update,optional"`
+	Delete      *string            `pulumi:"delete,optional"`
+	Read        *string            `pulumi:"read,optional"`
+	Dir         *string            `pulumi:"dir,optional"`
+	Interpreter *[]string          `pulumi:"interpreter,optional"`
+	Environment *map[string]string `pulumi:"environment,optional"`
+}
+
+type ScriptState struct {
+	ScriptArgs
+	CommandOutputs
+}
+
+func (s *Script) Annotate(a infer.Annotator) {
+	a.Describe(&s, `
+Run inline scripts to install, update and uninstall something.
+
+Each script is written to a private temporary file and run using
+interpreter, or the interpreter from its shebang, e.g. #!/usr/bin/env python3.
+Scripts without either are run with /bin/sh.`)
+}
+
+func (s *ScriptArgs) Annotate(a infer.Annotator) {
+	a.Describe(&s.Create, "The script to run when the resource is created")
+	a.Describe(&s.Update, `The script to run when the create or update script changes. It is followed by
+				updateCommands and updateSteps. If none of them are provided then changing the create script
+				will replace the resource`)
+	a.Describe(&s.Delete, "The script to run when the resource is deleted. It is followed by uninstallCommands and uninstallSteps")
+	a.Describe(&s.Read, `The script to run to read the state of the resource. This runs after create,
+				update and during refresh. If it prints a JSON object then that becomes the outputs of the resource`)
+	a.Describe(&s.Dir, "The directory to run the scripts in")
+	a.Describe(&s.Interpreter, `The interpreter to run the scripts with, e.g. ['python3', '-u']. The path of the script
+				is added as the last argument. Defaults to the shebang of each script, or /bin/sh`)
+	a.Describe(&s.Environment, "The environment variables to set when running the scripts")
+}
+
+func (s *Script) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (ScriptArgs, []p.CheckFailure, error) {
+	if failures := checkBase(ctx, newInputs); len(failures) > 0 {
+		return ScriptArgs{}, failures, nil
+	}
+	return infer.DefaultCheck[ScriptArgs](newInputs)
+}
+
+func (s *Script) Diff(ctx p.Context, id string, olds ScriptState, news ScriptArgs) (p.DiffResponse, error) {
+	diff := map[string]p.PropertyDiff{}
+
+	if news.Create != olds.Create {
+		if news.canUpdate() {
+			diff["create"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+		} else {
+			diff["create"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
+		}
+	}
+	if !ptrEqual(news.Update, olds.Update) {
+		diff["update"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.Delete, olds.Delete) {
+		diff["delete"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.Read, olds.Read) {
+		diff["read"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !ptrEqual(news.Dir, olds.Dir) {
+		diff["dir"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !commandsEqual(news.Interpreter, olds.Interpreter) {
+		diff["interpreter"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	if !envEqual(news.Environment, olds.Environment) {
+		diff["environment"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+	}
+	news.BaseInputs.diff(olds.BaseInputs, diff)
+
+	return p.DiffResponse{
+		DeleteBeforeReplace: true,
+		HasChanges:          len(diff) > 0,
+		DetailedDiff:        diff,
+	}, nil
+}
+
+func (s *Script) Create(ctx p.Context, name string, input ScriptArgs, preview bool) (string, ScriptState, error) {
+	state := &ScriptState{
+		ScriptArgs: input,
+	}
+	if preview {
+		return name, *state, nil
+	}
+
+	if err := state.runHook(ctx, input.BaseInputs, "preInstall", input.PreInstall, nil, state.dir()); err != nil {
+		return "", ScriptState{}, err
+	}
+	stdout, err := state.script(ctx, "create", input.Create)
+	if err == nil {
+		err = state.outputsFrom(input.BaseInputs, stdout)
+	}
+	if err != nil {
+		return "", ScriptState{}, err
+	}
+	if err := state.verify(ctx, input.BaseInputs, input.Verify, nil, state.dir()); err != nil {
+		// don't leave a broken install behind
+		if input.Delete != nil {
+			_, derr := state.script(ctx, "delete", *input.Delete)
+			err = errors.Join(err, derr)
+		}
+		return "", ScriptState{}, err
+	}
+	if err := state.runHook(ctx, input.BaseInputs, "postInstall", input.PostInstall, nil, state.dir()); err != nil {
+		return "", ScriptState{}, err
+	}
+	if err := state.read(ctx); err != nil {
+		return "", ScriptState{}, err
+	}
+	return name, *state, nil
+}
+
+// Read runs the read script so that a refresh picks up any changes to the
+// outputs
+func (s *Script) Read(ctx p.Context, id string, inputs ScriptArgs, state ScriptState) (
+	canonicalID string, normalizedInputs ScriptArgs, normalizedState ScriptState, err error) {
+
+	if err := state.read(ctx); err != nil {
+		return "", ScriptArgs{}, ScriptState{}, err
+	}
+	return id, inputs, state, nil
+}
+
+func (s *Script) Update(ctx p.Context, name string, olds ScriptState, news ScriptArgs, preview bool) (ScriptState, error) {
+	state := &ScriptState{
+		ScriptArgs:     news,
+		CommandOutputs: olds.CommandOutputs,
+	}
+	if preview {
+		return *state, nil
+	}
+
+	// only the create and update scripts and commands change what is
+	// installed, a change to any other input only needs to be stored in state
+	updateSteps, hasUpdateSteps := news.updateSteps()
+	changed := news.Create != olds.Create || !ptrEqual(news.Update, olds.Update) ||
+		!commandsEqual(news.UpdateCommands, olds.UpdateCommands) || !stepsEqual(news.UpdateSteps, olds.UpdateSteps)
+	if news.canUpdate() && changed {
+		if err := state.runHook(ctx, news.BaseInputs, "preInstall", news.PreInstall, nil, state.dir()); err != nil {
+			return ScriptState{}, err
+		}
+		if news.Update != nil {
+			stdout, err := state.script(ctx, "update", *news.Update)
+			if err == nil {
+				err = state.outputsFrom(news.BaseInputs, stdout)
+			}
+			if err != nil {
+				return ScriptState{}, err
+			}
+		}
+		if hasUpdateSteps {
+			if err := state.runSteps(ctx, news.BaseInputs, updateSteps, state.dir()); err != nil {
+				return ScriptState{}, err
+			}
+		}
+		// scripts can't be undone so a broken update is only reported
+		if err := state.verify(ctx, news.BaseInputs, news.Verify, nil, state.dir()); err != nil {
+			return ScriptState{}, err
+		}
+		if err := state.runHook(ctx, news.BaseInputs, "postInstall", news.PostInstall, nil, state.dir()); err != nil {
+			return ScriptState{}, err
+		}
+	}
+	if err := state.read(ctx); err != nil {
+		return ScriptState{}, err
+	}
+	return *state, nil
+}
+
+func (s *Script) Delete(ctx p.Context, id string, props ScriptState) error {
+	if err := props.runHook(ctx, props.BaseInputs, "preUninstall", props.PreUninstall, nil, props.dir()); err != nil {
+		return err
+	}
+	if props.ScriptArgs.Delete != nil {
+		if _, err := props.script(ctx, "delete", *props.ScriptArgs.Delete); err != nil {
+			return err
+		}
+	}
+	if err := props.runSteps(ctx, props.BaseInputs, props.uninstallSteps(), props.dir()); err != nil {
+		return err
+	}
+	return props.runHook(ctx, props.BaseInputs, "postUninstall", props.PostUninstall, nil, props.dir())
+}
+
+// canUpdate returns whether there is an update script or update commands to
+// run when the create script changes
+func (s *ScriptArgs) canUpdate() bool {
+	_, ok := s.updateSteps()
+	return s.Update != nil || ok
+}
+
+// dir returns the directory to run the scripts in, or "" for the working
+// directory of the provider
+func (s *ScriptState) dir() string {
+	if s.Dir == nil {
+		return ""
+	}
+	return *s.Dir
+}
+
+// read runs the read script, if there is one, and stores the JSON object it
+// prints in Outputs
+func (s *ScriptState) read(ctx p.Context) error {
+	if s.Read == nil {
+		return nil
+	}
+	output, err := s.script(ctx, "read", *s.Read)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(output) == "" {
+		s.Outputs = nil
+		return nil
+	}
+	outputs, err := parseOutputs(output)
+	if err != nil {
+		return fmt.Errorf("read script: %w", err)
+	}
+	s.Outputs = &outputs
+	return nil
+}
+
+// script runs one of the scripts using the options from the inputs
+func (s *ScriptState) script(ctx p.Context, name, body string) (string, error) {
+	interpreter := scriptInterpreter(body)
+	if s.Interpreter != nil && len(*s.Interpreter) > 0 {
+		interpreter = *s.Interpreter
+	}
+	var env []string
+	if s.Environment != nil {
+		for k, v := range *s.Environment {
+			env = append(env, k+"="+v)
+		}
+		sort.Strings(env)
+	}
+	return s.CommandOutputs.runScript(ctx, s.BaseInputs, name, body, s.dir(), interpreter, env)
+}
+
+// runScript writes the script body to a private temp file and runs it with
+// interpreter. env is added to the environment of the script
+func (c *CommandOutputs) runScript(ctx p.Context, b BaseInputs, name, body, dir string, interpreter, env []string) (string, error) {
+	f, err := os.CreateTemp("", "pde-script-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	if err := f.Chmod(0700); err != nil {
+		f.Close()
+		return "", err
+	}
+	if _, err := f.WriteString(body); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	args := append(slices.Clone(interpreter), f.Name())
+	stdout, _, err := c.execWith(ctx, b, args, env, fmt.Sprintf("%s script", name), dir)
+	return stdout, err
+}
+
+// scriptInterpreter returns the interpreter from the shebang of the script.
+// The interpreter is run directly rather than executing the file so that
+// scripts still work when the temp dir is mounted noexec
+func scriptInterpreter(body string) []string {
+	line, _, _ := strings.Cut(body, "\n")
+	if !strings.HasPrefix(line, "#!") {
+		return []string{"/bin/sh"}
+	}
+	interpreter := strings.Fields(strings.TrimPrefix(line, "#!"))
+	if len(interpreter) == 0 {
+		return []string{"/bin/sh"}
+	}
+	return interpreter
+}
+
+// envEqual compares environments, nil is the same as empty
+func envEqual(a, b *map[string]string) bool {
+	var as, bs map[string]string
+	if a != nil {
+		as = *a
+	}
+	if b != nil {
+		bs = *b
+	}
+	return maps.Equal(as, bs)
+}
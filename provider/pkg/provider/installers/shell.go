@@ -1,41 +1,57 @@
 package installers
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path"
-	"strings"
+	"regexp"
 
 	p "github.com/pulumi/pulumi-go-provider"
 
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
 type Shell struct{}
 
+var _ = (infer.CustomRead[ShellArgs, ShellState])((*Shell)(nil))
 var _ = (infer.CustomUpdate[ShellArgs, ShellState])((*Shell)(nil))
 var _ = (infer.CustomDiff[ShellArgs, ShellState])((*Shell)(nil))
 var _ = (infer.CustomDelete[ShellState])((*Shell)(nil))
 var _ = (infer.CustomCheck[ShellArgs])((*Shell)(nil))
+var _ = (infer.ExplicitDependencies[ShellArgs, ShellState])((*Shell)(nil))
 
 type ShellArgs struct {
 	BaseInputs
 	InstallCommands []string           `pulumi:"installCommands"`
+	InstallSteps    *[]Step            `pulumi:"installSteps,optional"`
 	ProgramName     string             `pulumi:"programName"`
 	DownloadURL     string             `pulumi:"downloadURL"`
+	DesiredVersion  *string            `pulumi:"desiredVersion,optional"`
+	Platform        *string            `pulumi:"platform,optional"`
 	Environment     *map[string]string `pulumi:"environment,optional"`
 	Interpreter     *[]string          `pulumi:"interpreter,optional"`
 	VersionCommand  *string            `pulumi:"versionCommand,optional"`
+	VersionRegex    *string            `pulumi:"versionRegex,optional"`
 	BinLocation     *string            `pulumi:"binLocation,optional"`
 	Executable      *bool              `pulumi:"executable,optional"`
+	WorkingDir      *string            `pulumi:"workingDir,optional"`
+	Workspace       *bool              `pulumi:"workspace,optional"`
+	Retain          *bool              `pulumi:"retain,optional"`
+	TrackChanges    *bool              `pulumi:"trackChanges,optional"`
+	TrackPaths      *[]string          `pulumi:"trackPaths,optional"`
 }
 
 type ShellState struct {
 	ShellArgs
 	CommandOutputs
 	BaseOutputs
-	Location *string `pulumi:"location,optional"`
+	Location     *string   `pulumi:"location,optional"`
+	WorkspaceDir *string   `pulumi:"workspaceDir,optional"`
+	Plan         *Plan     `pulumi:"plan,optional"`
+	CreatedPaths *[]string `pulumi:"createdPaths,optional"`
 }
 
 func (s *Shell) Annotate(a infer.Annotator) {
@@ -43,72 +59,112 @@ func (s *Shell) Annotate(a infer.Annotator) {
 }
 
 func (s *ShellArgs) Annotate(a infer.Annotator) {
-	a.Describe(&s.InstallCommands, "The commands to run to install the program")
+	a.Describe(&s.InstallCommands, "The commands to run to install the program. Each command is run as a separate step")
+	a.Describe(&s.InstallSteps, "The steps to run to install the program. These are run after installCommands")
 	a.Describe(&s.ProgramName, "The name of the program. This is the name you would use to execute the program")
-	a.Describe(&s.DownloadURL, "The URL to download the program from")
+	a.Describe(&s.DownloadURL, `The URL to download the program from. This is a go template which can use
+				{{.Version}}, {{.OS}}, {{.Arch}} and {{.ArchAlias "x86_64"}}. ArchAlias returns the host
+				architecture using the naming scheme where amd64 is called by the given name, e.g. x86_64/aarch64`)
+	a.Describe(&s.DesiredVersion, `The version of the program to install. This is available in downloadURL as
+				{{.Version}}. The version that versionCommand reports is in the version output`)
+	a.Describe(&s.Platform, `The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+				used for {{.OS}} and {{.Arch}} in downloadURL and an executable that is built for another
+				platform is refused before it is installed. Defaults to the platform of the host`)
 	a.Describe(&s.Environment, "The environment variables to set when running the commands")
 	a.Describe(&s.Interpreter, "The interpreter to use to run the install commands. Defaults to ['/bin/sh', '-c']")
 	a.Describe(&s.VersionCommand, "The command to run to get the version of the program. This is needed if you want to keep track of the version in state")
+	a.Describe(&s.VersionRegex, `A regex used to extract the version from the output of versionCommand.
+				If the regex contains a capture group then the first group is used, otherwise the whole match is used`)
 	a.Describe(&s.BinLocation, "The location to put the program. Defaults to $HOME/.local/bin")
 	a.Describe(&s.Executable, "Whether the program that is download is an executable")
+	a.Describe(&s.WorkingDir, `The directory to download the program to and run the commands in. This directory is never
+				removed by the resource. Defaults to a temporary directory`)
+	a.Describe(&s.Workspace, `Whether to create a managed workspace at $HOME/.local/share/pde/shell/$NAME to download the
+				program to and run the commands in. The workspace is kept between create, update and delete so it can be
+				used by installers that build in place. Ignored if workingDir is set`)
+	a.Describe(&s.Retain, "Whether to retain the managed workspace when the resource is deleted")
+	a.Describe(&s.TrackChanges, `Whether to record every path that the install creates in binLocation, $HOME/.local/share
+				and $HOME/.config so that they are all removed when the resource is deleted. This is useful for
+				installers that put files in more places than binLocation, e.g. completions or lib directories.
+				Nothing else runs commands while a tracked install runs`)
+	a.Describe(&s.TrackPaths, `The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+				set, e.g. ~/.local/share/tool. binLocation is always watched`)
 }
 
 func (s *ShellState) Annotate(a infer.Annotator) {
 	a.Describe(&s.Location, "The location the program was installed to")
+	a.Describe(&s.WorkspaceDir, "The directory the program was downloaded to and the commands were run in")
+	a.Describe(&s.Plan, "What the last create or update did. During preview this is what it will do")
+	a.Describe(&s.CreatedPaths, "The paths that were created by the install when trackChanges is set")
+}
+
+// WireDependencies keeps the plan known during preview so that it can be
+// reviewed before it is applied
+func (l *Shell) WireDependencies(f infer.FieldSelector, args *ShellArgs, state *ShellState) {
+	f.OutputField(&state.Plan).AlwaysKnown()
 }
 
 func (l *Shell) Diff(ctx p.Context, id string, olds ShellState, news ShellArgs) (p.DiffResponse, error) {
 	diff := map[string]p.PropertyDiff{}
 
 	if *news.BinLocation != *olds.BinLocation {
-		diff["binLocation"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		diff["binLocation"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
+	}
+	versionChanged := !ptrEqual(news.DesiredVersion, olds.DesiredVersion)
+	if versionChanged {
+		diff["desiredVersion"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
 	if news.DownloadURL != olds.DownloadURL {
-		diff["downloadURL"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		// the rendered downloadURL will change along with the version and
+		// that should be an update, not a replace
+		if versionChanged {
+			diff["downloadURL"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+		} else {
+			diff["downloadURL"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
+		}
+	}
+	if !ptrEqual(news.Platform, olds.Platform) {
+		diff["platform"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
 	}
 	if (news.Executable != nil && olds.Executable == nil) || (news.Executable == nil && olds.Executable != nil) {
-		diff["executable"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		diff["executable"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
 	}
 	if (news.Executable != nil && olds.Executable != nil) && *news.Executable != *olds.Executable {
-		diff["executable"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		diff["executable"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
 	}
-	var newInstall string
-	var oldInstall string
-	if news.InstallCommands != nil {
-		newInstall = strings.Join(news.InstallCommands, " && ")
+	if !commandsEqual(&news.InstallCommands, &olds.InstallCommands) {
+		diff["installCommands"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-	if olds.InstallCommands != nil {
-		oldInstall = strings.Join(olds.InstallCommands, " && ")
+	if !stepsEqual(news.InstallSteps, olds.InstallSteps) {
+		diff["installSteps"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-
-	if newInstall != oldInstall {
-		diff["installCommands"] = p.PropertyDiff{Kind: p.Update}
+	if news.ProgramName != olds.ProgramName {
+		diff["programName"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
 	}
-	var newUninstall string
-	var oldUninstall string
-	if news.UninstallCommands != nil {
-		newUninstall = strings.Join(*news.UninstallCommands, " && ")
+	if !ptrEqual(news.WorkingDir, olds.WorkingDir) {
+		diff["workingDir"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-	if olds.UninstallCommands != nil {
-		oldUninstall = strings.Join(*olds.UninstallCommands, " && ")
+	if !ptrEqual(news.Workspace, olds.Workspace) {
+		diff["workspace"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-	if newUninstall != oldUninstall {
-		diff["uninstallCommands"] = p.PropertyDiff{Kind: p.Update}
+	news.BaseInputs.diff(olds.BaseInputs, diff)
+	if !ptrEqual(news.TrackChanges, olds.TrackChanges) {
+		diff["trackChanges"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-
-	var newUpdate string
-	var oldUpdate string
-	if news.UpdateCommands != nil {
-		newUpdate = strings.Join(*news.UpdateCommands, " && ")
+	if !commandsEqual(news.TrackPaths, olds.TrackPaths) {
+		diff["trackPaths"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-	if olds.UpdateCommands != nil {
-		oldUpdate = strings.Join(*olds.UpdateCommands, " && ")
+	if !ptrEqual(news.Retain, olds.Retain) {
+		diff["retain"] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
 	}
-	if newUpdate != oldUpdate {
-		diff["updateCommands"] = p.PropertyDiff{Kind: p.Update}
+
+	// Read will clear these if the program was removed or the version probe
+	// failed outside of pulumi, in which case we need to install it again
+	if news.Executable != nil && *news.Executable && olds.Location == nil {
+		diff["location"] = p.PropertyDiff{Kind: p.UpdateReplace}
 	}
-	if news.ProgramName != olds.ProgramName {
-		diff["programName"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	if news.VersionCommand != nil && olds.Version == nil {
+		diff["version"] = p.PropertyDiff{Kind: p.Update}
 	}
 
 	return p.DiffResponse{
@@ -118,22 +174,73 @@ func (l *Shell) Diff(ctx p.Context, id string, olds ShellState, news ShellArgs)
 	}, nil
 }
 
+// Read checks that the program still exists at Location and re-runs the
+// versionCommand so that a refresh shows programs that were removed or
+// upgraded outside of pulumi
 func (l *Shell) Read(ctx p.Context, id string, inputs ShellArgs, state ShellState) (
 	canonicalID string, normalizedInputs ShellArgs, normalizedState ShellState, err error) {
+	ctx = withBinDirs(ctx, inputs.BinLocation)
+
+	if state.Location != nil {
+		if _, err := os.Lstat(*state.Location); err != nil {
+			if !os.IsNotExist(err) {
+				return "", ShellArgs{}, ShellState{}, err
+			}
+			ctx.Logf(diag.Warning, "program %s no longer exists at %s", state.ProgramName, *state.Location)
+			state.Location = nil
+		}
+	}
+
+	if inputs.VersionCommand != nil {
+		version, err := state.probeVersion(ctx, inputs, "")
+		if err != nil {
+			ctx.Logf(diag.Warning, "unable to determine the version of %s: %s", state.ProgramName, err.Error())
+			state.Version = nil
+		} else {
+			state.Version = &version
+		}
+	}
 
 	return id, inputs, state, nil
 }
 
 // All resources must implement Create at a minumum.
 func (l *Shell) Create(ctx p.Context, name string, input ShellArgs, preview bool) (string, ShellState, error) {
+	ctx = withBinDirs(ctx, input.BinLocation)
 	state := &ShellState{
 		ShellArgs: input,
 	}
+	dir, err := state.workspaceDir(name)
+	if err != nil {
+		return "", ShellState{}, err
+	}
+	state.WorkspaceDir = dir
+	state.Plan = state.plan(input, input.installSteps(), state.commandDir())
 	if preview {
 		return name, *state, nil
 	}
 
-	if err := state.createOrUpdate(ctx, input, input.InstallCommands); err != nil {
+	if err := state.setWorkspace(name); err != nil {
+		return "", ShellState{}, err
+	}
+	ctx, t, err := state.track(ctx, input)
+	if err != nil {
+		return "", ShellState{}, err
+	}
+	if err := state.createOrUpdate(ctx, input, input.installSteps()); err != nil {
+		return "", ShellState{}, errors.Join(err, t.cleanup(ctx, &state.CommandOutputs, input.BaseInputs))
+	}
+	if err := state.verifyInstall(ctx, input); err != nil {
+		// don't leave a broken program behind
+		if state.Location != nil {
+			err = errors.Join(err, state.removeFile(ctx, input.BaseInputs, *state.Location))
+		}
+		return "", ShellState{}, errors.Join(err, t.cleanup(ctx, &state.CommandOutputs, input.BaseInputs))
+	}
+	if err := t.record(&state.CreatedPaths); err != nil {
+		return "", ShellState{}, err
+	}
+	if err := state.postInstall(ctx, input); err != nil {
 		return "", ShellState{}, err
 	}
 	return name, *state, nil
@@ -151,84 +258,300 @@ func (l *Shell) Check(ctx p.Context, name string, oldInputs, newInputs resource.
 		newInputs["binLocation"] = resource.NewStringProperty(binLocation)
 	}
 
+	var platform *string
+	if v, ok := newInputs["platform"]; ok && v.IsString() {
+		ps := v.StringValue()
+		platform = &ps
+	}
+	goos, arch, err := parsePlatform(platform)
+	if err != nil {
+		fails = append(fails, p.CheckFailure{Property: "platform", Reason: err.Error()})
+	} else if url, ok := newInputs["downloadURL"]; ok && url.IsString() {
+		if v, ok := newInputs["desiredVersion"]; ok && v.IsComputed() {
+			newInputs["downloadURL"] = resource.MakeComputed(resource.NewStringProperty(""))
+		} else {
+			var version *string
+			if ok && v.IsString() {
+				vs := v.StringValue()
+				version = &vs
+			}
+			rendered, err := newPlatformData(version, goos, arch).renderTemplate("downloadURL", url.StringValue())
+			if err != nil {
+				fails = append(fails, p.CheckFailure{Property: "downloadURL", Reason: err.Error()})
+			} else {
+				newInputs["downloadURL"] = resource.NewStringProperty(rendered)
+			}
+		}
+	}
+
+	fails = append(fails, checkBase(ctx, newInputs)...)
+	if v, ok := newInputs["versionRegex"]; ok && v.IsString() {
+		if _, err := regexp.Compile(v.StringValue()); err != nil {
+			fails = append(fails, p.CheckFailure{Property: "versionRegex", Reason: err.Error()})
+		}
+	}
+
 	inputs, failures, err := infer.DefaultCheck[ShellArgs](newInputs)
+	addBinDirs(inputs.BinLocation)
 	return inputs, append(failures, fails...), err
 }
 
 func (l *Shell) Update(ctx p.Context, name string, olds ShellState, news ShellArgs, preview bool) (ShellState, error) {
+	ctx = withBinDirs(ctx, news.BinLocation)
 	state := &ShellState{
 		ShellArgs:      news,
 		Location:       olds.Location,
+		WorkspaceDir:   olds.WorkspaceDir,
 		CommandOutputs: olds.CommandOutputs,
-		BaseOutputs: BaseOutputs{
-			Version: olds.Version,
-		},
+		CreatedPaths:   olds.CreatedPaths,
+		BaseOutputs:    olds.BaseOutputs,
+	}
+	steps, ok := news.updateSteps()
+	if !ok {
+		steps = news.installSteps()
+	}
+	dir, err := state.workspaceDir(name)
+	if err != nil {
+		return ShellState{}, err
+	}
+	state.WorkspaceDir = dir
+	state.Plan = state.plan(news, steps, state.commandDir())
+	if state.Plan != nil && olds.managedWorkspace() && !ptrEqual(olds.WorkspaceDir, dir) {
+		state.Plan.Removes = append(state.Plan.Removes, *olds.WorkspaceDir)
 	}
 	if preview {
 		return *state, nil
 	}
-	var commands []string
-	if news.UpdateCommands != nil {
-		commands = *news.UpdateCommands
-	} else {
-		commands = news.InstallCommands
+	if err := state.setWorkspace(name); err != nil {
+		return ShellState{}, err
+	}
+	// the installed program is moved aside so that it can be put back if
+	// the new version is broken
+	bk := &backup{}
+	if news.Verify != nil && olds.Location != nil {
+		bk, err = state.backupFiles(ctx, news.BaseInputs, []string{*olds.Location})
+		if err != nil {
+			return ShellState{}, err
+		}
+	}
+	ctx, t, err := state.track(ctx, news)
+	if err != nil {
+		return ShellState{}, errors.Join(err, state.restore(ctx, bk))
 	}
-	if err := state.createOrUpdate(ctx, news, commands); err != nil {
+	err = state.createOrUpdate(ctx, news, steps)
+	if err == nil {
+		err = state.verifyInstall(ctx, news)
+	}
+	if err != nil {
+		err = errors.Join(err, t.cleanup(ctx, &state.CommandOutputs, news.BaseInputs))
+		return ShellState{}, errors.Join(err, state.restore(ctx, bk))
+	}
+	if err := t.record(&state.CreatedPaths); err != nil {
 		return ShellState{}, err
 	}
+	if err := state.discard(ctx, bk); err != nil {
+		return ShellState{}, err
+	}
+	if err := state.postInstall(ctx, news); err != nil {
+		return ShellState{}, err
+	}
+	// the workspace moved so the old one is no longer needed
+	if olds.managedWorkspace() && !ptrEqual(olds.WorkspaceDir, state.WorkspaceDir) {
+		if err := olds.removeWorkspace(); err != nil {
+			return ShellState{}, err
+		}
+	}
 	return *state, nil
 }
 
 func (l *Shell) Delete(ctx p.Context, id string, props ShellState) error {
-	if props.UninstallCommands != nil {
-		_, err := props.run(ctx, strings.Join(*props.UninstallCommands, " && "), "")
-		if err != nil {
-			ctx.Logf("error running uninstall commands: %s", err.Error())
-			return nil
-		}
-
+	ctx = withBinDirs(ctx, props.BinLocation)
+	var dir string
+	if props.WorkspaceDir != nil {
+		dir = *props.WorkspaceDir
+	}
+	env := props.hookEnv(props.ShellArgs, props.Version)
+	// a failed uninstall leaves the program in place so that it can be
+	// retried, steps that may fail can set continueOnError
+	if err := props.runHook(ctx, props.BaseInputs, "preUninstall", props.PreUninstall, env, dir); err != nil {
+		return err
+	}
+	if err := props.runSteps(ctx, props.BaseInputs, props.uninstallSteps(), dir); err != nil {
+		return err
 	}
 	if props.Location != nil {
-		if err := os.Remove(*props.Location); err != nil && !os.IsNotExist(err) {
+		if err := props.removeFile(ctx, props.BaseInputs, *props.Location); err != nil {
 			return err
 		}
 	}
+	if err := props.removeCreated(ctx, props.BaseInputs, props.CreatedPaths); err != nil {
+		return err
+	}
+	// the managed workspace is removed after postUninstall so that it can
+	// still be used by the hook
+	if err := props.runHook(ctx, props.BaseInputs, "postUninstall", props.PostUninstall, env, dir); err != nil {
+		return err
+	}
+	if props.managedWorkspace() {
+		return props.removeWorkspace()
+	}
 	return nil
 }
 
-func (s *ShellState) createOrUpdate(ctx p.Context, input ShellArgs, commands []string) error {
-	dir := os.TempDir()
-	_, err := s.run(ctx, fmt.Sprintf("curl -OL %s", input.DownloadURL), dir)
+// workspaceDir returns the directory to download the program to and run the
+// commands in. This is nil if a temporary directory is used
+func (s *ShellState) workspaceDir(name string) (*string, error) {
+	var dir string
+	if s.WorkingDir != nil {
+		dir = *s.WorkingDir
+	} else if s.Workspace != nil && *s.Workspace {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = path.Join(home, ".local", "share", "pde", "shell", name)
+	} else {
+		return nil, nil
+	}
+	return &dir, nil
+}
+
+// track starts tracking the paths that the install creates if trackChanges
+// is set. The workspace is not tracked since it is managed separately
+func (s *ShellState) track(ctx p.Context, input ShellArgs) (p.Context, *tracker, error) {
+	if input.TrackChanges == nil || !*input.TrackChanges {
+		return ctx, nil, nil
+	}
+	var exclude []string
+	if s.WorkspaceDir != nil {
+		exclude = append(exclude, *s.WorkspaceDir)
+	}
+	return startTracking(ctx, input.BinLocation, input.TrackPaths, exclude...)
+}
+
+// setWorkspace resolves the directory to download the program to and run the
+// commands in, creating it if it doesn't exist
+func (s *ShellState) setWorkspace(name string) error {
+	dir, err := s.workspaceDir(name)
 	if err != nil {
 		return err
 	}
-	_, err = s.run(ctx, strings.Join(commands, " && "), dir)
+	s.WorkspaceDir = dir
+	if dir == nil {
+		return nil
+	}
+	if err := os.MkdirAll(*dir, 0755); err != nil {
+		return fmt.Errorf("creating workspace %s: %w", *dir, err)
+	}
+	return nil
+}
+
+// managedWorkspace returns whether the workspace was created by the resource
+// and should be removed along with it
+func (s *ShellState) managedWorkspace() bool {
+	return s.WorkingDir == nil && s.Workspace != nil && *s.Workspace && s.WorkspaceDir != nil &&
+		(s.Retain == nil || !*s.Retain)
+}
+
+func (s *ShellState) removeWorkspace() error {
+	if err := os.RemoveAll(*s.WorkspaceDir); err != nil {
+		return fmt.Errorf("removing workspace %s: %w", *s.WorkspaceDir, err)
+	}
+	return nil
+}
+
+func (s *ShellState) createOrUpdate(ctx p.Context, input ShellArgs, steps []Step) error {
+	dir := s.commandDir()
+	if err := s.runHook(ctx, input.BaseInputs, "preInstall", input.PreInstall, s.hookEnv(input, input.DesiredVersion), dir); err != nil {
+		return err
+	}
+	_, err := s.run(ctx, input.BaseInputs, downloadCommand(input.DownloadURL), dir)
 	if err != nil {
 		return err
 	}
+	if err = s.runSteps(ctx, input.BaseInputs, steps, dir); err != nil {
+		return err
+	}
 
 	if input.Executable != nil && *input.Executable {
+		src := path.Join(dir, input.ProgramName)
+		if err := checkPlatform(src, input.Platform); err != nil {
+			return fmt.Errorf("asset %s: %w", downloadName(input.DownloadURL), err)
+		}
 		target := path.Join(*input.BinLocation, input.ProgramName)
 		s.Location = &target
-		// move it
-		if err = os.Rename(path.Join(dir, input.ProgramName), target); err != nil {
-			return err
-		}
-		// make executable
-		if err = os.Chmod(target, 0777); err != nil {
+		if err = s.moveExecutable(ctx, input.BaseInputs, src, target); err != nil {
 			return err
 		}
 	}
 
 	if input.VersionCommand != nil {
-		output, err := s.run(ctx, *input.VersionCommand, dir)
+		version, err := s.probeVersion(ctx, input, dir)
 		if err != nil {
 			return err
 		}
-		s.Version = &output
+		s.Version = &version
+	} else if input.DesiredVersion != nil {
+		s.Version = input.DesiredVersion
 	} else {
 		dv := "0.0.0"
 		s.Version = &dv
 	}
 	return nil
 }
+
+// verifyInstall runs the verify command of the input against what createOrUpdate
+// installed
+func (s *ShellState) verifyInstall(ctx p.Context, input ShellArgs) error {
+	return s.verify(ctx, input.BaseInputs, input.Verify, s.hookEnv(input, s.Version), s.commandDir())
+}
+
+func (s *ShellState) postInstall(ctx p.Context, input ShellArgs) error {
+	return s.runHook(ctx, input.BaseInputs, "postInstall", input.PostInstall, s.hookEnv(input, s.Version), s.commandDir())
+}
+
+// hookEnv returns the environment for the lifecycle hooks. The location is
+// where the program is installed to, or the binLocation if it isn't an
+// executable
+func (s *ShellState) hookEnv(input ShellArgs, version *string) []string {
+	location := input.BinLocation
+	if s.Location != nil {
+		location = s.Location
+	} else if input.Executable != nil && *input.Executable && input.BinLocation != nil {
+		target := path.Join(*input.BinLocation, input.ProgramName)
+		location = &target
+	}
+	return hookEnv(location, version)
+}
+
+func (s *ShellArgs) installSteps() []Step {
+	return newSteps(&s.InstallCommands, s.InstallSteps)
+}
+
+// probeVersion runs the versionCommand and, if a versionRegex is provided,
+// extracts the version from its output
+func (s *ShellState) probeVersion(ctx p.Context, input ShellArgs, dir string) (string, error) {
+	output, err := s.run(ctx, input.BaseInputs, *input.VersionCommand, dir)
+	if err != nil {
+		return "", err
+	}
+	if input.VersionRegex == nil {
+		return output, nil
+	}
+	return parseVersion(output, *input.VersionRegex)
+}
+
+func parseVersion(output, versionRegex string) (string, error) {
+	regx, err := regexp.Compile(versionRegex)
+	if err != nil {
+		return "", err
+	}
+	match := regx.FindStringSubmatch(output)
+	if match == nil {
+		return "", fmt.Errorf("versionRegex %q did not match output %q", versionRegex, output)
+	}
+	if len(match) > 1 {
+		return match[1], nil
+	}
+	return match[0], nil
+}
@@ -0,0 +1,208 @@
+package installers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+)
+
+// Step is a single command that is run as part of an install, update or
+// uninstall
+type Step struct {
+	Name            *string `pulumi:"name,optional"`
+	Command         string  `pulumi:"command"`
+	Dir             *string `pulumi:"dir,optional"`
+	ContinueOnError *bool   `pulumi:"continueOnError,optional"`
+}
+
+func (s *Step) Annotate(a infer.Annotator) {
+	a.Describe(&s.Name, "The name of the step. This is used in logs and errors. Defaults to the command")
+	a.Describe(&s.Command, "The command to run")
+	a.Describe(&s.Dir, `The directory to run the command in. Relative paths are relative to
+				the directory the resource runs its commands in`)
+	a.Describe(&s.ContinueOnError, "Whether to continue with the next step if this step fails")
+}
+
+// StepResult is the result of running a Step
+type StepResult struct {
+	Name     string `pulumi:"name"`
+	ExitCode int    `pulumi:"exitCode"`
+	Duration string `pulumi:"duration"`
+}
+
+func (s *StepResult) Annotate(a infer.Annotator) {
+	a.Describe(&s.Name, "The name of the step")
+	a.Describe(&s.ExitCode, "The exit code of the step. This is -1 if the step could not be started")
+	a.Describe(&s.Duration, "How long the step took to run")
+}
+
+func (s Step) name() string {
+	if s.Name != nil {
+		return *s.Name
+	}
+	return s.Command
+}
+
+func (s Step) dir(dir string) string {
+	if s.Dir == nil {
+		return dir
+	}
+	if filepath.IsAbs(*s.Dir) {
+		return *s.Dir
+	}
+	return filepath.Join(dir, *s.Dir)
+}
+
+// toSteps converts commands to steps. Each command is run as its own step
+func toSteps(commands []string) []Step {
+	steps := make([]Step, 0, len(commands))
+	for _, c := range commands {
+		steps = append(steps, Step{Command: c})
+	}
+	return steps
+}
+
+// newSteps returns the commands followed by the steps
+func newSteps(commands *[]string, steps *[]Step) []Step {
+	all := []Step{}
+	if commands != nil {
+		all = append(all, toSteps(*commands)...)
+	}
+	if steps != nil {
+		all = append(all, *steps...)
+	}
+	return all
+}
+
+// runSteps runs each step one at a time and records the result of every
+// step in c.Steps. dir is the directory steps run in unless they set their
+// own
+func (c *CommandOutputs) runSteps(ctx p.Context, b BaseInputs, steps []Step, dir string) error {
+	results := []StepResult{}
+	var stdouts, stderrs []string
+	defer func() {
+		c.Steps = &results
+		stdout := strings.Join(stdouts, "\n")
+		stderr := strings.Join(stderrs, "\n")
+		c.Stdout = &stdout
+		c.Stderr = &stderr
+	}()
+	var lastStdout string
+	var lastFailed error
+	for i, step := range steps {
+		start := time.Now()
+		stdout, stderr, err := c.execWith(ctx, b, c.args(step.Command), nil, step.Command, step.dir(dir))
+		if stdout != "" {
+			stdouts = append(stdouts, stdout)
+		}
+		if stderr != "" {
+			stderrs = append(stderrs, stderr)
+		}
+		lastStdout = stdout
+		lastFailed = nil
+		results = append(results, StepResult{
+			Name:     step.name(),
+			ExitCode: exitCode(err),
+			Duration: time.Since(start).Round(time.Millisecond).String(),
+		})
+		if err != nil {
+			if step.ContinueOnError != nil && *step.ContinueOnError {
+				ctx.Logf(diag.Warning, "step %d (%s) failed, continuing: %s", i+1, step.name(), err.Error())
+				lastFailed = fmt.Errorf("step %d (%s) failed", i+1, step.name())
+				continue
+			}
+			return fmt.Errorf("step %d (%s) failed: %w", i+1, step.name(), err)
+		}
+	}
+
+	// the outputs come from the last step, which may have failed part way
+	// through printing them
+	if lastFailed != nil && b.OutputsFrom != nil {
+		return fmt.Errorf("outputsFrom: %w, so it has no outputs", lastFailed)
+	}
+	return c.outputsFrom(b, lastStdout)
+}
+
+// outputsFrom sets Outputs from the stdout of the last command if outputsFrom
+// is set
+func (c *CommandOutputs) outputsFrom(b BaseInputs, stdout string) error {
+	if b.OutputsFrom == nil {
+		return nil
+	}
+	if *b.OutputsFrom != outputsFromJSON {
+		return fmt.Errorf("unknown outputsFrom %q, must be %s", *b.OutputsFrom, outputsFromJSON)
+	}
+	outputs, err := parseOutputs(stdout)
+	if err != nil {
+		return fmt.Errorf("outputsFrom: %w", err)
+	}
+	c.Outputs = &outputs
+	return nil
+}
+
+const outputsFromJSON = "json"
+
+// parseOutputs parses the JSON object printed by a command
+func parseOutputs(stdout string) (map[string]any, error) {
+	outputs := map[string]any{}
+	if err := json.Unmarshal([]byte(stdout), &outputs); err != nil {
+		return nil, fmt.Errorf("expected a JSON object: %w", err)
+	}
+	return outputs, nil
+}
+
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
+
+// commandsEqual compares commands one by one
+func commandsEqual(a, b *[]string) bool {
+	var as, bs []string
+	if a != nil {
+		as = *a
+	}
+	if b != nil {
+		bs = *b
+	}
+	return slices.Equal(as, bs)
+}
+
+// stepsEqual compares steps one by one
+func stepsEqual(a, b *[]Step) bool {
+	var as, bs []Step
+	if a != nil {
+		as = *a
+	}
+	if b != nil {
+		bs = *b
+	}
+	return slices.EqualFunc(as, bs, func(x, y Step) bool {
+		return x.Command == y.Command &&
+			ptrEqual(x.Name, y.Name) &&
+			ptrEqual(x.Dir, y.Dir) &&
+			ptrEqual(x.ContinueOnError, y.ContinueOnError)
+	})
+}
+
+func ptrEqual[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
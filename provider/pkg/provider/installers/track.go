@@ -0,0 +1,216 @@
+package installers
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// snapshot is the set of paths under the watched roots
+type snapshot map[string]struct{}
+
+// maxTrackDepth is how deep below a watched root paths are recorded. A
+// directory created at that depth is recorded with everything in it, so only
+// files added deep inside directories that already existed are missed. This
+// keeps the walk of a well used ~/.local/share cheap
+const maxTrackDepth = 3
+
+// installs makes a tracked install the only thing that runs commands while
+// it is tracked, so that the paths it records aren't ones created by another
+// resource that is installed in parallel. Other commands hold the read lock,
+// see lockInstalls
+var installs sync.RWMutex
+
+// trackingKey marks the context of a tracked install, which already holds
+// the write lock of installs
+type trackingKey struct{}
+
+// lockInstalls waits for any tracked install to finish before a command is
+// run and returns the func that releases the lock
+func lockInstalls(ctx p.Context) func() {
+	if tracking, _ := ctx.Value(trackingKey{}).(bool); tracking {
+		return func() {}
+	}
+	installs.RLock()
+	return installs.RUnlock
+}
+
+// tracker records the paths that an install creates under the watched roots
+// so that they can be removed when the resource is deleted
+type tracker struct {
+	roots   []string
+	exclude []string
+	before  snapshot
+	unlock  func()
+}
+
+// startTracking snapshots the watched roots, which are binLocation and
+// paths, or ~/.local/share and ~/.config if no paths are given. Paths under
+// exclude are never tracked. The install must use the returned context and
+// finish with record or cleanup, which let other commands run again
+func startTracking(ctx p.Context, binLocation *string, paths *[]string, exclude ...string) (p.Context, *tracker, error) {
+	var roots []string
+	if paths != nil {
+		for _, root := range *paths {
+			root, err := expandHome(root)
+			if err != nil {
+				return ctx, nil, err
+			}
+			roots = append(roots, filepath.Clean(root))
+		}
+	} else {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ctx, nil, err
+		}
+		roots = []string{path.Join(home, ".local", "share"), path.Join(home, ".config")}
+	}
+	if binLocation != nil {
+		roots = append([]string{*binLocation}, roots...)
+	}
+	installs.Lock()
+	t := &tracker{roots: roots, exclude: exclude, unlock: sync.OnceFunc(installs.Unlock)}
+	before, err := t.snapshot()
+	if err != nil {
+		t.unlock()
+		return ctx, nil, err
+	}
+	t.before = before
+	return p.CtxWithValue(ctx, trackingKey{}, true), t, nil
+}
+
+func (t *tracker) snapshot() (snapshot, error) {
+	s := snapshot{}
+	for _, root := range t.roots {
+		err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				// roots that don't exist yet and directories we can't read
+				// are skipped
+				if d != nil && d.IsDir() && p != root {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if p == root {
+				return nil
+			}
+			if slices.Contains(t.exclude, p) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			s[p] = struct{}{}
+			if d.IsDir() && depth(root, p) >= maxTrackDepth {
+				return filepath.SkipDir
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// created returns the paths that were created since tracking started. Paths
+// inside a created directory are left out since removing the directory
+// removes them as well
+func (t *tracker) created() ([]string, error) {
+	after, err := t.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	created := snapshot{}
+	for p := range after {
+		if _, ok := t.before[p]; !ok {
+			created[p] = struct{}{}
+		}
+	}
+	paths := []string{}
+	for p := range created {
+		if !createdParent(created, p) {
+			paths = append(paths, p)
+		}
+	}
+	slices.Sort(paths)
+	return paths, nil
+}
+
+// depth returns how many levels p is below root
+func depth(root, p string) int {
+	rel, err := filepath.Rel(root, p)
+	if err != nil {
+		return 0
+	}
+	return strings.Count(rel, string(filepath.Separator)) + 1
+}
+
+func createdParent(created snapshot, p string) bool {
+	for dir := filepath.Dir(p); dir != p; p, dir = dir, filepath.Dir(dir) {
+		if _, ok := created[dir]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// trackedPaths returns the paths tracked by a previous install along with the
+// ones created by this one
+func trackedPaths(previous *[]string, created []string) *[]string {
+	paths := slices.Clone(created)
+	if previous != nil {
+		paths = append(paths, *previous...)
+	}
+	slices.Sort(paths)
+	paths = slices.Compact(paths)
+	return &paths
+}
+
+// removeCreated removes the paths that were created by the install
+func (c *CommandOutputs) removeCreated(ctx p.Context, b BaseInputs, paths *[]string) error {
+	if paths == nil {
+		return nil
+	}
+	var errs []error
+	for _, p := range *paths {
+		errs = append(errs, c.removePath(ctx, b, p))
+	}
+	return errors.Join(errs...)
+}
+
+// record adds the paths that were created since tracking started to paths.
+// Nothing is recorded if t is nil, i.e. trackChanges is not set
+func (t *tracker) record(paths **[]string) error {
+	if t == nil {
+		return nil
+	}
+	defer t.unlock()
+	created, err := t.created()
+	if err != nil {
+		return err
+	}
+	*paths = trackedPaths(*paths, created)
+	return nil
+}
+
+// cleanup removes the paths that were created since tracking started. This is
+// used to clean up after an install that failed
+func (t *tracker) cleanup(ctx p.Context, c *CommandOutputs, b BaseInputs) error {
+	if t == nil {
+		return nil
+	}
+	defer t.unlock()
+	created, err := t.created()
+	if err != nil {
+		return err
+	}
+	return c.removeCreated(ctx, b, &created)
+}
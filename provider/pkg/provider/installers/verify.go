@@ -0,0 +1,127 @@
+package installers
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// Verify checks that an install works after the install commands have run
+type Verify struct {
+	Command        string  `pulumi:"command"`
+	ExpectedOutput *string `pulumi:"expectedOutput,optional"`
+}
+
+func (v *Verify) Annotate(a infer.Annotator) {
+	a.Describe(&v.Command, `The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks`)
+	a.Describe(&v.ExpectedOutput, `A regex that the output of the command must match. Both stdout and stderr are
+				matched since some programs print their version to stderr`)
+}
+
+// checkVerify validates the expectedOutput regex of verify so that a bad
+// regex fails before anything is installed rather than after, when the
+// install would be rolled back
+func checkVerify(inputs resource.PropertyMap) []p.CheckFailure {
+	v, ok := inputs["verify"]
+	if !ok || !v.IsObject() {
+		return nil
+	}
+	expected, ok := v.ObjectValue()["expectedOutput"]
+	if !ok || !expected.IsString() {
+		return nil
+	}
+	if _, err := regexp.Compile(expected.StringValue()); err != nil {
+		return []p.CheckFailure{{Property: "verify.expectedOutput", Reason: err.Error()}}
+	}
+	return nil
+}
+
+// verify runs the verify command. An error means that the install is broken
+// and should be cleaned up or rolled back
+func (c *CommandOutputs) verify(ctx p.Context, b BaseInputs, v *Verify, env []string, dir string) error {
+	if v == nil {
+		return nil
+	}
+	// the program is checked as the user that will run it
+	opts := BaseInputs{InheritEnvironment: b.InheritEnvironment}
+	stdout, stderr, err := c.execWith(ctx, opts, c.args(v.Command), env, v.Command, dir)
+	if err != nil {
+		return fmt.Errorf("verify failed: %w", err)
+	}
+	if v.ExpectedOutput == nil {
+		return nil
+	}
+	regx, err := regexp.Compile(*v.ExpectedOutput)
+	if err != nil {
+		return fmt.Errorf("verify expectedOutput: %w", err)
+	}
+	output := stdout + "\n" + stderr
+	if !regx.MatchString(output) {
+		return fmt.Errorf("verify failed: output of %q did not match %q:\n%s", v.Command, *v.ExpectedOutput, output)
+	}
+	return nil
+}
+
+// backup holds installed files that were moved aside during an update so
+// that the update can be rolled back
+type backup struct {
+	b     BaseInputs
+	files map[string]string
+}
+
+const backupSuffix = ".pde-backup"
+
+// backupFiles moves the files at locations aside. Locations that don't exist
+// are skipped
+func (c *CommandOutputs) backupFiles(ctx p.Context, b BaseInputs, locations []string) (*backup, error) {
+	bk := &backup{b: b, files: map[string]string{}}
+	for _, l := range locations {
+		if _, err := os.Lstat(l); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if err := c.moveFile(ctx, b, l, l+backupSuffix); err != nil {
+			return nil, errors.Join(err, c.restore(ctx, bk))
+		}
+		bk.files[l] = l + backupSuffix
+	}
+	return bk, nil
+}
+
+// restore puts the backed up files back, replacing anything that the update
+// installed in their place
+func (c *CommandOutputs) restore(ctx p.Context, bk *backup) error {
+	var errs []error
+	for l, backup := range bk.files {
+		if err := c.removeFile(ctx, bk.b, l); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		errs = append(errs, c.moveFile(ctx, bk.b, backup, l))
+	}
+	return errors.Join(errs...)
+}
+
+// discard removes the backed up files once the update has been verified
+func (c *CommandOutputs) discard(ctx p.Context, bk *backup) error {
+	var errs []error
+	for _, backup := range bk.files {
+		errs = append(errs, c.removeFile(ctx, bk.b, backup))
+	}
+	return errors.Join(errs...)
+}
+
+func verifyEqual(a, b *Verify) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Command == b.Command && ptrEqual(a.ExpectedOutput, b.ExpectedOutput)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"github.com/corymhall/pulumi-provider-pde/provider/pkg/provider/config"
 	"github.com/corymhall/pulumi-provider-pde/provider/pkg/provider/installers"
 	"github.com/corymhall/pulumi-provider-pde/provider/pkg/provider/local"
 
@@ -19,7 +20,9 @@ const (
 func NewProvider() p.Provider {
 	// We tell the provider what resources it needs to support.
 	// In this case, a single custom resource.
-	return infer.Provider(infer.Options{
+	return secretOutputs(infer.Wrap(p.Provider{
+		Cancel: cancelCommands,
+	}, infer.Options{
 		Metadata: schema.Metadata{
 			DisplayName: "pde",
 			Description: "The pulumi pde provider...",
@@ -30,6 +33,7 @@ func NewProvider() p.Provider {
 				},
 			},
 		},
+		Config: infer.Config[*config.Config](),
 		Resources: []infer.InferredResource{
 			infer.Resource[*local.Link, local.LinkArgs, local.LinkState](),
 			infer.Resource[*local.File, local.FileArgs, local.FileState](),
@@ -37,7 +41,17 @@ func NewProvider() p.Provider {
 			infer.Resource[*installers.GitHubRepo, installers.GitHubRepoArgs, installers.GitHubRepoState](),
 			infer.Resource[*installers.Shell, installers.ShellArgs, installers.ShellState](),
 			infer.Resource[*installers.Npm, installers.NpmArgs, installers.NpmState](),
+			infer.Resource[*installers.Script, installers.ScriptArgs, installers.ScriptState](),
 		},
-	})
+	}))
 
 }
+
+// cancelCommands stops the commands that are running when the engine cancels
+// the provider, e.g. after Ctrl-C or pulumi cancel. The contexts of in-flight
+// requests are already cancelled by infer, this also waits for the commands
+// to exit so that nothing is left running
+func cancelCommands(ctx p.Context) error {
+	installers.Cancel()
+	return nil
+}
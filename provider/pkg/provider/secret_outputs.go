@@ -0,0 +1,54 @@
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// capturedOutputs are the outputs that hold the output of the commands a
+// resource runs
+var capturedOutputs = []resource.PropertyKey{"stdout", "stderr", "outputs"}
+
+// secretOutputs marks the captured outputs of a resource as secret when its
+// secretOutputs input is true. infer only marks an output as secret when the
+// inputs it depends on are secret, so a plain boolean input can't do this on
+// its own
+func secretOutputs(provider p.Provider) p.Provider {
+	create := provider.Create
+	provider.Create = func(ctx p.Context, req p.CreateRequest) (p.CreateResponse, error) {
+		resp, err := create(ctx, req)
+		markSecretOutputs(req.Properties, resp.Properties)
+		return resp, err
+	}
+	update := provider.Update
+	provider.Update = func(ctx p.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+		resp, err := update(ctx, req)
+		markSecretOutputs(req.News, resp.Properties)
+		return resp, err
+	}
+	read := provider.Read
+	provider.Read = func(ctx p.Context, req p.ReadRequest) (p.ReadResponse, error) {
+		resp, err := read(ctx, req)
+		markSecretOutputs(resp.Inputs, resp.Properties)
+		return resp, err
+	}
+	return provider
+}
+
+func markSecretOutputs(inputs, state resource.PropertyMap) {
+	v, ok := inputs["secretOutputs"]
+	if !ok {
+		return
+	}
+	if v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+	if !v.IsBool() || !v.BoolValue() {
+		return
+	}
+	for _, k := range capturedOutputs {
+		if o, ok := state[k]; ok && !o.IsNull() && !o.IsSecret() {
+			state[k] = resource.MakeSecret(o)
+		}
+	}
+}
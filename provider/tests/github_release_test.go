@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path"
@@ -123,6 +124,7 @@ func TestGitHubReleaseCommand(t *testing.T) {
 			"binLocation":    resource.PropertyValue{V: bin},
 			"binFolder":      resource.PropertyValue{V: "pulumi"},
 			"downloadURL":    resource.MakeComputed(resource.PropertyValue{V: "https://github.com/pulumi/pulumi/releases/download/v3.81.0/pulumi-v3.81.0-darwin-arm64.tar.gz"}),
+			"plan":           expectedPlan(bin, "v3.81.0"),
 		}, create(true /* preview */, resource.PropertyValue{V: "v3.81.0"}))
 	})
 
@@ -139,6 +141,7 @@ func TestGitHubReleaseCommand(t *testing.T) {
 		assert.Equal(t, expectedProps(bin, "v3.80.0", resource.PropertyMap{
 			"releaseVersion": resource.PropertyValue{V: "v3.80.0"},
 			"downloadURL":    resource.MakeComputed(resource.PropertyValue{V: "https://github.com/pulumi/pulumi/releases/download/v3.80.0/pulumi-v3.80.0-darwin-arm64.tar.gz"}),
+			"plan":           expectedPlan(bin, "v3.80.0"),
 		}), update(true /*preview*/, resource.PropertyValue{V: "v3.80.0"}))
 	})
 
@@ -189,3 +192,80 @@ func expectedProps(
 	}
 	return base
 }
+
+// expectedPlan is the plan for installing the pulumi release asset for
+// version
+func expectedPlan(bin, version string) resource.PropertyValue {
+	asset := fmt.Sprintf("pulumi-%s-darwin-arm64.tar.gz", version)
+	downloadURL := fmt.Sprintf("https://github.com/pulumi/pulumi/releases/download/%s/%s", version, asset)
+	return resource.PropertyValue{V: resource.PropertyMap{
+		"downloadURL": resource.PropertyValue{V: downloadURL},
+		"asset":       resource.PropertyValue{V: asset},
+		"commands": resource.PropertyValue{V: []resource.PropertyValue{
+			{V: "curl -OL " + downloadURL},
+			{V: "tar -xzvf " + asset},
+			{V: fmt.Sprintf("cp -r pulumi/* %s", bin)},
+		}},
+		"writes": resource.PropertyValue{V: []resource.PropertyValue{
+			{V: path.Join(os.TempDir(), asset)},
+			{V: path.Join(bin, "*")},
+		}},
+		"removes": resource.PropertyValue{V: []resource.PropertyValue{}},
+	}}
+}
+
+func TestGitHubReleaseUninstallSteps(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "GitHubRelease")
+
+	marker := path.Join(t.TempDir(), "marker")
+	props := func(continueOnError bool) resource.PropertyMap {
+		return resource.PropertyMap{
+			"org":         resource.PropertyValue{V: "corymhall"},
+			"repo":        resource.PropertyValue{V: "pulumi-provider-pde"},
+			"downloadURL": resource.PropertyValue{V: "https://example.com"},
+			"locations":   resource.PropertyValue{V: []resource.PropertyValue{}},
+			"uninstallSteps": resource.PropertyValue{V: []resource.PropertyValue{
+				{V: resource.PropertyMap{
+					"name":            resource.PropertyValue{V: "fail"},
+					"command":         resource.PropertyValue{V: "exit 3"},
+					"continueOnError": resource.PropertyValue{V: continueOnError},
+				}},
+				{V: resource.PropertyMap{
+					"name":    resource.PropertyValue{V: "touch marker"},
+					"command": resource.PropertyValue{V: fmt.Sprintf("touch %s", path.Base(marker))},
+					"dir":     resource.PropertyValue{V: path.Dir(marker)},
+				}},
+			}},
+		}
+	}
+
+	err := cmd.Delete(p.DeleteRequest{Urn: urn, Properties: props(false)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "step 1 (fail) failed")
+	_, err = os.Stat(marker)
+	assert.True(t, os.IsNotExist(err))
+
+	err = cmd.Delete(p.DeleteRequest{Urn: urn, Properties: props(true)})
+	require.NoError(t, err)
+	_, err = os.Stat(marker)
+	assert.NoError(t, err)
+}
+
+func TestGitHubReleaseSchema(t *testing.T) {
+	t.Parallel()
+	resp, err := provider().GetSchema(p.GetSchemaRequest{})
+	require.NoError(t, err)
+	var schema struct {
+		Resources map[string]struct {
+			RequiredInputs []string `json:"requiredInputs"`
+		} `json:"resources"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(resp.Schema), &schema))
+
+	// GitHubRepo can be cloned from a url instead
+	assert.Subset(t, schema.Resources["pde:installers:GitHubRelease"].RequiredInputs, []string{"org", "repo"})
+	assert.NotContains(t, schema.Resources["pde:installers:GitHubRepo"].RequiredInputs, "org")
+	assert.NotContains(t, schema.Resources["pde:installers:GitHubRepo"].RequiredInputs, "repo")
+}
@@ -1,11 +1,17 @@
 package tests
 
 import (
+	"debug/elf"
+	"encoding/binary"
 	"os"
+	"os/exec"
 	"path"
+	"strings"
 	"testing"
 
+	"github.com/corymhall/pulumi-provider-pde/provider/pkg/provider/installers"
 	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/stretchr/testify/assert"
@@ -150,3 +156,1246 @@ func absFolder(name string) string {
 	homeDir, _ := os.UserHomeDir()
 	return path.Join(homeDir, name)
 }
+
+// gitRepo creates a git repo with a commit on main and returns its path and
+// a function that adds another commit and returns its hash. If tags are given
+// then they are added to the commit as annotated tags
+func gitRepo(t *testing.T, name string) (string, func(content string, tags ...string) string) {
+	dir := path.Join(t.TempDir(), name)
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	git("init", "-b", "main")
+	// the file transport only serves partial clones if the repo allows it
+	git("config", "uploadpack.allowFilter", "true")
+	commit := func(content string, tags ...string) string {
+		require.NoError(t, os.WriteFile(path.Join(dir, "VERSION"), []byte(content), 0644))
+		git("add", "-A")
+		git("commit", "-m", content)
+		for _, tag := range tags {
+			git("tag", "-a", tag, "-m", tag)
+		}
+		return git("rev-parse", "HEAD")
+	}
+	commit("1.0.0")
+	return dir, commit
+}
+
+func TestGitHubRepoURL(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	src, commit := gitRepo(t, "tool")
+	first := commit("1.1.0")
+	url := "file://" + src
+	clone := path.Join(home, "tool")
+
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn: urn,
+		News: resource.PropertyMap{
+			"url": resource.PropertyValue{V: url},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, cResp.Failures)
+	assert.Equal(t, resource.PropertyValue{V: first}, cResp.Inputs["version"])
+	assert.Equal(t, resource.PropertyValue{V: "tool"}, cResp.Inputs["repo"])
+	assert.Equal(t, resource.PropertyValue{V: "tool"}, cResp.Inputs["folderName"])
+
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyValue{V: clone}, resp.Properties["absFolderName"])
+	b, err := os.ReadFile(path.Join(clone, "VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "1.1.0", string(b))
+
+	second := commit("2.0.0")
+	news := cResp.Inputs.Copy()
+	news["version"] = resource.PropertyValue{V: second}
+	uResp, err := cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: news,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyValue{V: second}, uResp.Properties["version"])
+	b, err = os.ReadFile(path.Join(clone, "VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", string(b))
+
+	err = cmd.Delete(p.DeleteRequest{
+		Urn:        urn,
+		Properties: uResp.Properties,
+	})
+	require.NoError(t, err)
+	_, err = os.Stat(clone)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestGitHubRepoCheck(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	resp, err := cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		News: resource.PropertyMap{},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Failures, 2)
+	assert.Equal(t, "org", string(resp.Failures[0].Property))
+	assert.Equal(t, "repo", string(resp.Failures[1].Property))
+
+	resp, err = cmd.Check(p.CheckRequest{
+		Urn: urn,
+		News: resource.PropertyMap{
+			"url":     resource.PropertyValue{V: "git@gitlab.example.com:group/sub/tool.git"},
+			"version": resource.PropertyValue{V: "abc"},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Failures)
+	assert.Equal(t, resource.PropertyValue{V: "group/sub"}, resp.Inputs["org"])
+	assert.Equal(t, resource.PropertyValue{V: "tool"}, resp.Inputs["repo"])
+}
+
+func TestGitHubRepoRef(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	src, commit := gitRepo(t, "tool")
+	commit("0.9.0", "v0.9.0")
+	commit("0.10.0", "v0.10.0")
+	v0101 := commit("0.10.1", "v0.10.1")
+	commit("0.10.2-rc.1", "v0.10.2-rc.1")
+	commit("0.11.0", "v0.11.0")
+	news := resource.PropertyMap{
+		"url": resource.PropertyValue{V: "file://" + src},
+		"ref": resource.PropertyValue{V: "~0.10"},
+	}
+
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, cResp.Failures)
+	assert.Equal(t, resource.PropertyValue{V: v0101}, cResp.Inputs["version"])
+	assert.Equal(t, resource.PropertyValue{V: "v0.10.1"}, cResp.Inputs["tag"])
+
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+	b, err := os.ReadFile(path.Join(home, "tool", "VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "0.10.1", string(b))
+
+	// a new release that matches is picked up by the next Check
+	v0103 := commit("0.10.3", "v0.10.3")
+	cResp, err = cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyValue{V: v0103}, cResp.Inputs["version"])
+	assert.Equal(t, resource.PropertyValue{V: "v0.10.3"}, cResp.Inputs["tag"])
+
+	dResp, err := cmd.Diff(p.DiffRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, p.Update, dResp.DetailedDiff["tag"].Kind)
+	assert.Equal(t, p.Update, dResp.DetailedDiff["version"].Kind)
+
+	_, err = cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	b, err = os.ReadFile(path.Join(home, "tool", "VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "0.10.3", string(b))
+
+	t.Run("branch", func(t *testing.T) {
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn: urn,
+			News: resource.PropertyMap{
+				"url": resource.PropertyValue{V: "file://" + src},
+				"ref": resource.PropertyValue{V: "main"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, resource.PropertyValue{V: v0103}, cResp.Inputs["version"])
+		assert.NotContains(t, cResp.Inputs, resource.PropertyKey("tag"))
+	})
+
+	t.Run("no-match", func(t *testing.T) {
+		_, err := cmd.Check(p.CheckRequest{
+			Urn: urn,
+			News: resource.PropertyMap{
+				"url": resource.PropertyValue{V: "file://" + src},
+				"ref": resource.PropertyValue{V: "^2"},
+			},
+		})
+		assert.ErrorContains(t, err, "no tag matches ^2")
+	})
+}
+
+func TestGitHubRepoErrors(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	// the git CLI is run in English whatever the locale of the provider is
+	t.Setenv("LC_ALL", "de_DE.UTF-8")
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+	src, _ := gitRepo(t, "tool")
+
+	create := func(t *testing.T, news resource.PropertyMap, remove string) error {
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn:  urn,
+			News: news,
+		})
+		if err != nil {
+			return err
+		}
+		require.Empty(t, cResp.Failures)
+		if remove != "" {
+			require.NoError(t, os.RemoveAll(remove))
+		}
+		_, err = cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: cResp.Inputs,
+		})
+		return err
+	}
+
+	t.Run("ref-not-found", func(t *testing.T) {
+		err := create(t, resource.PropertyMap{
+			"url":        resource.PropertyValue{V: "file://" + src},
+			"folderName": resource.PropertyValue{V: "missing-commit"},
+			"version":    resource.PropertyValue{V: strings.Repeat("0", 40)},
+		}, "")
+		assert.ErrorIs(t, err, installers.ErrGitRefNotFound)
+		var gitErr *installers.GitError
+		require.ErrorAs(t, err, &gitErr)
+		assert.Equal(t, strings.Repeat("0", 40), gitErr.Target)
+	})
+
+	t.Run("branch-not-found", func(t *testing.T) {
+		err := create(t, resource.PropertyMap{
+			"url":    resource.PropertyValue{V: "file://" + src},
+			"branch": resource.PropertyValue{V: "nope"},
+		}, "")
+		assert.ErrorIs(t, err, installers.ErrGitRefNotFound)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		err := create(t, resource.PropertyMap{
+			"url": resource.PropertyValue{V: "file://" + path.Join(t.TempDir(), "missing")},
+		}, "")
+		assert.ErrorIs(t, err, installers.ErrGitNetwork)
+		assert.NotErrorIs(t, err, installers.ErrGitAuth)
+	})
+
+	t.Run("unreachable-cli", func(t *testing.T) {
+		// a filter makes the clone use the git CLI
+		gone, _ := gitRepo(t, "gone")
+		err := create(t, resource.PropertyMap{
+			"url":        resource.PropertyValue{V: "file://" + gone},
+			"folderName": resource.PropertyValue{V: "gone"},
+			"filter":     resource.PropertyValue{V: "blob:none"},
+		}, gone)
+		assert.ErrorIs(t, err, installers.ErrGitNetwork)
+		var gitErr *installers.GitError
+		require.ErrorAs(t, err, &gitErr)
+		assert.Equal(t, "clone", gitErr.Op)
+	})
+}
+
+func TestGitHubRepoShallow(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	src, commit := gitRepo(t, "tool")
+	old := commit("1.1.0")
+	for _, v := range []string{"1.2.0", "1.3.0", "1.4.0", "1.5.0"} {
+		commit(v)
+	}
+	news := resource.PropertyMap{
+		"url":   resource.PropertyValue{V: "file://" + src},
+		"depth": resource.NewNumberProperty(1),
+	}
+
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, cResp.Failures)
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+	shallow, err := os.ReadFile(path.Join(home, "tool", ".git", "shallow"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(shallow), "\n"))
+
+	// the old commit isn't in the clone so more history is fetched
+	news["version"] = resource.PropertyValue{V: old}
+	cResp, err = cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	_, err = cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	b, err := os.ReadFile(path.Join(home, "tool", "VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "1.1.0", string(b))
+}
+
+func TestGitHubRepoSparse(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	src, commit := gitRepo(t, "tool")
+	for _, dir := range []string{"docs", "src"} {
+		require.NoError(t, os.MkdirAll(path.Join(src, dir), 0755))
+		require.NoError(t, os.WriteFile(path.Join(src, dir, "README"), []byte(dir), 0644))
+	}
+	commit("1.1.0")
+	news := resource.PropertyMap{
+		"url":         resource.PropertyValue{V: "file://" + src},
+		"filter":      resource.PropertyValue{V: "blob:none"},
+		"sparsePaths": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("docs")}),
+	}
+
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, cResp.Failures)
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+	assert.FileExists(t, path.Join(home, "tool", "VERSION"))
+	assert.FileExists(t, path.Join(home, "tool", "docs", "README"))
+	assert.NoDirExists(t, path.Join(home, "tool", "src"))
+
+	// a read of the sparse checkout doesn't see the missing directories as
+	// local changes
+	_, err = cmd.Read(p.ReadRequest{
+		Urn:        urn,
+		ID:         resp.ID,
+		Properties: resp.Properties,
+		Inputs:     cResp.Inputs,
+	})
+	require.NoError(t, err)
+
+	news["sparsePaths"] = resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("src")})
+	cResp, err = cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	dResp, err := cmd.Diff(p.DiffRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, p.Update, dResp.DetailedDiff["sparsePaths"].Kind)
+	_, err = cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.FileExists(t, path.Join(home, "tool", "src", "README"))
+	assert.NoDirExists(t, path.Join(home, "tool", "docs"))
+
+	t.Run("invalid", func(t *testing.T) {
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn: urn,
+			News: resource.PropertyMap{
+				"url":    resource.PropertyValue{V: "file://" + src},
+				"filter": resource.PropertyValue{V: "blob:limit=1k"},
+				"depth":  resource.NewNumberProperty(0),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []p.CheckFailure{
+			{Property: "depth", Reason: "depth must be at least 1"},
+			{Property: "filter", Reason: "filter must be one of blob:none, tree:0"},
+		}, cResp.Failures)
+	})
+}
+
+func TestGitHubRepoSubmodules(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	// git only uses the file transport for submodules if it is allowed
+	require.NoError(t, os.WriteFile(path.Join(home, ".gitconfig"), []byte("[protocol \"file\"]\n\tallow = always\n"), 0644))
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	lib, libCommit := gitRepo(t, "lib")
+	src, commit := gitRepo(t, "tool")
+	git := func(dir string, args ...string) {
+		out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	git(src, "submodule", "add", "file://"+lib, "lib")
+	commit("1.1.0")
+	news := resource.PropertyMap{
+		"url":        resource.PropertyValue{V: "file://" + src},
+		"submodules": resource.PropertyValue{V: "recursive"},
+	}
+
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, cResp.Failures)
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+	b, err := os.ReadFile(path.Join(home, "tool", "lib", "VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", string(b))
+
+	// a new version that points the submodule to a new commit updates it
+	libCommit("2.0.0")
+	git(path.Join(src, "lib"), "pull", "--quiet", "origin", "main")
+	news["version"] = resource.PropertyValue{V: commit("1.2.0")}
+	cResp, err = cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	uResp, err := cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	b, err = os.ReadFile(path.Join(home, "tool", "lib", "VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", string(b))
+
+	// turning submodules off removes them
+	news["submodules"] = resource.PropertyValue{V: "none"}
+	cResp, err = cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		Olds: uResp.Properties,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	_, err = cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: uResp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.NoFileExists(t, path.Join(home, "tool", "lib", "VERSION"))
+
+	t.Run("invalid", func(t *testing.T) {
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn: urn,
+			News: resource.PropertyMap{
+				"url":        resource.PropertyValue{V: "file://" + src},
+				"submodules": resource.PropertyValue{V: "all"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []p.CheckFailure{
+			{Property: "submodules", Reason: "submodules must be one of none, shallow, recursive"},
+		}, cResp.Failures)
+	})
+}
+
+func TestGitHubRepoLFS(t *testing.T) {
+	if _, err := exec.LookPath("git-lfs"); err == nil {
+		t.Skip("git-lfs is installed")
+	}
+	t.Setenv("HOME", t.TempDir())
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	src, _ := gitRepo(t, "tool")
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn: urn,
+		News: resource.PropertyMap{
+			"url": resource.PropertyValue{V: "file://" + src},
+			"lfs": resource.PropertyValue{V: true},
+		},
+	})
+	require.NoError(t, err)
+	_, err = cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	assert.ErrorContains(t, err, "lfs needs git-lfs to be installed")
+}
+
+func TestGitHubRepoLocalChanges(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	src, commit := gitRepo(t, "tool")
+	clone := path.Join(home, "tool")
+	git := func(args ...string) string {
+		c := exec.Command("git", append([]string{"-C", clone}, args...)...)
+		c.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := c.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	news := resource.PropertyMap{
+		"url": resource.PropertyValue{V: "file://" + src},
+	}
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+	olds := resp.Properties
+
+	// changed files and commits on the detached HEAD are reported by a read
+	require.NoError(t, os.WriteFile(path.Join(clone, "VERSION"), []byte("local"), 0644))
+	git("commit", "--allow-empty", "-m", "wip")
+	rResp, err := cmd.Read(p.ReadRequest{
+		Urn:        urn,
+		ID:         resp.ID,
+		Properties: olds,
+		Inputs:     cResp.Inputs,
+	})
+	require.NoError(t, err)
+	changes := rResp.Properties["localChanges"].ObjectValue()
+	assert.Equal(t, resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("VERSION")}), changes["files"])
+	require.Len(t, changes["unpushedCommits"].ArrayValue(), 1)
+	assert.True(t, strings.HasSuffix(changes["unpushedCommits"].ArrayValue()[0].StringValue(), " wip"))
+	assert.Equal(t, resource.NewNumberProperty(0), changes["stashes"])
+
+	t.Run("without-git-cli", func(t *testing.T) {
+		// go-git finds the same changes when the git CLI isn't installed.
+		// go-git only needs git-upload-pack to fetch from a file:// remote
+		bin := t.TempDir()
+		require.NoError(t, os.Symlink(path.Join(git("--exec-path"), "git-upload-pack"), path.Join(bin, "git-upload-pack")))
+		t.Setenv("PATH", bin)
+		rResp, err := cmd.Read(p.ReadRequest{
+			Urn:        urn,
+			ID:         resp.ID,
+			Properties: olds,
+			Inputs:     cResp.Inputs,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, changes, rResp.Properties["localChanges"].ObjectValue())
+	})
+
+	update := func(onLocalChanges string) error {
+		news := news.Copy()
+		news["version"] = resource.PropertyValue{V: commit(onLocalChanges)}
+		news["onLocalChanges"] = resource.PropertyValue{V: onLocalChanges}
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn:  urn,
+			Olds: olds,
+			News: news,
+		})
+		require.NoError(t, err)
+		uResp, err := cmd.Update(p.UpdateRequest{
+			Urn:  urn,
+			Olds: olds,
+			News: cResp.Inputs,
+		})
+		if err == nil {
+			olds = uResp.Properties
+		}
+		return err
+	}
+	del := func(props resource.PropertyMap) error {
+		return cmd.Delete(p.DeleteRequest{
+			Urn:        urn,
+			ID:         resp.ID,
+			Properties: props,
+		})
+	}
+
+	// an update that doesn't move HEAD can still change the files in the
+	// clone, e.g. a new sparsePaths
+	sparse := news.Copy()
+	sparse["sparsePaths"] = resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("docs")})
+	sResp, err := cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		Olds: olds,
+		News: sparse,
+	})
+	require.NoError(t, err)
+	_, err = cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: olds,
+		News: sResp.Inputs,
+	})
+	assert.ErrorContains(t, err, "local changes would be lost: changes to VERSION, 1 unpushed commit")
+	b, err := os.ReadFile(path.Join(clone, "VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "local", string(b))
+
+	err = update("fail")
+	assert.ErrorContains(t, err, "local changes would be lost: changes to VERSION, 1 unpushed commit")
+	b, err = os.ReadFile(path.Join(clone, "VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "local", string(b))
+	assert.ErrorContains(t, del(olds), "set onLocalChanges to discard them or retainOnDelete to keep the clone")
+	assert.DirExists(t, clone)
+
+	// stash keeps the changed file in a stash and the commit on a branch
+	wip := git("rev-parse", "--short=7", "HEAD")
+	require.NoError(t, update("stash"))
+	b, err = os.ReadFile(path.Join(clone, "VERSION"))
+	require.NoError(t, err)
+	assert.Equal(t, "stash", string(b))
+	assert.Contains(t, git("stash", "list"), "pulumi-pde: local changes before update")
+	assert.Equal(t, "pde-"+wip, git("branch", "--list", "--format=%(refname:short)", "pde-*"))
+
+	// the stash is still there, so the clone is only kept or thrown away if
+	// asked to
+	retained := olds.Copy()
+	retained["retainOnDelete"] = resource.PropertyValue{V: true}
+	require.NoError(t, del(retained))
+	assert.DirExists(t, clone)
+	discarded := olds.Copy()
+	discarded["onLocalChanges"] = resource.PropertyValue{V: "discard"}
+	require.NoError(t, del(discarded))
+	assert.NoDirExists(t, clone)
+}
+
+func TestGitHubRepoUnpushedCommits(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	src, commit := gitRepo(t, "tool")
+	clone := path.Join(home, "tool")
+	run := func(env []string, args ...string) string {
+		c := exec.Command("git", append([]string{"-C", clone}, args...)...)
+		c.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		c.Env = append(c.Env, env...)
+		out, err := c.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	git := func(args ...string) string {
+		return run(nil, args...)
+	}
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn: urn,
+		News: resource.PropertyMap{
+			"url": resource.PropertyValue{V: "file://" + src},
+		},
+	})
+	require.NoError(t, err)
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+
+	// go-git only needs git-upload-pack to fetch from a file:// remote
+	bin := t.TempDir()
+	require.NoError(t, os.Symlink(path.Join(git("--exec-path"), "git-upload-pack"), path.Join(bin, "git-upload-pack")))
+	unpushed := func(t *testing.T) []string {
+		rResp, err := cmd.Read(p.ReadRequest{
+			Urn:        urn,
+			ID:         resp.ID,
+			Properties: resp.Properties,
+			Inputs:     cResp.Inputs,
+		})
+		require.NoError(t, err)
+		changes, ok := rResp.Properties["localChanges"]
+		if !ok || changes.IsNull() {
+			return nil
+		}
+		var commits []string
+		for _, c := range changes.ObjectValue()["unpushedCommits"].ArrayValue() {
+			commits = append(commits, c.StringValue())
+		}
+		return commits
+	}
+	// both the git CLI and go-git, when the CLI isn't installed, find the
+	// commits that git log does
+	check := func(name string) {
+		t.Run(name, func(t *testing.T) {
+			var expected []string
+			for _, line := range strings.Split(git("log", "--format=%H %s", "--branches", "HEAD", "--not", "--remotes", "--tags", "--"), "\n") {
+				if line != "" {
+					expected = append(expected, line[:7]+line[40:])
+				}
+			}
+			assert.Equal(t, expected, unpushed(t))
+			t.Setenv("PATH", bin)
+			assert.Equal(t, expected, unpushed(t))
+		})
+	}
+
+	check("clean")
+	git("commit", "--allow-empty", "-m", "detached")
+	check("detached-head")
+	git("checkout", "-b", "feature")
+	git("commit", "--allow-empty", "-m", "feature 1")
+	git("commit", "--allow-empty", "-m", "feature 2")
+	check("local-branch")
+	git("tag", "lightweight", "HEAD~1")
+	check("lightweight-tag")
+	git("tag", "-a", "annotated", "-m", "annotated")
+	check("annotated-tag")
+
+	// commits with an older commit time than the remote commits they are
+	// merged with are still found
+	commit("2.0.0")
+	git("fetch", "origin")
+	git("checkout", "-b", "old", "lightweight~2")
+	run([]string{"GIT_COMMITTER_DATE=2000-01-01T00:00:00Z", "GIT_AUTHOR_DATE=2000-01-01T00:00:00Z"},
+		"commit", "--allow-empty", "-m", "old")
+	git("merge", "--no-ff", "-m", "merge", "origin/main")
+	check("merge")
+	git("checkout", "--detach", "origin/main")
+	check("remote-head")
+}
+
+func TestGitHubRepoChangedFiles(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	src, _ := gitRepo(t, "tool")
+	clone := path.Join(home, "tool")
+	git := func(args ...string) string {
+		c := exec.Command("git", append([]string{"-C", clone}, args...)...)
+		out, err := c.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(path.Join(clone, name), []byte(content), 0644))
+	}
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn: urn,
+		News: resource.PropertyMap{
+			"url": resource.PropertyValue{V: "file://" + src},
+		},
+	})
+	require.NoError(t, err)
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+
+	bin := t.TempDir()
+	require.NoError(t, os.Symlink(path.Join(git("--exec-path"), "git-upload-pack"), path.Join(bin, "git-upload-pack")))
+	files := func(t *testing.T) []string {
+		rResp, err := cmd.Read(p.ReadRequest{
+			Urn:        urn,
+			ID:         resp.ID,
+			Properties: resp.Properties,
+			Inputs:     cResp.Inputs,
+		})
+		require.NoError(t, err)
+		changes, ok := rResp.Properties["localChanges"]
+		if !ok || changes.IsNull() {
+			return nil
+		}
+		var files []string
+		for _, f := range changes.ObjectValue()["files"].ArrayValue() {
+			files = append(files, f.StringValue())
+		}
+		return files
+	}
+	// the git CLI and go-git, when the CLI isn't installed, find the same
+	// changed files
+	check := func(name string, expected ...string) {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, expected, files(t))
+			t.Setenv("PATH", bin)
+			assert.Equal(t, expected, files(t))
+		})
+	}
+
+	check("clean")
+	write("untracked", "build output")
+	check("untracked")
+	write("VERSION", "modified")
+	check("modified", "VERSION")
+	git("add", "VERSION")
+	write("VERSION", "1.0.0")
+	check("staged", "VERSION")
+	git("checkout", "HEAD", "--", "VERSION")
+	git("mv", "VERSION", "RENAMED")
+	check("renamed", "RENAMED", "VERSION")
+	git("mv", "RENAMED", "VERSION")
+	git("rm", "-q", "VERSION")
+	check("deleted", "VERSION")
+}
+
+func TestGitHubRepoDrift(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	src, commit := gitRepo(t, "tool")
+	head := commit("1.1.0")
+	clone := path.Join(home, "tool")
+	git := func(args ...string) string {
+		out, err := exec.Command("git", append([]string{"-C", clone}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	news := resource.PropertyMap{
+		"url": resource.PropertyValue{V: "file://" + src},
+	}
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+
+	read := func() p.ReadResponse {
+		rResp, err := cmd.Read(p.ReadRequest{
+			Urn:        urn,
+			ID:         resp.ID,
+			Properties: resp.Properties,
+			Inputs:     cResp.Inputs,
+		})
+		require.NoError(t, err)
+		return rResp
+	}
+	diff := func(rResp p.ReadResponse) (p.CheckResponse, p.DiffResponse) {
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn:  urn,
+			Olds: rResp.Inputs,
+			News: news.Copy(),
+		})
+		require.NoError(t, err)
+		dResp, err := cmd.Diff(p.DiffRequest{
+			Urn:  urn,
+			Olds: rResp.Properties,
+			News: cResp.Inputs,
+		})
+		require.NoError(t, err)
+		return cResp, dResp
+	}
+
+	rResp := read()
+	_, dResp := diff(rResp)
+	assert.False(t, dResp.HasChanges)
+
+	// another commit and branch are checked out
+	{
+		git("checkout", "--quiet", "HEAD~1")
+		git("checkout", "--quiet", "-b", "feature")
+		rResp := read()
+		assert.Equal(t, resource.PropertyValue{V: git("rev-parse", "HEAD")}, rResp.Properties["version"])
+		assert.Equal(t, resource.PropertyValue{V: "feature"}, rResp.Properties["branch"])
+		cResp, dResp := diff(rResp)
+		assert.Equal(t, p.Update, dResp.DetailedDiff["version"].Kind)
+		assert.Equal(t, p.Update, dResp.DetailedDiff["branch"].Kind)
+
+		_, err := cmd.Update(p.UpdateRequest{
+			Urn:  urn,
+			Olds: rResp.Properties,
+			News: cResp.Inputs,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, head, git("rev-parse", "HEAD"))
+		assert.Equal(t, "HEAD", git("rev-parse", "--abbrev-ref", "HEAD"))
+	}
+
+	// origin is pointed at a fork
+	{
+		fork, _ := gitRepo(t, "fork")
+		git("remote", "set-url", "origin", "file://"+fork)
+		rResp := read()
+		assert.Equal(t, resource.PropertyValue{V: "file://" + fork}, rResp.Properties["url"])
+		_, dResp := diff(rResp)
+		assert.Equal(t, p.UpdateReplace, dResp.DetailedDiff["url"].Kind)
+		git("remote", "set-url", "origin", "file://"+src)
+	}
+
+	// the clone is removed
+	{
+		require.NoError(t, os.RemoveAll(clone))
+		rResp := read()
+		assert.NotContains(t, rResp.Properties, resource.PropertyKey("absFolderName"))
+		_, dResp := diff(rResp)
+		assert.Equal(t, p.UpdateReplace, dResp.DetailedDiff["absFolderName"].Kind)
+		require.NoError(t, cmd.Delete(p.DeleteRequest{
+			Urn:        urn,
+			ID:         resp.ID,
+			Properties: rResp.Properties,
+		}))
+	}
+}
+
+func TestGitHubRepoLocation(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	urn := urn("installers", "GitHubRepo")
+	src, _ := gitRepo(t, "tool")
+
+	create := func(cmd integration.Server, folderName string) (resource.PropertyMap, error) {
+		news := resource.PropertyMap{
+			"url": resource.PropertyValue{V: "file://" + src},
+		}
+		if folderName != "" {
+			news["folderName"] = resource.PropertyValue{V: folderName}
+		}
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn:  urn,
+			News: news,
+		})
+		require.NoError(t, err)
+		resp, err := cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: cResp.Inputs.Copy(),
+		})
+		return resp.Properties, err
+	}
+
+	abs := path.Join(t.TempDir(), "opt", "src", "tool")
+	props, err := create(provider(), abs)
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyValue{V: abs}, props["absFolderName"])
+	assert.FileExists(t, path.Join(abs, "VERSION"))
+
+	props, err = create(provider(), "~/code/tool")
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyValue{V: path.Join(home, "code", "tool")}, props["absFolderName"])
+
+	// repos without a folderName go under the sourceRoot of the provider
+	cmd := provider()
+	require.NoError(t, cmd.Configure(p.ConfigureRequest{
+		Args: resource.PropertyMap{"sourceRoot": resource.PropertyValue{V: "~/src"}},
+	}))
+	props, err = create(cmd, "")
+	require.NoError(t, err)
+	org := strings.TrimPrefix(path.Dir(src), "/")
+	assert.Equal(t, resource.PropertyValue{V: path.Join("~/src", org, "tool")}, props["folderName"])
+	assert.Equal(t, resource.PropertyValue{V: path.Join(home, "src", org, "tool")}, props["absFolderName"])
+
+	require.NoError(t, os.WriteFile(path.Join(home, "file"), nil, 0644))
+	_, err = create(provider(), "~/file/tool")
+	assert.ErrorContains(t, err, "creating the parent directory of "+path.Join(home, "file", "tool"))
+}
+
+func TestGitHubRepoRemotes(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	upstream, commit := gitRepo(t, "upstream")
+	fork := path.Join(t.TempDir(), "fork")
+	out, err := exec.Command("git", "clone", "--quiet", upstream, fork).CombinedOutput()
+	require.NoError(t, err, string(out))
+	forkHead := strings.TrimSpace(string(must(exec.Command("git", "-C", fork, "rev-parse", "HEAD").Output())))
+	upstreamHead := commit("2.0.0")
+
+	clone := path.Join(home, "fork")
+	git := func(args ...string) string {
+		out, err := exec.Command("git", append([]string{"-C", clone}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	remotes := resource.NewObjectProperty(resource.PropertyMap{
+		"upstream": resource.NewObjectProperty(resource.PropertyMap{
+			"url":     resource.PropertyValue{V: "file://" + upstream},
+			"pushUrl": resource.PropertyValue{V: "no_push"},
+		}),
+	})
+	news := resource.PropertyMap{
+		"url":         resource.PropertyValue{V: "file://" + fork},
+		"remotes":     remotes,
+		"trackRemote": resource.PropertyValue{V: "upstream"},
+	}
+
+	// the version comes from the branch of upstream, which the fork is
+	// behind
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, cResp.Failures)
+	assert.Equal(t, resource.PropertyValue{V: upstreamHead}, cResp.Inputs["version"])
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, upstreamHead, git("rev-parse", "HEAD"))
+	assert.Equal(t, "file://"+upstream, git("remote", "get-url", "upstream"))
+	assert.Equal(t, "no_push", git("remote", "get-url", "--push", "upstream"))
+
+	// a push url that was removed by hand is put back
+	git("config", "--unset", "remote.upstream.pushurl")
+	rResp, err := cmd.Read(p.ReadRequest{
+		Urn:        urn,
+		ID:         resp.ID,
+		Properties: resp.Properties,
+		Inputs:     cResp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, rResp.Properties["remotes"].ObjectValue()["upstream"].ObjectValue(), resource.PropertyKey("pushUrl"))
+	cResp, err = cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		Olds: rResp.Inputs,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	dResp, err := cmd.Diff(p.DiffRequest{
+		Urn:  urn,
+		Olds: rResp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, p.Update, dResp.DetailedDiff["remotes"].Kind)
+	uResp, err := cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: rResp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "no_push", git("remote", "get-url", "--push", "upstream"))
+
+	// going back to the fork alone removes upstream and follows origin
+	git("remote", "add", "mine", "file://"+fork)
+	news = resource.PropertyMap{
+		"url": resource.PropertyValue{V: "file://" + fork},
+	}
+	cResp, err = cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		Olds: cResp.Inputs,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyValue{V: forkHead}, cResp.Inputs["version"])
+	_, err = cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: uResp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "mine\norigin", git("remote"))
+	assert.Empty(t, git("branch", "--remotes", "--list", "upstream/*"))
+	assert.Equal(t, forkHead, git("rev-parse", "HEAD"))
+
+	t.Run("invalid", func(t *testing.T) {
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn: urn,
+			News: resource.PropertyMap{
+				"url": resource.PropertyValue{V: "file://" + fork},
+				"remotes": resource.NewObjectProperty(resource.PropertyMap{
+					"origin": resource.NewObjectProperty(resource.PropertyMap{
+						"url": resource.PropertyValue{V: "file://" + upstream},
+					}),
+				}),
+				"trackRemote": resource.PropertyValue{V: "upstream"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []p.CheckFailure{
+			{Property: "remotes", Reason: "the url of origin must be file://" + fork + ", the url of the repo"},
+			{Property: "trackRemote", Reason: "trackRemote must be origin or one of remotes"},
+		}, cResp.Failures)
+	})
+}
+
+func TestGitHubRepoExecutables(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "GitHubRepo")
+
+	src, _ := gitRepo(t, "tool")
+	bin := path.Join(t.TempDir(), "bin")
+	clone := path.Join(home, "tool")
+	news := resource.PropertyMap{
+		"url": resource.PropertyValue{V: "file://" + src},
+		"installCommands": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("mkdir -p out && printf '#!/bin/sh\\n' | tee out/tool out/other && chmod +x out/*"),
+		}),
+		"executables": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("out/tool"),
+		}),
+		"binLocation": resource.PropertyValue{V: bin},
+	}
+
+	cResp, err := cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, cResp.Failures)
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: cResp.Inputs.Copy(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewStringProperty(path.Join(bin, "tool")),
+	}), resp.Properties["locations"])
+	target, err := os.Readlink(path.Join(bin, "tool"))
+	require.NoError(t, err)
+	assert.Equal(t, path.Join(clone, "out", "tool"), target)
+
+	// links that are no longer wanted are removed
+	news["executables"] = resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewStringProperty("out/other"),
+	})
+	cResp, err = cmd.Check(p.CheckRequest{
+		Urn:  urn,
+		Olds: cResp.Inputs,
+		News: news.Copy(),
+	})
+	require.NoError(t, err)
+	dResp, err := cmd.Diff(p.DiffRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, p.Update, dResp.DetailedDiff["executables"].Kind)
+	uResp, err := cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: cResp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.NoFileExists(t, path.Join(bin, "tool"))
+	target, err = os.Readlink(path.Join(bin, "other"))
+	require.NoError(t, err)
+	assert.Equal(t, path.Join(clone, "out", "other"), target)
+
+	err = cmd.Delete(p.DeleteRequest{
+		Urn:        urn,
+		Properties: uResp.Properties,
+	})
+	require.NoError(t, err)
+	assert.NoFileExists(t, path.Join(bin, "other"))
+	assert.NoDirExists(t, clone)
+
+	t.Run("not-built", func(t *testing.T) {
+		news := news.Copy()
+		news["executables"] = resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("out/missing"),
+		})
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn:  urn,
+			News: news,
+		})
+		require.NoError(t, err)
+		_, err = cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: cResp.Inputs.Copy(),
+		})
+		assert.ErrorContains(t, err, "executable out/missing was not built")
+		assert.NoDirExists(t, clone)
+	})
+
+	t.Run("wrong-platform", func(t *testing.T) {
+		// the header of an ELF binary for riscv64, which no host the tests
+		// run on can run
+		header := make([]byte, 64)
+		copy(header, []byte{0x7f, 'E', 'L', 'F', 2, 1, 1})
+		binary.LittleEndian.PutUint16(header[16:], uint16(elf.ET_EXEC))
+		binary.LittleEndian.PutUint16(header[18:], uint16(elf.EM_RISCV))
+		binary.LittleEndian.PutUint32(header[20:], uint32(elf.EV_CURRENT))
+		binary.LittleEndian.PutUint16(header[52:], 64)
+		riscv := path.Join(t.TempDir(), "riscv")
+		require.NoError(t, os.WriteFile(riscv, header, 0755))
+
+		news := news.Copy()
+		news["installCommands"] = resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("mkdir -p out && printf '#!/bin/sh\\n' > out/tool && chmod +x out/tool && cp " + riscv + " out/cross"),
+		})
+		news["executables"] = resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("out/tool"),
+			resource.NewStringProperty("out/cross"),
+		})
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn:  urn,
+			News: news,
+		})
+		require.NoError(t, err)
+		_, err = cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: cResp.Inputs.Copy(),
+		})
+		assert.ErrorContains(t, err, "executable out/cross: cross is a ELF binary for any/")
+		// nothing is linked, not even the executables that could run
+		assert.NoFileExists(t, path.Join(bin, "tool"))
+		assert.NoDirExists(t, clone)
+	})
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
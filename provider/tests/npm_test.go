@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"fmt"
 	"os"
 	"path"
 	"testing"
@@ -225,3 +226,152 @@ func TestNpmCommand(t *testing.T) {
 		})
 	}
 }
+
+// fakeNpm writes an npm to a new directory that logs its arguments and the
+// PDE_TEST_LEAK variable and links a pde-npm-tool into node_modules/.bin on
+// install. It returns the directory and the log
+func fakeNpm(t *testing.T) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	log := path.Join(t.TempDir(), "npm.log")
+	script := fmt.Sprintf(`#!/bin/sh
+echo "$* ${PDE_TEST_LEAK-unset}" >> %s
+if [ "$1" = install ]; then
+	mkdir -p node_modules/.bin
+	printf '#!/bin/sh\necho npm-tool\n' > node_modules/.bin/pde-npm-tool
+	chmod +x node_modules/.bin/pde-npm-tool
+fi
+`, log)
+	require.NoError(t, os.WriteFile(path.Join(dir, "npm"), []byte(script), 0755))
+	return dir, log
+}
+
+func TestNpmInheritEnvironment(t *testing.T) {
+	t.Setenv("PDE_TEST_LEAK", "leak")
+	cmd := provider()
+	npmUrn := urn("installers", "Npm")
+
+	npm, log := fakeNpm(t)
+	require.NoError(t, cmd.Configure(p.ConfigureRequest{
+		Args: resource.PropertyMap{"binPaths": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty(npm),
+		})},
+	}))
+	loc := t.TempDir()
+	marker := path.Join(t.TempDir(), "installed")
+
+	check, err := cmd.Check(p.CheckRequest{
+		Urn: npmUrn,
+		News: resource.PropertyMap{
+			"location":           resource.NewStringProperty(loc),
+			"package":            resource.NewStringProperty("pde-npm-tool"),
+			"version":            resource.NewStringProperty("1.0.0"),
+			"inheritEnvironment": resource.NewBoolProperty(false),
+			// the executables of the package are on PATH for the hooks
+			"postInstall": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.NewStringProperty(fmt.Sprintf("pde-npm-tool > %s", marker)),
+			}),
+			"uninstallCommands": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.NewStringProperty(`echo "uninstall ${PDE_TEST_LEAK-unset}"`),
+			}),
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, check.Failures)
+
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        npmUrn,
+		Properties: check.Inputs,
+	})
+	require.NoError(t, err)
+	b, err := os.ReadFile(marker)
+	require.NoError(t, err)
+	assert.Equal(t, "npm-tool\n", string(b))
+
+	// the executables of the package can be used by later resources
+	shell, err := cmd.Create(p.CreateRequest{
+		Urn: urn("installers", "Shell"),
+		Properties: resource.PropertyMap{
+			"installCommands":    resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("pde-npm-tool")}),
+			"programName":        resource.NewStringProperty("tool"),
+			"downloadURL":        resource.NewStringProperty("file://" + marker),
+			"binLocation":        resource.NewStringProperty(t.TempDir()),
+			"inheritEnvironment": resource.NewBoolProperty(false),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewStringProperty("npm-tool"), shell.Properties["stdout"])
+
+	require.NoError(t, cmd.Delete(p.DeleteRequest{
+		ID:         resp.ID,
+		Urn:        npmUrn,
+		Properties: resp.Properties,
+	}))
+	b, err = os.ReadFile(log)
+	require.NoError(t, err)
+	assert.Equal(t, "install pde-npm-tool@1.0.0 unset\nuninstall pde-npm-tool unset\n", string(b))
+}
+
+func TestNpmVerify(t *testing.T) {
+	cmd := provider()
+	npmUrn := urn("installers", "Npm")
+
+	npm, log := fakeNpm(t)
+	t.Setenv("PATH", npm+":"+os.Getenv("PATH"))
+	loc := t.TempDir()
+	props := func(pkg, version, verify string) resource.PropertyMap {
+		return resource.PropertyMap{
+			"location": resource.NewStringProperty(loc),
+			"package":  resource.NewStringProperty(pkg),
+			"version":  resource.NewStringProperty(version),
+			"verify": resource.NewObjectProperty(resource.PropertyMap{
+				"command":        resource.NewStringProperty(verify),
+				"expectedOutput": resource.NewStringProperty(".*"),
+			}),
+		}
+	}
+
+	check, err := cmd.Check(p.CheckRequest{
+		Urn:  npmUrn,
+		News: props("pde-npm-tool", "1.0.0", "true"),
+	})
+	require.NoError(t, err)
+	require.Empty(t, check.Failures)
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        npmUrn,
+		Properties: check.Inputs,
+	})
+	require.NoError(t, err)
+
+	// a new package that fails to verify is removed and the old one is put
+	// back
+	check, err = cmd.Check(p.CheckRequest{
+		Urn:  npmUrn,
+		Olds: resp.Properties,
+		News: props("pde-other-tool", "2.0.0", "exit 1"),
+	})
+	require.NoError(t, err)
+	_, err = cmd.Update(p.UpdateRequest{
+		Urn:  npmUrn,
+		Olds: resp.Properties,
+		News: check.Inputs,
+	})
+	assert.ErrorContains(t, err, "verify failed")
+	b, err := os.ReadFile(log)
+	require.NoError(t, err)
+	assert.Equal(t, `install pde-npm-tool@1.0.0 unset
+install pde-other-tool@2.0.0 unset
+uninstall pde-other-tool unset
+install pde-npm-tool@1.0.0 unset
+`, string(b))
+
+	bad := props("pde-npm-tool", "1.0.0", "true")
+	bad["verify"].ObjectValue()["expectedOutput"] = resource.NewStringProperty("[")
+	check, err = cmd.Check(p.CheckRequest{
+		Urn:  npmUrn,
+		News: bad,
+	})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Equal(t, "verify.expectedOutput", string(check.Failures[0].Property))
+}
@@ -0,0 +1,216 @@
+package tests
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScriptCommand(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Script")
+
+	dir := t.TempDir()
+	installed := path.Join(dir, "installed")
+
+	inputs := resource.PropertyMap{
+		"create": resource.PropertyValue{V: "#!/bin/bash\necho -n v1 > installed\n"},
+		"update": resource.PropertyValue{V: "#!/bin/bash\necho -n v2 > installed\n"},
+		"delete": resource.PropertyValue{V: "rm installed\n"},
+		"read": resource.PropertyValue{V: `#!/usr/bin/env python3
+import json
+with open("installed") as f:
+    print(json.dumps({"version": f.read()}))
+`},
+		"dir": resource.PropertyValue{V: dir},
+	}
+
+	t.Run("create-preview", func(t *testing.T) {
+		resp, err := cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: inputs.Copy(),
+			Preview:    true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, inputs["create"], resp.Properties["create"])
+		_, err = os.Stat(installed)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	var state resource.PropertyMap
+	t.Run("create-actual", func(t *testing.T) {
+		resp, err := cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: inputs.Copy(),
+		})
+		require.NoError(t, err)
+		state = resp.Properties
+		assert.Equal(t, resource.NewObjectProperty(resource.PropertyMap{
+			"version": resource.NewStringProperty("v1"),
+		}), state["outputs"])
+	})
+
+	t.Run("update-actual", func(t *testing.T) {
+		news := inputs.Copy()
+		news["create"] = resource.PropertyValue{V: "#!/bin/bash\necho -n v1.1 > installed\n"}
+		dResp, err := cmd.Diff(p.DiffRequest{
+			Urn:  urn,
+			Olds: state,
+			News: news,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, p.Update, dResp.DetailedDiff["create"].Kind)
+
+		resp, err := cmd.Update(p.UpdateRequest{
+			Urn:  urn,
+			Olds: state,
+			News: news,
+		})
+		require.NoError(t, err)
+		state = resp.Properties
+		assert.Equal(t, resource.NewObjectProperty(resource.PropertyMap{
+			"version": resource.NewStringProperty("v2"),
+		}), state["outputs"])
+	})
+
+	t.Run("read", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(installed, []byte("v3"), 0600))
+		resp, err := cmd.Read(p.ReadRequest{
+			ID:         "script",
+			Urn:        urn,
+			Properties: state,
+			Inputs:     inputs,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, resource.NewObjectProperty(resource.PropertyMap{
+			"version": resource.NewStringProperty("v3"),
+		}), resp.Properties["outputs"])
+	})
+
+	t.Run("delete-actual", func(t *testing.T) {
+		err := cmd.Delete(p.DeleteRequest{
+			Urn:        urn,
+			Properties: state,
+		})
+		require.NoError(t, err)
+		_, err = os.Stat(installed)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestScriptInputs(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Script")
+
+	dir := t.TempDir()
+	answer := path.Join(dir, "answer")
+	inputs := resource.PropertyMap{
+		"create":      resource.PropertyValue{V: "touch installed\n"},
+		"delete":      resource.PropertyValue{V: "read answer && echo -n $answer > answer\n"},
+		"dir":         resource.PropertyValue{V: dir},
+		"stdin":       resource.PropertyValue{V: "n\n"},
+		"postInstall": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("touch hooked")}),
+	}
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: inputs.Copy(),
+	})
+	require.NoError(t, err)
+	assert.FileExists(t, path.Join(dir, "hooked"))
+
+	// every input is stored in state, even the ones that don't change what
+	// is installed, so that delete runs with the latest options
+	news := inputs.Copy()
+	news["stdin"] = resource.PropertyValue{V: "y\n"}
+	news["becomeMethod"] = resource.PropertyValue{V: "doas"}
+	news["secretOutputs"] = resource.PropertyValue{V: true}
+	news["inheritEnvironment"] = resource.PropertyValue{V: false}
+	news["uninstallCommands"] = resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("rm installed")})
+	dResp, err := cmd.Diff(p.DiffRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: news,
+	})
+	require.NoError(t, err)
+	for _, k := range []string{"stdin", "becomeMethod", "secretOutputs", "inheritEnvironment", "uninstallCommands"} {
+		assert.Equal(t, p.PropertyDiff{Kind: p.Update, InputDiff: true}, dResp.DetailedDiff[k], k)
+	}
+	uResp, err := cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: news,
+	})
+	require.NoError(t, err)
+
+	err = cmd.Delete(p.DeleteRequest{
+		Urn:        urn,
+		Properties: uResp.Properties,
+	})
+	require.NoError(t, err)
+	b, err := os.ReadFile(answer)
+	require.NoError(t, err)
+	assert.Equal(t, "y", string(b))
+	assert.NoFileExists(t, path.Join(dir, "installed"))
+}
+
+func TestScriptInterpreter(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Script")
+
+	dir := t.TempDir()
+	// interpreter is used instead of the shebang, which would fail
+	inputs := resource.PropertyMap{
+		"create":      resource.PropertyValue{V: "#!/bin/false\necho -n $GREETING > created\n"},
+		"delete":      resource.PropertyValue{V: "#!/bin/false\necho -n $GREETING > deleted\n"},
+		"dir":         resource.PropertyValue{V: dir},
+		"interpreter": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("/bin/sh")}),
+		"environment": resource.NewObjectProperty(resource.PropertyMap{
+			"GREETING": resource.NewStringProperty("hello"),
+		}),
+		"inheritEnvironment": resource.PropertyValue{V: false},
+	}
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: inputs.Copy(),
+	})
+	require.NoError(t, err)
+	b, err := os.ReadFile(path.Join(dir, "created"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+
+	news := inputs.Copy()
+	news["environment"] = resource.NewObjectProperty(resource.PropertyMap{
+		"GREETING": resource.NewStringProperty("bye"),
+	})
+	dResp, err := cmd.Diff(p.DiffRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: news,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{"environment": {Kind: p.Update, InputDiff: true}}, dResp.DetailedDiff)
+	uResp, err := cmd.Update(p.UpdateRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: news,
+	})
+	require.NoError(t, err)
+
+	err = cmd.Delete(p.DeleteRequest{
+		Urn:        urn,
+		Properties: uResp.Properties,
+	})
+	require.NoError(t, err)
+	b, err = os.ReadFile(path.Join(dir, "deleted"))
+	require.NoError(t, err)
+	assert.Equal(t, "bye", string(b))
+}
@@ -1,7 +1,14 @@
 package tests
 
 import (
+	"fmt"
+	"os"
+	"path"
+	"runtime"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 
 	p "github.com/pulumi/pulumi-go-provider"
 
@@ -43,6 +50,16 @@ func TestShellCommand(t *testing.T) {
 				"installCommands": resource.PropertyValue{V: []resource.PropertyValue{}},
 				"programName":     resource.PropertyValue{V: "cht.sh"},
 				"downloadURL":     resource.PropertyValue{V: "https://cht.sh/:cht.sh"},
+				"plan": resource.PropertyValue{V: resource.PropertyMap{
+					"downloadURL": resource.PropertyValue{V: "https://cht.sh/:cht.sh"},
+					"commands": resource.PropertyValue{V: []resource.PropertyValue{
+						{V: "curl -OL https://cht.sh/:cht.sh"},
+					}},
+					"writes": resource.PropertyValue{V: []resource.PropertyValue{
+						{V: path.Join(os.TempDir(), ":cht.sh")},
+					}},
+					"removes": resource.PropertyValue{V: []resource.PropertyValue{}},
+				}},
 			},
 		},
 		{
@@ -67,6 +84,19 @@ func TestShellCommand(t *testing.T) {
 				"programName":     resource.PropertyValue{V: "cht.sh"},
 				"downloadURL":     resource.PropertyValue{V: "https://cht.sh/:cht.sh"},
 				"version":         resource.PropertyValue{V: "0.0.0"},
+				"steps":           resource.PropertyValue{V: []resource.PropertyValue{}},
+				"stdout":          resource.PropertyValue{V: ""},
+				"stderr":          resource.PropertyValue{V: ""},
+				"plan": resource.PropertyValue{V: resource.PropertyMap{
+					"downloadURL": resource.PropertyValue{V: "https://cht.sh/:cht.sh"},
+					"commands": resource.PropertyValue{V: []resource.PropertyValue{
+						{V: "curl -OL https://cht.sh/:cht.sh"},
+					}},
+					"writes": resource.PropertyValue{V: []resource.PropertyValue{
+						{V: path.Join(os.TempDir(), ":cht.sh")},
+					}},
+					"removes": resource.PropertyValue{V: []resource.PropertyValue{}},
+				}},
 			},
 		},
 		{
@@ -112,6 +142,17 @@ func TestShellCommand(t *testing.T) {
 				"programName": resource.PropertyValue{V: "cht.sh"},
 				"downloadURL": resource.PropertyValue{V: "https://cht.sh/:cht.sh"},
 				"version":     resource.MakeComputed(resource.PropertyValue{V: "0.0.0"}),
+				"plan": resource.PropertyValue{V: resource.PropertyMap{
+					"downloadURL": resource.PropertyValue{V: "https://cht.sh/:cht.sh"},
+					"commands": resource.PropertyValue{V: []resource.PropertyValue{
+						{V: "curl -OL https://cht.sh/:cht.sh"},
+						{V: "echo 'hello world'"},
+					}},
+					"writes": resource.PropertyValue{V: []resource.PropertyValue{
+						{V: path.Join(os.TempDir(), ":cht.sh")},
+					}},
+					"removes": resource.PropertyValue{V: []resource.PropertyValue{}},
+				}},
 			},
 		},
 		{
@@ -146,6 +187,11 @@ func TestShellCommand(t *testing.T) {
 					Preview: preview,
 				})
 				require.NoError(t, err)
+				// durations differ between runs so only check the step results we can
+				steps := resp.Properties["steps"].ArrayValue()
+				require.Len(t, steps, 1)
+				assert.Equal(t, resource.NewNumberProperty(0), steps[0].ObjectValue()["exitCode"])
+				delete(resp.Properties, "steps")
 				return resp.Properties
 			},
 			preview: false,
@@ -157,6 +203,19 @@ func TestShellCommand(t *testing.T) {
 				"programName": resource.PropertyValue{V: "cht.sh"},
 				"downloadURL": resource.PropertyValue{V: "https://cht.sh/:cht.sh"},
 				"version":     resource.PropertyValue{V: "0.0.0"},
+				"stdout":      resource.PropertyValue{V: "hello world"},
+				"stderr":      resource.PropertyValue{V: ""},
+				"plan": resource.PropertyValue{V: resource.PropertyMap{
+					"downloadURL": resource.PropertyValue{V: "https://cht.sh/:cht.sh"},
+					"commands": resource.PropertyValue{V: []resource.PropertyValue{
+						{V: "curl -OL https://cht.sh/:cht.sh"},
+						{V: "echo 'hello world'"},
+					}},
+					"writes": resource.PropertyValue{V: []resource.PropertyValue{
+						{V: path.Join(os.TempDir(), ":cht.sh")},
+					}},
+					"removes": resource.PropertyValue{V: []resource.PropertyValue{}},
+				}},
 			},
 		},
 	}
@@ -169,3 +228,924 @@ func TestShellCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestShellRead(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	location := path.Join(t.TempDir(), "cht.sh")
+
+	state := resource.PropertyMap{
+		"binLocation":     resource.PropertyValue{V: path.Dir(location)},
+		"installCommands": resource.PropertyValue{V: []resource.PropertyValue{}},
+		"programName":     resource.PropertyValue{V: "cht.sh"},
+		"downloadURL":     resource.PropertyValue{V: "https://cht.sh/:cht.sh"},
+		"executable":      resource.PropertyValue{V: true},
+		"versionCommand":  resource.PropertyValue{V: "echo 'cht.sh version v1.2.3 (abc123)'"},
+		"versionRegex":    resource.PropertyValue{V: `v(\d+\.\d+\.\d+)`},
+		"desiredVersion":  resource.PropertyValue{V: "1.2.3"},
+		"version":         resource.PropertyValue{V: "1.0.0"},
+		"location":        resource.PropertyValue{V: location},
+	}
+	inputs := state.Copy()
+	delete(inputs, "version")
+	delete(inputs, "location")
+
+	resp, err := cmd.Read(p.ReadRequest{
+		ID:         "cht.sh",
+		Urn:        urn,
+		Properties: state,
+		Inputs:     inputs,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, resource.PropertyValue{V: "1.2.3"}, resp.Properties["version"])
+	assert.NotContains(t, resp.Properties, "location")
+
+	dResp, err := cmd.Diff(p.DiffRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: resp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, dResp.DetailedDiff, "location")
+	assert.NotContains(t, dResp.DetailedDiff, "desiredVersion")
+
+	// the desiredVersion is compared with the input, not with what
+	// versionCommand printed
+	inputs["versionCommand"] = resource.PropertyValue{V: "echo 'tool v1.2.3'"}
+	delete(inputs, "versionRegex")
+	resp, err = cmd.Read(p.ReadRequest{
+		ID:         "cht.sh",
+		Urn:        urn,
+		Properties: resp.Properties,
+		Inputs:     inputs,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyValue{V: "tool v1.2.3"}, resp.Properties["version"])
+	dResp, err = cmd.Diff(p.DiffRequest{
+		Urn:  urn,
+		Olds: resp.Properties,
+		News: resp.Inputs,
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, dResp.DetailedDiff, "desiredVersion")
+}
+
+// TestShellDiffExistingState checks that state written before desiredVersion
+// existed, where version is only an output, doesn't cause an update
+func TestShellDiffExistingState(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	for _, version := range []string{"0.0.0", "tool v1.2.3"} {
+		inputs := resource.PropertyMap{
+			"binLocation":     resource.PropertyValue{V: "/usr/local/bin"},
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{}},
+			"programName":     resource.PropertyValue{V: "tool"},
+			"downloadURL":     resource.PropertyValue{V: "https://example.com/tool"},
+		}
+		if version != "0.0.0" {
+			inputs["versionCommand"] = resource.PropertyValue{V: "echo 'tool v1.2.3'"}
+		}
+		olds := inputs.Copy()
+		olds["version"] = resource.PropertyValue{V: version}
+
+		resp, err := cmd.Diff(p.DiffRequest{
+			Urn:  urn,
+			Olds: olds,
+			News: inputs,
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.HasChanges, version)
+		assert.Empty(t, resp.DetailedDiff, version)
+	}
+}
+
+func TestShellCheckDownloadURL(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	resp, err := cmd.Check(p.CheckRequest{
+		Urn: urn,
+		News: resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{}},
+			"programName":     resource.PropertyValue{V: "tool"},
+			"desiredVersion":  resource.PropertyValue{V: "1.2.3"},
+			"downloadURL":     resource.PropertyValue{V: "https://example.com/v{{.Version}}/tool_{{.OS}}_{{.Arch}}.tar.gz"},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Failures)
+	assert.Equal(t,
+		resource.PropertyValue{V: fmt.Sprintf("https://example.com/v1.2.3/tool_%s_%s.tar.gz", runtime.GOOS, runtime.GOARCH)},
+		resp.Inputs["downloadURL"],
+	)
+
+	resp, err = cmd.Check(p.CheckRequest{
+		Urn: urn,
+		News: resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{}},
+			"programName":     resource.PropertyValue{V: "tool"},
+			"downloadURL":     resource.PropertyValue{V: "https://example.com/v{{.Version}}/tool.tar.gz"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Failures, 1)
+	assert.Equal(t, "downloadURL", string(resp.Failures[0].Property))
+
+	olds := resource.PropertyMap{
+		"binLocation":     resource.PropertyValue{V: "/usr/local/bin"},
+		"installCommands": resource.PropertyValue{V: []resource.PropertyValue{}},
+		"programName":     resource.PropertyValue{V: "tool"},
+		"desiredVersion":  resource.PropertyValue{V: "1.2.3"},
+		"downloadURL":     resource.PropertyValue{V: "https://example.com/v1.2.3/tool.tar.gz"},
+	}
+	news := olds.Copy()
+	news["desiredVersion"] = resource.PropertyValue{V: "1.3.0"}
+	news["downloadURL"] = resource.PropertyValue{V: "https://example.com/v1.3.0/tool.tar.gz"}
+	dResp, err := cmd.Diff(p.DiffRequest{
+		Urn:  urn,
+		Olds: olds,
+		News: news,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, p.Update, dResp.DetailedDiff["downloadURL"].Kind)
+	assert.Equal(t, p.Update, dResp.DetailedDiff["desiredVersion"].Kind)
+}
+
+func TestShellStdin(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	answered := path.Join(t.TempDir(), "answered")
+	err := cmd.Delete(p.DeleteRequest{
+		Urn: urn,
+		Properties: resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{}},
+			"programName":     resource.PropertyValue{V: "tool"},
+			"downloadURL":     resource.PropertyValue{V: "https://example.com/tool"},
+			"uninstallCommands": resource.PropertyValue{V: []resource.PropertyValue{
+				{V: fmt.Sprintf(`read answer && [ "$answer" = "y" ] && touch %s`, answered)},
+			}},
+			"stdin": resource.PropertyValue{V: "y\n"},
+		},
+	})
+	require.NoError(t, err)
+	_, err = os.Stat(answered)
+	assert.NoError(t, err)
+}
+
+func TestShellBecome(t *testing.T) {
+	// fake sudo and doas which record how they were run. Like the real ones
+	// they don't pass the environment of the caller on to the command
+	logs := t.TempDir()
+	bin := t.TempDir()
+	fake := fmt.Sprintf(`#!/bin/sh
+log=%s/$(basename "$0")
+printf '%%s\n' "$@" > "$log.args"
+if [ "$1" = "-A" ]; then
+  echo "$SUDO_ASKPASS" > "$log.askpass"
+  ls -l "$SUDO_ASKPASS" | cut -c1-10 > "$log.mode"
+  "$SUDO_ASKPASS" > "$log.password"
+fi
+shift 2
+exec env -i PATH="$PATH" "$@"
+`, logs)
+	for _, name := range []string{"sudo", "doas"} {
+		require.NoError(t, os.WriteFile(path.Join(bin, name), []byte(fake), 0755))
+	}
+	t.Setenv("PATH", bin+":"+os.Getenv("PATH"))
+	urn := urn("installers", "Shell")
+
+	src := path.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(src, []byte("#!/bin/sh\necho tool\n"), 0644))
+	inputs := func(method string, commands ...string) resource.PropertyMap {
+		var cmds []resource.PropertyValue
+		for _, c := range commands {
+			cmds = append(cmds, resource.NewStringProperty(c))
+		}
+		return resource.PropertyMap{
+			"installCommands": resource.NewArrayProperty(cmds),
+			"programName":     resource.PropertyValue{V: "tool"},
+			"downloadURL":     resource.PropertyValue{V: "file://" + src},
+			"binLocation":     resource.PropertyValue{V: t.TempDir()},
+			"become":          resource.PropertyValue{V: true},
+			"becomeMethod":    resource.PropertyValue{V: method},
+		}
+	}
+	read := func(name string) string {
+		b, err := os.ReadFile(path.Join(logs, name))
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	cmd := provider()
+	require.NoError(t, cmd.Configure(p.ConfigureRequest{
+		Args: resource.PropertyMap{"becomePassword": resource.PropertyValue{V: "hunter2"}},
+	}))
+
+	// the password only reaches sudo through the askpass helper
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: inputs("sudo", "env"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "-A\n--\n/bin/sh\n-c\nenv\n", read("sudo.args"))
+	assert.Equal(t, "hunter2\n", read("sudo.password"))
+	assert.Equal(t, "-rwx------\n", read("sudo.mode"))
+	assert.NotContains(t, resp.Properties["stdout"].StringValue(), "hunter2")
+	assert.NoFileExists(t, strings.TrimSpace(read("sudo.askpass")))
+
+	// the askpass helper is also removed when the command fails
+	_, err = cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: inputs("sudo", "env; exit 3"),
+	})
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "hunter2")
+	assert.Equal(t, "-A\n--\n/bin/sh\n-c\nenv; exit 3\n", read("sudo.args"))
+	assert.NoFileExists(t, strings.TrimSpace(read("sudo.askpass")))
+
+	// doas can't be given a password and typos fail before anything runs
+	for method, reason := range map[string]string{
+		"doas": "doas does not support reading a password non-interactively",
+		"sduo": `unknown becomeMethod "sduo", must be one of sudo or doas`,
+	} {
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn:  urn,
+			News: inputs(method, "true"),
+		})
+		require.NoError(t, err)
+		require.Len(t, cResp.Failures, 1)
+		assert.Equal(t, "becomeMethod", string(cResp.Failures[0].Property))
+		assert.Contains(t, cResp.Failures[0].Reason, reason)
+	}
+	_, err = cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: inputs("doas", "true"),
+	})
+	assert.ErrorContains(t, err, "doas does not support reading a password non-interactively")
+
+	// without a password neither method may prompt
+	_, err = provider().Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: inputs("doas", "true"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "-n\n--\n/bin/sh\n-c\ntrue\n", read("doas.args"))
+	_, err = provider().Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: inputs("doas", "exit 3"),
+	})
+	require.Error(t, err)
+	assert.Equal(t, "-n\n--\n/bin/sh\n-c\nexit 3\n", read("doas.args"))
+	_, err = provider().Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: inputs("sudo", "true"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "-n\n--\n/bin/sh\n-c\ntrue\n", read("sudo.args"))
+}
+
+func TestShellWorkspace(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	src := path.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(src, []byte("#!/bin/sh\necho tool\n"), 0644))
+	bin := t.TempDir()
+
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn: urn,
+		Properties: resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+				{V: "echo built > build.log"},
+			}},
+			"programName": resource.PropertyValue{V: "tool"},
+			"downloadURL": resource.PropertyValue{V: "file://" + src},
+			"binLocation": resource.PropertyValue{V: bin},
+			"executable":  resource.PropertyValue{V: true},
+			"workspace":   resource.PropertyValue{V: true},
+		},
+	})
+	require.NoError(t, err)
+
+	workspace := path.Join(home, ".local", "share", "pde", "shell", "name")
+	assert.Equal(t, resource.PropertyValue{V: workspace}, resp.Properties["workspaceDir"])
+	assert.Equal(t, resource.PropertyValue{V: path.Join(bin, "tool")}, resp.Properties["location"])
+	_, err = os.Stat(path.Join(workspace, "build.log"))
+	require.NoError(t, err)
+
+	err = cmd.Delete(p.DeleteRequest{
+		Urn:        urn,
+		Properties: resp.Properties,
+	})
+	require.NoError(t, err)
+	_, err = os.Stat(workspace)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(path.Join(bin, "tool"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestShellOutputs(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	src := path.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(src, []byte("#!/bin/sh\necho tool\n"), 0644))
+
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn: urn,
+		Properties: resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+				{V: "echo installing && echo warning >&2"},
+				{V: `echo '{"token": "abc", "count": 2}'`},
+			}},
+			"programName":   resource.PropertyValue{V: "tool"},
+			"downloadURL":   resource.PropertyValue{V: "file://" + src},
+			"binLocation":   resource.PropertyValue{V: t.TempDir()},
+			"outputsFrom":   resource.PropertyValue{V: "json"},
+			"secretOutputs": resource.PropertyValue{V: true},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, resource.MakeSecret(resource.NewStringProperty("installing\n{\"token\": \"abc\", \"count\": 2}")), resp.Properties["stdout"])
+	assert.Equal(t, resource.MakeSecret(resource.NewStringProperty("warning")), resp.Properties["stderr"])
+	assert.Equal(t, resource.MakeSecret(resource.NewObjectProperty(resource.PropertyMap{
+		"token": resource.NewStringProperty("abc"),
+		"count": resource.NewNumberProperty(2),
+	})), resp.Properties["outputs"])
+
+	_, err = cmd.Create(p.CreateRequest{
+		Urn: urn,
+		Properties: resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+				{V: "echo not json"},
+			}},
+			"programName": resource.PropertyValue{V: "tool"},
+			"downloadURL": resource.PropertyValue{V: "file://" + src},
+			"binLocation": resource.PropertyValue{V: t.TempDir()},
+			"outputsFrom": resource.PropertyValue{V: "json"},
+		},
+	})
+	assert.ErrorContains(t, err, "outputsFrom: expected a JSON object")
+
+	// the outputs of a last step that failed can't be trusted even if it may
+	// fail
+	_, err = cmd.Create(p.CreateRequest{
+		Urn: urn,
+		Properties: resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{}},
+			"installSteps": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.NewObjectProperty(resource.PropertyMap{
+					"command": resource.PropertyValue{V: `echo '{"token": "abc"}'`},
+				}),
+				resource.NewObjectProperty(resource.PropertyMap{
+					"name":            resource.PropertyValue{V: "outputs"},
+					"command":         resource.PropertyValue{V: `echo '{"token": "partial"}'; exit 3`},
+					"continueOnError": resource.PropertyValue{V: true},
+				}),
+			}),
+			"programName": resource.PropertyValue{V: "tool"},
+			"downloadURL": resource.PropertyValue{V: "file://" + src},
+			"binLocation": resource.PropertyValue{V: t.TempDir()},
+			"outputsFrom": resource.PropertyValue{V: "json"},
+		},
+	})
+	assert.ErrorContains(t, err, "outputsFrom: step 2 (outputs) failed, so it has no outputs")
+}
+
+func TestShellInheritEnvironment(t *testing.T) {
+	t.Setenv("PDE_TEST_LEAK", "leak")
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	src := path.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(src, []byte("#!/bin/sh\necho tool\n"), 0644))
+
+	// a tool installed by an earlier resource in the stack
+	bin := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(bin, "pde-env-tool"), []byte("#!/bin/sh\necho found\n"), 0755))
+	require.NoError(t, cmd.Configure(p.ConfigureRequest{
+		Args: resource.PropertyMap{"binPaths": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty(bin),
+		})},
+	}))
+
+	// a tool in the binLocation of the resource itself
+	own := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(own, "pde-own-tool"), []byte("#!/bin/sh\necho own\n"), 0755))
+	marker := path.Join(t.TempDir(), "deleted")
+
+	props := resource.PropertyMap{
+		"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+			{V: "pde-env-tool"},
+			{V: `echo "${PDE_TEST_LEAK-unset}"`},
+		}},
+		"uninstallCommands": resource.PropertyValue{V: []resource.PropertyValue{
+			{V: fmt.Sprintf("pde-own-tool > %s", marker)},
+		}},
+		"versionCommand":     resource.PropertyValue{V: `echo "${PDE_TEST_LEAK-unset}"`},
+		"programName":        resource.PropertyValue{V: "tool"},
+		"downloadURL":        resource.PropertyValue{V: "file://" + src},
+		"binLocation":        resource.PropertyValue{V: own},
+		"inheritEnvironment": resource.PropertyValue{V: false},
+	}
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn:        urn,
+		Properties: props,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewStringProperty("found\nunset"), resp.Properties["stdout"])
+	// the versionCommand doesn't inherit the environment either
+	assert.Equal(t, resource.NewStringProperty("unset"), resp.Properties["version"])
+
+	// a new provider, e.g. for a destroy, runs Read and Delete without Check
+	cmd = provider()
+	read, err := cmd.Read(p.ReadRequest{
+		ID:         resp.ID,
+		Urn:        urn,
+		Properties: resp.Properties,
+		Inputs:     props,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewStringProperty("unset"), read.Properties["version"])
+
+	require.NoError(t, cmd.Delete(p.DeleteRequest{
+		ID:         resp.ID,
+		Urn:        urn,
+		Properties: read.Properties,
+	}))
+	out, err := os.ReadFile(marker)
+	require.NoError(t, err)
+	assert.Equal(t, "own\n", string(out))
+}
+
+func TestShellCancel(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	src := path.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(src, []byte("#!/bin/sh\necho tool\n"), 0644))
+	pidFile := path.Join(t.TempDir(), "pid")
+
+	errs := make(chan error)
+	go func() {
+		_, err := cmd.Create(p.CreateRequest{
+			Urn: urn,
+			Properties: resource.PropertyMap{
+				"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+					{V: fmt.Sprintf("sleep 60 & echo $! > %s; wait", pidFile)},
+				}},
+				"programName": resource.PropertyValue{V: "tool"},
+				"downloadURL": resource.PropertyValue{V: "file://" + src},
+				"binLocation": resource.PropertyValue{V: t.TempDir()},
+			},
+		})
+		errs <- err
+	}()
+
+	var pid int
+	require.Eventually(t, func() bool {
+		b, err := os.ReadFile(pidFile)
+		if err != nil {
+			return false
+		}
+		_, err = fmt.Sscanf(string(b), "%d", &pid)
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, cmd.Cancel())
+	assert.Error(t, <-errs)
+
+	// the sleep started by the install command is stopped along with the shell
+	assert.Eventually(t, func() bool {
+		return syscall.Kill(pid, 0) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestShellCancelIgnoresTerm(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	src := path.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(src, []byte("#!/bin/sh\necho tool\n"), 0644))
+	pidFile := path.Join(t.TempDir(), "pid")
+
+	errs := make(chan error)
+	go func() {
+		_, err := cmd.Create(p.CreateRequest{
+			Urn: urn,
+			Properties: resource.PropertyMap{
+				// the shell exits on SIGTERM but the sleep it started keeps running
+				"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+					{V: fmt.Sprintf("(trap '' TERM; exec sleep 60) >/dev/null 2>&1 & echo $! > %s; sleep 60", pidFile)},
+				}},
+				"programName": resource.PropertyValue{V: "tool"},
+				"downloadURL": resource.PropertyValue{V: "file://" + src},
+				"binLocation": resource.PropertyValue{V: t.TempDir()},
+			},
+		})
+		errs <- err
+	}()
+
+	var pid int
+	require.Eventually(t, func() bool {
+		b, err := os.ReadFile(pidFile)
+		if err != nil {
+			return false
+		}
+		_, err = fmt.Sscanf(string(b), "%d", &pid)
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, cmd.Cancel())
+	assert.Error(t, <-errs)
+
+	// the orphaned sleep is killed once the grace period is over
+	assert.Eventually(t, func() bool {
+		return syscall.Kill(pid, 0) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestShellLifecycleHooks(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	src := path.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(src, []byte("#!/bin/sh\necho tool\n"), 0644))
+	bin := t.TempDir()
+	log := path.Join(t.TempDir(), "hooks.log")
+	hook := func(name string) resource.PropertyValue {
+		return resource.PropertyValue{V: []resource.PropertyValue{
+			{V: fmt.Sprintf(`echo "%s $PDE_LOCATION $PDE_VERSION" >> %s`, name, log)},
+		}}
+	}
+
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn: urn,
+		Properties: resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+				{V: fmt.Sprintf("echo install >> %s", log)},
+			}},
+			"uninstallCommands": resource.PropertyValue{V: []resource.PropertyValue{
+				{V: fmt.Sprintf("echo uninstall >> %s", log)},
+			}},
+			"programName":    resource.PropertyValue{V: "tool"},
+			"downloadURL":    resource.PropertyValue{V: "file://" + src},
+			"binLocation":    resource.PropertyValue{V: bin},
+			"executable":     resource.PropertyValue{V: true},
+			"desiredVersion": resource.PropertyValue{V: "1.0.0"},
+			"preInstall":     hook("preInstall"),
+			"postInstall":    hook("postInstall"),
+			"preUninstall":   hook("preUninstall"),
+			"postUninstall":  hook("postUninstall"),
+		},
+	})
+	require.NoError(t, err)
+
+	err = cmd.Delete(p.DeleteRequest{
+		Urn:        urn,
+		Properties: resp.Properties,
+	})
+	require.NoError(t, err)
+
+	location := path.Join(bin, "tool")
+	b, err := os.ReadFile(log)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`preInstall %[1]s 1.0.0
+install
+postInstall %[1]s 1.0.0
+preUninstall %[1]s 1.0.0
+uninstall
+postUninstall %[1]s 1.0.0
+`, location), string(b))
+}
+
+func TestShellUninstallErrors(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	src := path.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(src, []byte("#!/bin/sh\necho tool\n"), 0644))
+	create := func(t *testing.T, props resource.PropertyMap) (resource.PropertyMap, string) {
+		bin := t.TempDir()
+		props["installCommands"] = resource.PropertyValue{V: []resource.PropertyValue{}}
+		props["programName"] = resource.PropertyValue{V: "tool"}
+		props["downloadURL"] = resource.PropertyValue{V: "file://" + src}
+		props["binLocation"] = resource.PropertyValue{V: bin}
+		props["executable"] = resource.PropertyValue{V: true}
+		resp, err := cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: props,
+		})
+		require.NoError(t, err)
+		return resp.Properties, path.Join(bin, "tool")
+	}
+	del := func(props resource.PropertyMap) error {
+		return cmd.Delete(p.DeleteRequest{
+			Urn:        urn,
+			Properties: props,
+		})
+	}
+
+	// a failed uninstall fails the delete and leaves the program in place
+	t.Run("preUninstall", func(t *testing.T) {
+		props, location := create(t, resource.PropertyMap{
+			"preUninstall": resource.PropertyValue{V: []resource.PropertyValue{{V: "exit 3"}}},
+		})
+		assert.ErrorContains(t, del(props), "preUninstall hook")
+		assert.FileExists(t, location)
+	})
+	t.Run("uninstallCommands", func(t *testing.T) {
+		props, location := create(t, resource.PropertyMap{
+			"uninstallCommands": resource.PropertyValue{V: []resource.PropertyValue{{V: "exit 3"}}},
+		})
+		assert.ErrorContains(t, del(props), "step 1 (exit 3) failed")
+		assert.FileExists(t, location)
+	})
+
+	// unless the step that failed may fail
+	t.Run("continueOnError", func(t *testing.T) {
+		props, location := create(t, resource.PropertyMap{
+			"uninstallSteps": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.NewObjectProperty(resource.PropertyMap{
+					"command":         resource.PropertyValue{V: "exit 3"},
+					"continueOnError": resource.PropertyValue{V: true},
+				}),
+			}),
+		})
+		require.NoError(t, del(props))
+		assert.NoFileExists(t, location)
+	})
+}
+
+func TestShellVerify(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	dir := t.TempDir()
+	good := path.Join(dir, "good", "tool")
+	broken := path.Join(dir, "broken", "tool")
+	require.NoError(t, os.MkdirAll(path.Dir(good), 0755))
+	require.NoError(t, os.MkdirAll(path.Dir(broken), 0755))
+	require.NoError(t, os.WriteFile(good, []byte("#!/bin/sh\necho tool 1.0.0\n"), 0644))
+	require.NoError(t, os.WriteFile(broken, []byte("#!/bin/sh\nexit 1\n"), 0644))
+	bin := t.TempDir()
+	location := path.Join(bin, "tool")
+
+	props := func(src string) resource.PropertyMap {
+		return resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{}},
+			"programName":     resource.PropertyValue{V: "tool"},
+			"downloadURL":     resource.PropertyValue{V: "file://" + src},
+			"binLocation":     resource.PropertyValue{V: bin},
+			"executable":      resource.PropertyValue{V: true},
+			"verify": resource.PropertyValue{V: resource.PropertyMap{
+				"command":        resource.PropertyValue{V: "$PDE_LOCATION"},
+				"expectedOutput": resource.PropertyValue{V: `tool \d+\.\d+\.\d+`},
+			}},
+		}
+	}
+
+	t.Run("create-cleanup", func(t *testing.T) {
+		_, err := cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: props(broken),
+		})
+		assert.ErrorContains(t, err, "verify failed")
+		_, err = os.Stat(location)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("update-rollback", func(t *testing.T) {
+		resp, err := cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: props(good),
+		})
+		require.NoError(t, err)
+
+		_, err = cmd.Update(p.UpdateRequest{
+			Urn:  urn,
+			Olds: resp.Properties,
+			News: props(broken),
+		})
+		assert.ErrorContains(t, err, "verify failed")
+
+		b, err := os.ReadFile(location)
+		require.NoError(t, err)
+		assert.Equal(t, "#!/bin/sh\necho tool 1.0.0\n", string(b))
+		_, err = os.Stat(location + ".pde-backup")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("bad-regex", func(t *testing.T) {
+		news := props(good)
+		news["verify"].ObjectValue()["expectedOutput"] = resource.PropertyValue{V: `tool (\d+`}
+		cResp, err := cmd.Check(p.CheckRequest{
+			Urn:  urn,
+			News: news,
+		})
+		require.NoError(t, err)
+		require.Len(t, cResp.Failures, 1)
+		assert.Equal(t, "verify.expectedOutput", string(cResp.Failures[0].Property))
+		assert.Contains(t, cResp.Failures[0].Reason, "missing closing )")
+	})
+}
+
+func TestShellTrackChanges(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	src := path.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(src, []byte("#!/bin/sh\necho tool\n"), 0644))
+	bin := t.TempDir()
+	other := path.Join(home, ".config", "other")
+	require.NoError(t, os.MkdirAll(other, 0755))
+
+	resp, err := cmd.Create(p.CreateRequest{
+		Urn: urn,
+		Properties: resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+				{V: "mkdir -p $HOME/.config/tool && touch $HOME/.config/tool/config"},
+				{V: "mkdir -p $HOME/.local/share/tool/lib && touch $HOME/.local/share/tool/lib/libtool.so"},
+				{V: fmt.Sprintf("touch %s/tool-completion", bin)},
+			}},
+			"programName":  resource.PropertyValue{V: "tool"},
+			"downloadURL":  resource.PropertyValue{V: "file://" + src},
+			"binLocation":  resource.PropertyValue{V: bin},
+			"executable":   resource.PropertyValue{V: true},
+			"trackChanges": resource.PropertyValue{V: true},
+		},
+	})
+	require.NoError(t, err)
+
+	created := []resource.PropertyValue{
+		{V: path.Join(bin, "tool")},
+		{V: path.Join(bin, "tool-completion")},
+		{V: path.Join(home, ".config", "tool")},
+		{V: path.Join(home, ".local", "share", "tool")},
+	}
+	assert.ElementsMatch(t, created, resp.Properties["createdPaths"].ArrayValue())
+
+	err = cmd.Delete(p.DeleteRequest{
+		Urn:        urn,
+		Properties: resp.Properties,
+	})
+	require.NoError(t, err)
+	for _, c := range created {
+		_, err := os.Lstat(c.StringValue())
+		assert.True(t, os.IsNotExist(err), c.StringValue())
+	}
+	_, err = os.Stat(other)
+	assert.NoError(t, err)
+
+	t.Run("trackPaths", func(t *testing.T) {
+		resp, err := cmd.Create(p.CreateRequest{
+			Urn: urn,
+			Properties: resource.PropertyMap{
+				"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+					{V: "mkdir -p $HOME/.config/unrelated $HOME/.local/share/tool"},
+				}},
+				"programName":  resource.PropertyValue{V: "tool"},
+				"downloadURL":  resource.PropertyValue{V: "file://" + src},
+				"binLocation":  resource.PropertyValue{V: t.TempDir()},
+				"trackChanges": resource.PropertyValue{V: true},
+				"trackPaths": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewStringProperty("~/.local/share"),
+				}),
+			},
+		})
+		require.NoError(t, err)
+		created := resp.Properties["createdPaths"].ArrayValue()
+		assert.Contains(t, created, resource.NewStringProperty(path.Join(home, ".local", "share", "tool")))
+		assert.NotContains(t, created, resource.NewStringProperty(path.Join(home, ".config", "unrelated")))
+	})
+
+	t.Run("parallel", func(t *testing.T) {
+		started := path.Join(t.TempDir(), "started")
+		errs := make(chan error)
+		go func() {
+			// another resource that is installed while the tracked one runs
+			for {
+				if _, err := os.Stat(started); err == nil {
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			_, err := cmd.Create(p.CreateRequest{
+				Urn: urn,
+				Properties: resource.PropertyMap{
+					"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+						{V: "mkdir -p $HOME/.config/parallel"},
+					}},
+					"programName": resource.PropertyValue{V: "other"},
+					"downloadURL": resource.PropertyValue{V: "file://" + src},
+					"binLocation": resource.PropertyValue{V: t.TempDir()},
+				},
+			})
+			errs <- err
+		}()
+		resp, err := cmd.Create(p.CreateRequest{
+			Urn: urn,
+			Properties: resource.PropertyMap{
+				"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+					{V: fmt.Sprintf("touch %s && sleep 1", started)},
+				}},
+				"programName":  resource.PropertyValue{V: "tool"},
+				"downloadURL":  resource.PropertyValue{V: "file://" + src},
+				"binLocation":  resource.PropertyValue{V: t.TempDir()},
+				"trackChanges": resource.PropertyValue{V: true},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, <-errs)
+		assert.NotContains(t, resp.Properties["createdPaths"].ArrayValue(),
+			resource.NewStringProperty(path.Join(home, ".config", "parallel")))
+		_, err = os.Stat(path.Join(home, ".config", "parallel"))
+		assert.NoError(t, err)
+	})
+}
+
+func TestShellPlatform(t *testing.T) {
+	t.Parallel()
+	cmd := provider()
+	urn := urn("installers", "Shell")
+
+	// the test binary is built for the host so it is used as the asset
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	b, err := os.ReadFile(exe)
+	require.NoError(t, err)
+	src := path.Join(t.TempDir(), "tool_"+runtime.GOOS)
+	require.NoError(t, os.WriteFile(src, b, 0644))
+	other := "arm64"
+	if runtime.GOARCH == "arm64" {
+		other = "amd64"
+	}
+
+	props := func(bin, platform string) resource.PropertyMap {
+		props := resource.PropertyMap{
+			"installCommands": resource.PropertyValue{V: []resource.PropertyValue{
+				{V: "mv tool_* tool"},
+			}},
+			"programName": resource.PropertyValue{V: "tool"},
+			"downloadURL": resource.PropertyValue{V: "file://" + src},
+			"binLocation": resource.PropertyValue{V: bin},
+			"executable":  resource.PropertyValue{V: true},
+		}
+		if platform != "" {
+			props["platform"] = resource.PropertyValue{V: platform}
+		}
+		return props
+	}
+
+	t.Run("host", func(t *testing.T) {
+		bin := t.TempDir()
+		_, err := cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: props(bin, ""),
+		})
+		require.NoError(t, err)
+		_, err = os.Stat(path.Join(bin, "tool"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("wrong-arch", func(t *testing.T) {
+		bin := t.TempDir()
+		_, err := cmd.Create(p.CreateRequest{
+			Urn:        urn,
+			Properties: props(bin, runtime.GOOS+"/"+other),
+		})
+		assert.ErrorContains(t, err, fmt.Sprintf("asset tool_%s: tool is a", runtime.GOOS))
+		assert.ErrorContains(t, err, fmt.Sprintf("not %s/%s", runtime.GOOS, other))
+		_, err = os.Stat(path.Join(bin, "tool"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		resp, err := cmd.Check(p.CheckRequest{
+			Urn:  urn,
+			News: props(t.TempDir(), "linux"),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Failures, 1)
+		assert.Equal(t, "platform", string(resp.Failures[0].Property))
+	})
+}
@@ -0,0 +1,72 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Immutable;
+
+namespace Pulumi.Pde
+{
+    public static class Config
+    {
+        [global::System.Diagnostics.CodeAnalysis.SuppressMessage("Microsoft.Design", "IDE1006", Justification = 
+        "Double underscore prefix used to avoid conflicts with variable names.")]
+        private sealed class __Value<T>
+        {
+            private readonly Func<T> _getter;
+            private T _value = default!;
+            private bool _set;
+
+            public __Value(Func<T> getter)
+            {
+                _getter = getter;
+            }
+
+            public T Get() => _set ? _value : _getter();
+
+            public void Set(T value)
+            {
+                _value = value;
+                _set = true;
+            }
+        }
+
+        private static readonly global::Pulumi.Config __config = new global::Pulumi.Config("pde");
+
+        private static readonly __Value<string?> _becomePassword = new __Value<string?>(() => __config.Get("becomePassword"));
+        /// <summary>
+        /// The password to use when running commands with become. This is
+        /// 				passed to the become method through an askpass helper and is never written to state or logs
+        /// </summary>
+        public static string? BecomePassword
+        {
+            get => _becomePassword.Get();
+            set => _becomePassword.Set(value);
+        }
+
+        private static readonly __Value<ImmutableArray<string>> _binPaths = new __Value<ImmutableArray<string>>(() => __config.GetObject<ImmutableArray<string>>("binPaths"));
+        /// <summary>
+        /// Directories to put on PATH for commands that don't inherit the environment. They come
+        /// 				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+        /// 				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+        /// 				resources it deletes, so this can be used to always find tools installed by other resources
+        /// </summary>
+        public static ImmutableArray<string> BinPaths
+        {
+            get => _binPaths.Get();
+            set => _binPaths.Set(value);
+        }
+
+        private static readonly __Value<string?> _sourceRoot = new __Value<string?>(() => __config.Get("sourceRoot"));
+        /// <summary>
+        /// The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+        /// 				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+        /// 				Defaults to cloning to $HOME/$REPO
+        /// </summary>
+        public static string? SourceRoot
+        {
+            get => _sourceRoot.Get();
+            set => _sourceRoot.Set(value);
+        }
+
+    }
+}
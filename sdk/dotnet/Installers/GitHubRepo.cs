@@ -0,0 +1,751 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers
+{
+    /// <summary>
+    /// Install a program from a GitHub repository
+    /// </summary>
+    [PdeResourceType("pde:installers:GitHubRepo")]
+    public partial class GitHubRepo : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The absolute path to the folder the repo was cloned to
+        /// </summary>
+        [Output("absFolderName")]
+        public Output<string?> AbsFolderName { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to run the install, update and uninstall commands as root. If the become method
+        /// 				needs a password then it is read from the becomePassword provider config
+        /// </summary>
+        [Output("become")]
+        public Output<bool?> Become { get; private set; } = null!;
+
+        /// <summary>
+        /// The method to use to become root. Either sudo or doas. Defaults to sudo
+        /// </summary>
+        [Output("becomeMethod")]
+        public Output<string?> BecomeMethod { get; private set; } = null!;
+
+        /// <summary>
+        /// The location to link executables into. Defaults to $HOME/.local/bin
+        /// </summary>
+        [Output("binLocation")]
+        public Output<string?> BinLocation { get; private set; } = null!;
+
+        /// <summary>
+        /// The branch to clone from. Default to main
+        /// </summary>
+        [Output("branch")]
+        public Output<string?> Branch { get; private set; } = null!;
+
+        /// <summary>
+        /// Only fetch this many commits of history. Updates fetch more history if the new version
+        /// 				is not in it
+        /// </summary>
+        [Output("depth")]
+        public Output<int?> Depth { get; private set; } = null!;
+
+        /// <summary>
+        /// The environment variables to set when running the commands
+        /// </summary>
+        [Output("environment")]
+        public Output<ImmutableDictionary<string, string>?> Environment { get; private set; } = null!;
+
+        /// <summary>
+        /// The programs that the install commands build, relative to the clone, e.g.
+        /// 				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+        /// 				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+        /// </summary>
+        [Output("executables")]
+        public Output<ImmutableArray<string>> Executables { get; private set; } = null!;
+
+        /// <summary>
+        /// Make a partial clone that fetches files as they are needed, either blob:none to leave out
+        /// 				file contents or tree:0 to also leave out directories. This needs the git CLI
+        /// </summary>
+        [Output("filter")]
+        public Output<string?> Filter { get; private set; } = null!;
+
+        /// <summary>
+        /// The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+        /// 				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+        /// 				otherwise $HOME/$REPO
+        /// </summary>
+        [Output("folderName")]
+        public Output<string?> FolderName { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether commands inherit the environment of the provider. If this is false then
+        /// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        /// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        /// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        /// 				directories. Defaults to true
+        /// </summary>
+        [Output("inheritEnvironment")]
+        public Output<bool?> InheritEnvironment { get; private set; } = null!;
+
+        /// <summary>
+        /// The commands to run to install the program. Each command is run as a separate step
+        /// </summary>
+        [Output("installCommands")]
+        public Output<ImmutableArray<string>> InstallCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// The steps to run to install the program. These are run after installCommands
+        /// </summary>
+        [Output("installSteps")]
+        public Output<ImmutableArray<Outputs.Step>> InstallSteps { get; private set; } = null!;
+
+        /// <summary>
+        /// The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+        /// </summary>
+        [Output("interpreter")]
+        public Output<ImmutableArray<string>> Interpreter { get; private set; } = null!;
+
+        /// <summary>
+        /// Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+        /// </summary>
+        [Output("lfs")]
+        public Output<bool?> Lfs { get; private set; } = null!;
+
+        /// <summary>
+        /// The work in the clone that is not on the remote, as of the last refresh
+        /// </summary>
+        [Output("localChanges")]
+        public Output<Outputs.LocalChanges?> LocalChanges { get; private set; } = null!;
+
+        /// <summary>
+        /// The links to executables that were created in binLocation
+        /// </summary>
+        [Output("locations")]
+        public Output<ImmutableArray<string>> Locations { get; private set; } = null!;
+
+        /// <summary>
+        /// What to do when an update or delete would lose changed files, unpushed commits or
+        /// 				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+        /// 				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+        /// 				Defaults to fail
+        /// </summary>
+        [Output("onLocalChanges")]
+        public Output<string?> OnLocalChanges { get; private set; } = null!;
+
+        /// <summary>
+        /// The GitHub organization the repo belongs to. Not needed if url is set
+        /// </summary>
+        [Output("org")]
+        public Output<string?> Org { get; private set; } = null!;
+
+        /// <summary>
+        /// The outputs parsed from the last install or update. See outputsFrom
+        /// </summary>
+        [Output("outputs")]
+        public Output<ImmutableDictionary<string, object>?> Outputs { get; private set; } = null!;
+
+        /// <summary>
+        /// How to create the outputs map from the install and update commands. The only supported
+        /// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        /// 				even if it sets continueOnError
+        /// </summary>
+        [Output("outputsFrom")]
+        public Output<string?> OutputsFrom { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that were installed
+        /// </summary>
+        [Output("postInstall")]
+        public Output<ImmutableArray<string>> PostInstall { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        /// 				to the location and version that were removed
+        /// </summary>
+        [Output("postUninstall")]
+        public Output<ImmutableArray<string>> PostUninstall { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that will be installed
+        /// </summary>
+        [Output("preInstall")]
+        public Output<ImmutableArray<string>> PreInstall { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        /// 				and PDE_VERSION are set to the location and version that are installed
+        /// </summary>
+        [Output("preUninstall")]
+        public Output<ImmutableArray<string>> PreUninstall { get; private set; } = null!;
+
+        /// <summary>
+        /// The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+        /// 				develop or ~0.10. A constraint can use ~, ^ and ranges like &gt;=1.2.0 &lt;2.0.0 and resolves to
+        /// 				the highest tag that matches. Prerelease tags are never matched
+        /// </summary>
+        [Output("ref")]
+        public Output<string?> Ref { get; private set; } = null!;
+
+        /// <summary>
+        /// More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+        /// 				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+        /// 				removed from the clone, remotes that were added by hand are left alone
+        /// </summary>
+        [Output("remotes")]
+        public Output<ImmutableDictionary<string, Outputs.GitRemote>?> Remotes { get; private set; } = null!;
+
+        /// <summary>
+        /// The GitHub repository name. Not needed if url is set
+        /// </summary>
+        [Output("repo")]
+        public Output<string?> Repo { get; private set; } = null!;
+
+        /// <summary>
+        /// Leave the clone on disk when the resource is deleted. The uninstall commands
+        /// 				are still run
+        /// </summary>
+        [Output("retainOnDelete")]
+        public Output<bool?> RetainOnDelete { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to mark stdout, stderr and outputs as secret
+        /// </summary>
+        [Output("secretOutputs")]
+        public Output<bool?> SecretOutputs { get; private set; } = null!;
+
+        /// <summary>
+        /// Only check out these directories of the repo, along with the files at its root.
+        /// 				This needs the git CLI
+        /// </summary>
+        [Output("sparsePaths")]
+        public Output<ImmutableArray<string>> SparsePaths { get; private set; } = null!;
+
+        /// <summary>
+        /// The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+        /// 				is used. The host key of the remote must be in known_hosts
+        /// </summary>
+        [Output("sshKey")]
+        public Output<string?> SshKey { get; private set; } = null!;
+
+        /// <summary>
+        /// The password of sshKey if it is encrypted
+        /// </summary>
+        [Output("sshKeyPassword")]
+        public Output<string?> SshKeyPassword { get; private set; } = null!;
+
+        /// <summary>
+        /// The stderr of the last install or update
+        /// </summary>
+        [Output("stderr")]
+        public Output<string?> Stderr { get; private set; } = null!;
+
+        /// <summary>
+        /// Input to write to the stdin of the install, update and uninstall commands. This can be used
+        /// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+        /// </summary>
+        [Output("stdin")]
+        public Output<string?> Stdin { get; private set; } = null!;
+
+        /// <summary>
+        /// The stdout of the last install or update
+        /// </summary>
+        [Output("stdout")]
+        public Output<string?> Stdout { get; private set; } = null!;
+
+        /// <summary>
+        /// The results of the steps that were run by the last install or update
+        /// </summary>
+        [Output("steps")]
+        public Output<ImmutableArray<Outputs.StepResult>> Steps { get; private set; } = null!;
+
+        /// <summary>
+        /// How to check out submodules whenever the version changes. One of none, shallow to check
+        /// 				out only the commit each submodule points to, or recursive to also check out their submodules.
+        /// 				Defaults to none. This needs the git CLI
+        /// </summary>
+        [Output("submodules")]
+        public Output<string?> Submodules { get; private set; } = null!;
+
+        /// <summary>
+        /// The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+        /// </summary>
+        [Output("tag")]
+        public Output<string?> Tag { get; private set; } = null!;
+
+        /// <summary>
+        /// The remote whose branches and tags define version, either origin or one of remotes.
+        /// 				Defaults to origin
+        /// </summary>
+        [Output("trackRemote")]
+        public Output<string?> TrackRemote { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional Commands to run to uninstall the program
+        /// </summary>
+        [Output("uninstallCommands")]
+        public Output<ImmutableArray<string>> UninstallCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        /// 				A failing step fails the delete unless it sets continueOnError
+        /// </summary>
+        [Output("uninstallSteps")]
+        public Output<ImmutableArray<Outputs.Step>> UninstallSteps { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional Commands to run to update the program
+        /// </summary>
+        [Output("updateCommands")]
+        public Output<ImmutableArray<string>> UpdateCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional steps to run to update the program. These are run after updateCommands
+        /// </summary>
+        [Output("updateSteps")]
+        public Output<ImmutableArray<Outputs.Step>> UpdateSteps { get; private set; } = null!;
+
+        /// <summary>
+        /// The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+        /// 				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+        /// </summary>
+        [Output("url")]
+        public Output<string?> Url { get; private set; } = null!;
+
+        /// <summary>
+        /// A command to check that the program works after it is installed or updated. If this
+        /// 				fails then a create is cleaned up and an update is rolled back
+        /// </summary>
+        [Output("verify")]
+        public Output<Outputs.Verify?> Verify { get; private set; } = null!;
+
+        /// <summary>
+        /// The commit to check out. If this is not set then it is the commit that ref or the head of
+        /// 				branch resolves to
+        /// </summary>
+        [Output("version")]
+        public Output<string?> Version { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a GitHubRepo resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public GitHubRepo(string name, GitHubRepoArgs? args = null, CustomResourceOptions? options = null)
+            : base("pde:installers:GitHubRepo", name, args ?? new GitHubRepoArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private GitHubRepo(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("pde:installers:GitHubRepo", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "sshKeyPassword",
+                    "stdin",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing GitHubRepo resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static GitHubRepo Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new GitHubRepo(name, id, options);
+        }
+    }
+
+    public sealed class GitHubRepoArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether to run the install, update and uninstall commands as root. If the become method
+        /// 				needs a password then it is read from the becomePassword provider config
+        /// </summary>
+        [Input("become")]
+        public Input<bool>? Become { get; set; }
+
+        /// <summary>
+        /// The method to use to become root. Either sudo or doas. Defaults to sudo
+        /// </summary>
+        [Input("becomeMethod")]
+        public Input<string>? BecomeMethod { get; set; }
+
+        /// <summary>
+        /// The location to link executables into. Defaults to $HOME/.local/bin
+        /// </summary>
+        [Input("binLocation")]
+        public Input<string>? BinLocation { get; set; }
+
+        /// <summary>
+        /// The branch to clone from. Default to main
+        /// </summary>
+        [Input("branch")]
+        public Input<string>? Branch { get; set; }
+
+        /// <summary>
+        /// Only fetch this many commits of history. Updates fetch more history if the new version
+        /// 				is not in it
+        /// </summary>
+        [Input("depth")]
+        public Input<int>? Depth { get; set; }
+
+        [Input("executables")]
+        private InputList<string>? _executables;
+
+        /// <summary>
+        /// The programs that the install commands build, relative to the clone, e.g.
+        /// 				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+        /// 				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+        /// </summary>
+        public InputList<string> Executables
+        {
+            get => _executables ?? (_executables = new InputList<string>());
+            set => _executables = value;
+        }
+
+        /// <summary>
+        /// Make a partial clone that fetches files as they are needed, either blob:none to leave out
+        /// 				file contents or tree:0 to also leave out directories. This needs the git CLI
+        /// </summary>
+        [Input("filter")]
+        public Input<string>? Filter { get; set; }
+
+        /// <summary>
+        /// The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+        /// 				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+        /// 				otherwise $HOME/$REPO
+        /// </summary>
+        [Input("folderName")]
+        public Input<string>? FolderName { get; set; }
+
+        /// <summary>
+        /// Whether commands inherit the environment of the provider. If this is false then
+        /// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        /// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        /// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        /// 				directories. Defaults to true
+        /// </summary>
+        [Input("inheritEnvironment")]
+        public Input<bool>? InheritEnvironment { get; set; }
+
+        [Input("installCommands")]
+        private InputList<string>? _installCommands;
+
+        /// <summary>
+        /// The commands to run to install the program. Each command is run as a separate step
+        /// </summary>
+        public InputList<string> InstallCommands
+        {
+            get => _installCommands ?? (_installCommands = new InputList<string>());
+            set => _installCommands = value;
+        }
+
+        [Input("installSteps")]
+        private InputList<Inputs.StepArgs>? _installSteps;
+
+        /// <summary>
+        /// The steps to run to install the program. These are run after installCommands
+        /// </summary>
+        public InputList<Inputs.StepArgs> InstallSteps
+        {
+            get => _installSteps ?? (_installSteps = new InputList<Inputs.StepArgs>());
+            set => _installSteps = value;
+        }
+
+        /// <summary>
+        /// Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+        /// </summary>
+        [Input("lfs")]
+        public Input<bool>? Lfs { get; set; }
+
+        /// <summary>
+        /// What to do when an update or delete would lose changed files, unpushed commits or
+        /// 				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+        /// 				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+        /// 				Defaults to fail
+        /// </summary>
+        [Input("onLocalChanges")]
+        public Input<string>? OnLocalChanges { get; set; }
+
+        /// <summary>
+        /// The GitHub organization the repo belongs to. Not needed if url is set
+        /// </summary>
+        [Input("org")]
+        public Input<string>? Org { get; set; }
+
+        /// <summary>
+        /// How to create the outputs map from the install and update commands. The only supported
+        /// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        /// 				even if it sets continueOnError
+        /// </summary>
+        [Input("outputsFrom")]
+        public Input<string>? OutputsFrom { get; set; }
+
+        [Input("postInstall")]
+        private InputList<string>? _postInstall;
+
+        /// <summary>
+        /// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that were installed
+        /// </summary>
+        public InputList<string> PostInstall
+        {
+            get => _postInstall ?? (_postInstall = new InputList<string>());
+            set => _postInstall = value;
+        }
+
+        [Input("postUninstall")]
+        private InputList<string>? _postUninstall;
+
+        /// <summary>
+        /// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        /// 				to the location and version that were removed
+        /// </summary>
+        public InputList<string> PostUninstall
+        {
+            get => _postUninstall ?? (_postUninstall = new InputList<string>());
+            set => _postUninstall = value;
+        }
+
+        [Input("preInstall")]
+        private InputList<string>? _preInstall;
+
+        /// <summary>
+        /// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that will be installed
+        /// </summary>
+        public InputList<string> PreInstall
+        {
+            get => _preInstall ?? (_preInstall = new InputList<string>());
+            set => _preInstall = value;
+        }
+
+        [Input("preUninstall")]
+        private InputList<string>? _preUninstall;
+
+        /// <summary>
+        /// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        /// 				and PDE_VERSION are set to the location and version that are installed
+        /// </summary>
+        public InputList<string> PreUninstall
+        {
+            get => _preUninstall ?? (_preUninstall = new InputList<string>());
+            set => _preUninstall = value;
+        }
+
+        /// <summary>
+        /// The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+        /// 				develop or ~0.10. A constraint can use ~, ^ and ranges like &gt;=1.2.0 &lt;2.0.0 and resolves to
+        /// 				the highest tag that matches. Prerelease tags are never matched
+        /// </summary>
+        [Input("ref")]
+        public Input<string>? Ref { get; set; }
+
+        [Input("remotes")]
+        private InputMap<Inputs.GitRemoteArgs>? _remotes;
+
+        /// <summary>
+        /// More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+        /// 				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+        /// 				removed from the clone, remotes that were added by hand are left alone
+        /// </summary>
+        public InputMap<Inputs.GitRemoteArgs> Remotes
+        {
+            get => _remotes ?? (_remotes = new InputMap<Inputs.GitRemoteArgs>());
+            set => _remotes = value;
+        }
+
+        /// <summary>
+        /// The GitHub repository name. Not needed if url is set
+        /// </summary>
+        [Input("repo")]
+        public Input<string>? Repo { get; set; }
+
+        /// <summary>
+        /// Leave the clone on disk when the resource is deleted. The uninstall commands
+        /// 				are still run
+        /// </summary>
+        [Input("retainOnDelete")]
+        public Input<bool>? RetainOnDelete { get; set; }
+
+        /// <summary>
+        /// Whether to mark stdout, stderr and outputs as secret
+        /// </summary>
+        [Input("secretOutputs")]
+        public Input<bool>? SecretOutputs { get; set; }
+
+        [Input("sparsePaths")]
+        private InputList<string>? _sparsePaths;
+
+        /// <summary>
+        /// Only check out these directories of the repo, along with the files at its root.
+        /// 				This needs the git CLI
+        /// </summary>
+        public InputList<string> SparsePaths
+        {
+            get => _sparsePaths ?? (_sparsePaths = new InputList<string>());
+            set => _sparsePaths = value;
+        }
+
+        /// <summary>
+        /// The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+        /// 				is used. The host key of the remote must be in known_hosts
+        /// </summary>
+        [Input("sshKey")]
+        public Input<string>? SshKey { get; set; }
+
+        [Input("sshKeyPassword")]
+        private Input<string>? _sshKeyPassword;
+
+        /// <summary>
+        /// The password of sshKey if it is encrypted
+        /// </summary>
+        public Input<string>? SshKeyPassword
+        {
+            get => _sshKeyPassword;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _sshKeyPassword = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("stdin")]
+        private Input<string>? _stdin;
+
+        /// <summary>
+        /// Input to write to the stdin of the install, update and uninstall commands. This can be used
+        /// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+        /// </summary>
+        public Input<string>? Stdin
+        {
+            get => _stdin;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _stdin = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// How to check out submodules whenever the version changes. One of none, shallow to check
+        /// 				out only the commit each submodule points to, or recursive to also check out their submodules.
+        /// 				Defaults to none. This needs the git CLI
+        /// </summary>
+        [Input("submodules")]
+        public Input<string>? Submodules { get; set; }
+
+        /// <summary>
+        /// The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+        /// </summary>
+        [Input("tag")]
+        public Input<string>? Tag { get; set; }
+
+        /// <summary>
+        /// The remote whose branches and tags define version, either origin or one of remotes.
+        /// 				Defaults to origin
+        /// </summary>
+        [Input("trackRemote")]
+        public Input<string>? TrackRemote { get; set; }
+
+        [Input("uninstallCommands")]
+        private InputList<string>? _uninstallCommands;
+
+        /// <summary>
+        /// Optional Commands to run to uninstall the program
+        /// </summary>
+        public InputList<string> UninstallCommands
+        {
+            get => _uninstallCommands ?? (_uninstallCommands = new InputList<string>());
+            set => _uninstallCommands = value;
+        }
+
+        [Input("uninstallSteps")]
+        private InputList<Inputs.StepArgs>? _uninstallSteps;
+
+        /// <summary>
+        /// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        /// 				A failing step fails the delete unless it sets continueOnError
+        /// </summary>
+        public InputList<Inputs.StepArgs> UninstallSteps
+        {
+            get => _uninstallSteps ?? (_uninstallSteps = new InputList<Inputs.StepArgs>());
+            set => _uninstallSteps = value;
+        }
+
+        [Input("updateCommands")]
+        private InputList<string>? _updateCommands;
+
+        /// <summary>
+        /// Optional Commands to run to update the program
+        /// </summary>
+        public InputList<string> UpdateCommands
+        {
+            get => _updateCommands ?? (_updateCommands = new InputList<string>());
+            set => _updateCommands = value;
+        }
+
+        [Input("updateSteps")]
+        private InputList<Inputs.StepArgs>? _updateSteps;
+
+        /// <summary>
+        /// Optional steps to run to update the program. These are run after updateCommands
+        /// </summary>
+        public InputList<Inputs.StepArgs> UpdateSteps
+        {
+            get => _updateSteps ?? (_updateSteps = new InputList<Inputs.StepArgs>());
+            set => _updateSteps = value;
+        }
+
+        /// <summary>
+        /// The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+        /// 				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+        /// </summary>
+        [Input("url")]
+        public Input<string>? Url { get; set; }
+
+        /// <summary>
+        /// A command to check that the program works after it is installed or updated. If this
+        /// 				fails then a create is cleaned up and an update is rolled back
+        /// </summary>
+        [Input("verify")]
+        public Input<Inputs.VerifyArgs>? Verify { get; set; }
+
+        /// <summary>
+        /// The commit to check out. If this is not set then it is the commit that ref or the head of
+        /// 				branch resolves to
+        /// </summary>
+        [Input("version")]
+        public Input<string>? Version { get; set; }
+
+        public GitHubRepoArgs()
+        {
+        }
+        public static new GitHubRepoArgs Empty => new GitHubRepoArgs();
+    }
+}
@@ -0,0 +1,32 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers.Inputs
+{
+
+    public sealed class GitRemoteArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The URL to push to. Defaults to url
+        /// </summary>
+        [Input("pushUrl")]
+        public Input<string>? PushUrl { get; set; }
+
+        /// <summary>
+        /// The URL to fetch from
+        /// </summary>
+        [Input("url", required: true)]
+        public Input<string> Url { get; set; } = null!;
+
+        public GitRemoteArgs()
+        {
+        }
+        public static new GitRemoteArgs Empty => new GitRemoteArgs();
+    }
+}
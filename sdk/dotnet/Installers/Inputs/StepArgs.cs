@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers.Inputs
+{
+
+    public sealed class StepArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The command to run
+        /// </summary>
+        [Input("command", required: true)]
+        public Input<string> Command { get; set; } = null!;
+
+        /// <summary>
+        /// Whether to continue with the next step if this step fails
+        /// </summary>
+        [Input("continueOnError")]
+        public Input<bool>? ContinueOnError { get; set; }
+
+        /// <summary>
+        /// The directory to run the command in. Relative paths are relative to
+        /// 				the directory the resource runs its commands in
+        /// </summary>
+        [Input("dir")]
+        public Input<string>? Dir { get; set; }
+
+        /// <summary>
+        /// The name of the step. This is used in logs and errors. Defaults to the command
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        public StepArgs()
+        {
+        }
+        public static new StepArgs Empty => new StepArgs();
+    }
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers.Inputs
+{
+
+    public sealed class VerifyArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+        /// 				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+        /// </summary>
+        [Input("command", required: true)]
+        public Input<string> Command { get; set; } = null!;
+
+        /// <summary>
+        /// A regex that the output of the command must match. Both stdout and stderr are
+        /// 				matched since some programs print their version to stderr
+        /// </summary>
+        [Input("expectedOutput")]
+        public Input<string>? ExpectedOutput { get; set; }
+
+        public VerifyArgs()
+        {
+        }
+        public static new VerifyArgs Empty => new VerifyArgs();
+    }
+}
@@ -0,0 +1,414 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers
+{
+    /// <summary>
+    /// Install global npm packages.
+    /// 
+    /// This resource will create a local node project at the location you specify
+    /// and will then symlink the node_modules/.bin directory so that all the executables
+    /// are available globally.
+    /// </summary>
+    [PdeResourceType("pde:installers:Npm")]
+    public partial class Npm : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Whether to run the install, update and uninstall commands as root. If the become method
+        /// 				needs a password then it is read from the becomePassword provider config
+        /// </summary>
+        [Output("become")]
+        public Output<bool?> Become { get; private set; } = null!;
+
+        /// <summary>
+        /// The method to use to become root. Either sudo or doas. Defaults to sudo
+        /// </summary>
+        [Output("becomeMethod")]
+        public Output<string?> BecomeMethod { get; private set; } = null!;
+
+        /// <summary>
+        /// The environment variables to set when running the commands
+        /// </summary>
+        [Output("environment")]
+        public Output<ImmutableDictionary<string, string>?> Environment { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether commands inherit the environment of the provider. If this is false then
+        /// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        /// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        /// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        /// 				directories. Defaults to true
+        /// </summary>
+        [Output("inheritEnvironment")]
+        public Output<bool?> InheritEnvironment { get; private set; } = null!;
+
+        /// <summary>
+        /// The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+        /// </summary>
+        [Output("interpreter")]
+        public Output<ImmutableArray<string>> Interpreter { get; private set; } = null!;
+
+        /// <summary>
+        /// The location of the node project
+        /// </summary>
+        [Output("location")]
+        public Output<string> Location { get; private set; } = null!;
+
+        /// <summary>
+        /// The outputs parsed from the last install or update. See outputsFrom
+        /// </summary>
+        [Output("outputs")]
+        public Output<ImmutableDictionary<string, object>?> Outputs { get; private set; } = null!;
+
+        /// <summary>
+        /// How to create the outputs map from the install and update commands. The only supported
+        /// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        /// 				even if it sets continueOnError
+        /// </summary>
+        [Output("outputsFrom")]
+        public Output<string?> OutputsFrom { get; private set; } = null!;
+
+        /// <summary>
+        /// The npm package to install
+        /// </summary>
+        [Output("package")]
+        public Output<string> Package { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that were installed
+        /// </summary>
+        [Output("postInstall")]
+        public Output<ImmutableArray<string>> PostInstall { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        /// 				to the location and version that were removed
+        /// </summary>
+        [Output("postUninstall")]
+        public Output<ImmutableArray<string>> PostUninstall { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that will be installed
+        /// </summary>
+        [Output("preInstall")]
+        public Output<ImmutableArray<string>> PreInstall { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        /// 				and PDE_VERSION are set to the location and version that are installed
+        /// </summary>
+        [Output("preUninstall")]
+        public Output<ImmutableArray<string>> PreUninstall { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to mark stdout, stderr and outputs as secret
+        /// </summary>
+        [Output("secretOutputs")]
+        public Output<bool?> SecretOutputs { get; private set; } = null!;
+
+        /// <summary>
+        /// The stderr of the last install or update
+        /// </summary>
+        [Output("stderr")]
+        public Output<string?> Stderr { get; private set; } = null!;
+
+        /// <summary>
+        /// Input to write to the stdin of the install, update and uninstall commands. This can be used
+        /// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+        /// </summary>
+        [Output("stdin")]
+        public Output<string?> Stdin { get; private set; } = null!;
+
+        /// <summary>
+        /// The stdout of the last install or update
+        /// </summary>
+        [Output("stdout")]
+        public Output<string?> Stdout { get; private set; } = null!;
+
+        /// <summary>
+        /// The results of the steps that were run by the last install or update
+        /// </summary>
+        [Output("steps")]
+        public Output<ImmutableArray<Outputs.StepResult>> Steps { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional Commands to run to uninstall the program
+        /// </summary>
+        [Output("uninstallCommands")]
+        public Output<ImmutableArray<string>> UninstallCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        /// 				A failing step fails the delete unless it sets continueOnError
+        /// </summary>
+        [Output("uninstallSteps")]
+        public Output<ImmutableArray<Outputs.Step>> UninstallSteps { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional Commands to run to update the program
+        /// </summary>
+        [Output("updateCommands")]
+        public Output<ImmutableArray<string>> UpdateCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional steps to run to update the program. These are run after updateCommands
+        /// </summary>
+        [Output("updateSteps")]
+        public Output<ImmutableArray<Outputs.Step>> UpdateSteps { get; private set; } = null!;
+
+        /// <summary>
+        /// A command to check that the program works after it is installed or updated. If this
+        /// 				fails then a create is cleaned up and an update is rolled back
+        /// </summary>
+        [Output("verify")]
+        public Output<Outputs.Verify?> Verify { get; private set; } = null!;
+
+        /// <summary>
+        /// The version of the package to install
+        /// </summary>
+        [Output("version")]
+        public Output<string?> Version { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Npm resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Npm(string name, NpmArgs args, CustomResourceOptions? options = null)
+            : base("pde:installers:Npm", name, args ?? new NpmArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Npm(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("pde:installers:Npm", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "stdin",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Npm resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Npm Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Npm(name, id, options);
+        }
+    }
+
+    public sealed class NpmArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether to run the install, update and uninstall commands as root. If the become method
+        /// 				needs a password then it is read from the becomePassword provider config
+        /// </summary>
+        [Input("become")]
+        public Input<bool>? Become { get; set; }
+
+        /// <summary>
+        /// The method to use to become root. Either sudo or doas. Defaults to sudo
+        /// </summary>
+        [Input("becomeMethod")]
+        public Input<string>? BecomeMethod { get; set; }
+
+        /// <summary>
+        /// Whether commands inherit the environment of the provider. If this is false then
+        /// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        /// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        /// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        /// 				directories. Defaults to true
+        /// </summary>
+        [Input("inheritEnvironment")]
+        public Input<bool>? InheritEnvironment { get; set; }
+
+        /// <summary>
+        /// The location of the node project
+        /// </summary>
+        [Input("location", required: true)]
+        public Input<string> Location { get; set; } = null!;
+
+        /// <summary>
+        /// How to create the outputs map from the install and update commands. The only supported
+        /// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        /// 				even if it sets continueOnError
+        /// </summary>
+        [Input("outputsFrom")]
+        public Input<string>? OutputsFrom { get; set; }
+
+        /// <summary>
+        /// The npm package to install
+        /// </summary>
+        [Input("package", required: true)]
+        public Input<string> Package { get; set; } = null!;
+
+        [Input("postInstall")]
+        private InputList<string>? _postInstall;
+
+        /// <summary>
+        /// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that were installed
+        /// </summary>
+        public InputList<string> PostInstall
+        {
+            get => _postInstall ?? (_postInstall = new InputList<string>());
+            set => _postInstall = value;
+        }
+
+        [Input("postUninstall")]
+        private InputList<string>? _postUninstall;
+
+        /// <summary>
+        /// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        /// 				to the location and version that were removed
+        /// </summary>
+        public InputList<string> PostUninstall
+        {
+            get => _postUninstall ?? (_postUninstall = new InputList<string>());
+            set => _postUninstall = value;
+        }
+
+        [Input("preInstall")]
+        private InputList<string>? _preInstall;
+
+        /// <summary>
+        /// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that will be installed
+        /// </summary>
+        public InputList<string> PreInstall
+        {
+            get => _preInstall ?? (_preInstall = new InputList<string>());
+            set => _preInstall = value;
+        }
+
+        [Input("preUninstall")]
+        private InputList<string>? _preUninstall;
+
+        /// <summary>
+        /// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        /// 				and PDE_VERSION are set to the location and version that are installed
+        /// </summary>
+        public InputList<string> PreUninstall
+        {
+            get => _preUninstall ?? (_preUninstall = new InputList<string>());
+            set => _preUninstall = value;
+        }
+
+        /// <summary>
+        /// Whether to mark stdout, stderr and outputs as secret
+        /// </summary>
+        [Input("secretOutputs")]
+        public Input<bool>? SecretOutputs { get; set; }
+
+        [Input("stdin")]
+        private Input<string>? _stdin;
+
+        /// <summary>
+        /// Input to write to the stdin of the install, update and uninstall commands. This can be used
+        /// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+        /// </summary>
+        public Input<string>? Stdin
+        {
+            get => _stdin;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _stdin = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("uninstallCommands")]
+        private InputList<string>? _uninstallCommands;
+
+        /// <summary>
+        /// Optional Commands to run to uninstall the program
+        /// </summary>
+        public InputList<string> UninstallCommands
+        {
+            get => _uninstallCommands ?? (_uninstallCommands = new InputList<string>());
+            set => _uninstallCommands = value;
+        }
+
+        [Input("uninstallSteps")]
+        private InputList<Inputs.StepArgs>? _uninstallSteps;
+
+        /// <summary>
+        /// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        /// 				A failing step fails the delete unless it sets continueOnError
+        /// </summary>
+        public InputList<Inputs.StepArgs> UninstallSteps
+        {
+            get => _uninstallSteps ?? (_uninstallSteps = new InputList<Inputs.StepArgs>());
+            set => _uninstallSteps = value;
+        }
+
+        [Input("updateCommands")]
+        private InputList<string>? _updateCommands;
+
+        /// <summary>
+        /// Optional Commands to run to update the program
+        /// </summary>
+        public InputList<string> UpdateCommands
+        {
+            get => _updateCommands ?? (_updateCommands = new InputList<string>());
+            set => _updateCommands = value;
+        }
+
+        [Input("updateSteps")]
+        private InputList<Inputs.StepArgs>? _updateSteps;
+
+        /// <summary>
+        /// Optional steps to run to update the program. These are run after updateCommands
+        /// </summary>
+        public InputList<Inputs.StepArgs> UpdateSteps
+        {
+            get => _updateSteps ?? (_updateSteps = new InputList<Inputs.StepArgs>());
+            set => _updateSteps = value;
+        }
+
+        /// <summary>
+        /// A command to check that the program works after it is installed or updated. If this
+        /// 				fails then a create is cleaned up and an update is rolled back
+        /// </summary>
+        [Input("verify")]
+        public Input<Inputs.VerifyArgs>? Verify { get; set; }
+
+        /// <summary>
+        /// The version of the package to install
+        /// </summary>
+        [Input("version")]
+        public Input<string>? Version { get; set; }
+
+        public NpmArgs()
+        {
+        }
+        public static new NpmArgs Empty => new NpmArgs();
+    }
+}
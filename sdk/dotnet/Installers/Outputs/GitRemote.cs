@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers.Outputs
+{
+
+    [OutputType]
+    public sealed class GitRemote
+    {
+        /// <summary>
+        /// The URL to push to. Defaults to url
+        /// </summary>
+        public readonly string? PushUrl;
+        /// <summary>
+        /// The URL to fetch from
+        /// </summary>
+        public readonly string Url;
+
+        [OutputConstructor]
+        private GitRemote(
+            string? pushUrl,
+
+            string url)
+        {
+            PushUrl = pushUrl;
+            Url = url;
+        }
+    }
+}
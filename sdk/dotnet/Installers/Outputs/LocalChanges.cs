@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers.Outputs
+{
+
+    [OutputType]
+    public sealed class LocalChanges
+    {
+        /// <summary>
+        /// The tracked files that have uncommitted changes
+        /// </summary>
+        public readonly ImmutableArray<string> Files;
+        /// <summary>
+        /// The number of stash entries
+        /// </summary>
+        public readonly int Stashes;
+        /// <summary>
+        /// The commits on HEAD or a local branch that are not on any remote branch or tag,
+        /// 				as the short hash and subject
+        /// </summary>
+        public readonly ImmutableArray<string> UnpushedCommits;
+
+        [OutputConstructor]
+        private LocalChanges(
+            ImmutableArray<string> files,
+
+            int stashes,
+
+            ImmutableArray<string> unpushedCommits)
+        {
+            Files = files;
+            Stashes = stashes;
+            UnpushedCommits = unpushedCommits;
+        }
+    }
+}
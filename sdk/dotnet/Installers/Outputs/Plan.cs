@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers.Outputs
+{
+
+    [OutputType]
+    public sealed class Plan
+    {
+        /// <summary>
+        /// The release asset that is installed
+        /// </summary>
+        public readonly string? Asset;
+        /// <summary>
+        /// The commands that are run, in order
+        /// </summary>
+        public readonly ImmutableArray<string> Commands;
+        /// <summary>
+        /// The resolved URL the program is downloaded from
+        /// </summary>
+        public readonly string? DownloadURL;
+        /// <summary>
+        /// The files that are removed
+        /// </summary>
+        public readonly ImmutableArray<string> Removes;
+        /// <summary>
+        /// The files that are written
+        /// </summary>
+        public readonly ImmutableArray<string> Writes;
+
+        [OutputConstructor]
+        private Plan(
+            string? asset,
+
+            ImmutableArray<string> commands,
+
+            string? downloadURL,
+
+            ImmutableArray<string> removes,
+
+            ImmutableArray<string> writes)
+        {
+            Asset = asset;
+            Commands = commands;
+            DownloadURL = downloadURL;
+            Removes = removes;
+            Writes = writes;
+        }
+    }
+}
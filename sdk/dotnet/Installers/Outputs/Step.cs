@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers.Outputs
+{
+
+    [OutputType]
+    public sealed class Step
+    {
+        /// <summary>
+        /// The command to run
+        /// </summary>
+        public readonly string Command;
+        /// <summary>
+        /// Whether to continue with the next step if this step fails
+        /// </summary>
+        public readonly bool? ContinueOnError;
+        /// <summary>
+        /// The directory to run the command in. Relative paths are relative to
+        /// 				the directory the resource runs its commands in
+        /// </summary>
+        public readonly string? Dir;
+        /// <summary>
+        /// The name of the step. This is used in logs and errors. Defaults to the command
+        /// </summary>
+        public readonly string? Name;
+
+        [OutputConstructor]
+        private Step(
+            string command,
+
+            bool? continueOnError,
+
+            string? dir,
+
+            string? name)
+        {
+            Command = command;
+            ContinueOnError = continueOnError;
+            Dir = dir;
+            Name = name;
+        }
+    }
+}
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers.Outputs
+{
+
+    [OutputType]
+    public sealed class StepResult
+    {
+        /// <summary>
+        /// How long the step took to run
+        /// </summary>
+        public readonly string Duration;
+        /// <summary>
+        /// The exit code of the step. This is -1 if the step could not be started
+        /// </summary>
+        public readonly int ExitCode;
+        /// <summary>
+        /// The name of the step
+        /// </summary>
+        public readonly string Name;
+
+        [OutputConstructor]
+        private StepResult(
+            string duration,
+
+            int exitCode,
+
+            string name)
+        {
+            Duration = duration;
+            ExitCode = exitCode;
+            Name = name;
+        }
+    }
+}
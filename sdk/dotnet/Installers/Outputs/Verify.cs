@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers.Outputs
+{
+
+    [OutputType]
+    public sealed class Verify
+    {
+        /// <summary>
+        /// The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+        /// 				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+        /// </summary>
+        public readonly string Command;
+        /// <summary>
+        /// A regex that the output of the command must match. Both stdout and stderr are
+        /// 				matched since some programs print their version to stderr
+        /// </summary>
+        public readonly string? ExpectedOutput;
+
+        [OutputConstructor]
+        private Verify(
+            string command,
+
+            string? expectedOutput)
+        {
+            Command = command;
+            ExpectedOutput = expectedOutput;
+        }
+    }
+}
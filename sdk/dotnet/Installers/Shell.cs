@@ -0,0 +1,653 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Installers
+{
+    /// <summary>
+    /// Install something from a URL using shell commands
+    /// </summary>
+    [PdeResourceType("pde:installers:Shell")]
+    public partial class Shell : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Whether to run the install, update and uninstall commands as root. If the become method
+        /// 				needs a password then it is read from the becomePassword provider config
+        /// </summary>
+        [Output("become")]
+        public Output<bool?> Become { get; private set; } = null!;
+
+        /// <summary>
+        /// The method to use to become root. Either sudo or doas. Defaults to sudo
+        /// </summary>
+        [Output("becomeMethod")]
+        public Output<string?> BecomeMethod { get; private set; } = null!;
+
+        /// <summary>
+        /// The location to put the program. Defaults to $HOME/.local/bin
+        /// </summary>
+        [Output("binLocation")]
+        public Output<string?> BinLocation { get; private set; } = null!;
+
+        /// <summary>
+        /// The paths that were created by the install when trackChanges is set
+        /// </summary>
+        [Output("createdPaths")]
+        public Output<ImmutableArray<string>> CreatedPaths { get; private set; } = null!;
+
+        /// <summary>
+        /// The version of the program to install. This is available in downloadURL as
+        /// 				{{.Version}}. The version that versionCommand reports is in the version output
+        /// </summary>
+        [Output("desiredVersion")]
+        public Output<string?> DesiredVersion { get; private set; } = null!;
+
+        /// <summary>
+        /// The URL to download the program from. This is a go template which can use
+        /// 				{{.Version}}, {{.OS}}, {{.Arch}} and {{.ArchAlias "x86_64"}}. ArchAlias returns the host
+        /// 				architecture using the naming scheme where amd64 is called by the given name, e.g. x86_64/aarch64
+        /// </summary>
+        [Output("downloadURL")]
+        public Output<string> DownloadURL { get; private set; } = null!;
+
+        /// <summary>
+        /// The environment variables to set when running the commands
+        /// </summary>
+        [Output("environment")]
+        public Output<ImmutableDictionary<string, string>?> Environment { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the program that is download is an executable
+        /// </summary>
+        [Output("executable")]
+        public Output<bool?> Executable { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether commands inherit the environment of the provider. If this is false then
+        /// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        /// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        /// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        /// 				directories. Defaults to true
+        /// </summary>
+        [Output("inheritEnvironment")]
+        public Output<bool?> InheritEnvironment { get; private set; } = null!;
+
+        /// <summary>
+        /// The commands to run to install the program. Each command is run as a separate step
+        /// </summary>
+        [Output("installCommands")]
+        public Output<ImmutableArray<string>> InstallCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// The steps to run to install the program. These are run after installCommands
+        /// </summary>
+        [Output("installSteps")]
+        public Output<ImmutableArray<Outputs.Step>> InstallSteps { get; private set; } = null!;
+
+        /// <summary>
+        /// The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+        /// </summary>
+        [Output("interpreter")]
+        public Output<ImmutableArray<string>> Interpreter { get; private set; } = null!;
+
+        /// <summary>
+        /// The location the program was installed to
+        /// </summary>
+        [Output("location")]
+        public Output<string?> Location { get; private set; } = null!;
+
+        /// <summary>
+        /// The outputs parsed from the last install or update. See outputsFrom
+        /// </summary>
+        [Output("outputs")]
+        public Output<ImmutableDictionary<string, object>?> Outputs { get; private set; } = null!;
+
+        /// <summary>
+        /// How to create the outputs map from the install and update commands. The only supported
+        /// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        /// 				even if it sets continueOnError
+        /// </summary>
+        [Output("outputsFrom")]
+        public Output<string?> OutputsFrom { get; private set; } = null!;
+
+        /// <summary>
+        /// What the last create or update did. During preview this is what it will do
+        /// </summary>
+        [Output("plan")]
+        public Output<Outputs.Plan?> Plan { get; private set; } = null!;
+
+        /// <summary>
+        /// The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+        /// 				used for {{.OS}} and {{.Arch}} in downloadURL and an executable that is built for another
+        /// 				platform is refused before it is installed. Defaults to the platform of the host
+        /// </summary>
+        [Output("platform")]
+        public Output<string?> Platform { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that were installed
+        /// </summary>
+        [Output("postInstall")]
+        public Output<ImmutableArray<string>> PostInstall { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        /// 				to the location and version that were removed
+        /// </summary>
+        [Output("postUninstall")]
+        public Output<ImmutableArray<string>> PostUninstall { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that will be installed
+        /// </summary>
+        [Output("preInstall")]
+        public Output<ImmutableArray<string>> PreInstall { get; private set; } = null!;
+
+        /// <summary>
+        /// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        /// 				and PDE_VERSION are set to the location and version that are installed
+        /// </summary>
+        [Output("preUninstall")]
+        public Output<ImmutableArray<string>> PreUninstall { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the program. This is the name you would use to execute the program
+        /// </summary>
+        [Output("programName")]
+        public Output<string> ProgramName { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to retain the managed workspace when the resource is deleted
+        /// </summary>
+        [Output("retain")]
+        public Output<bool?> Retain { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to mark stdout, stderr and outputs as secret
+        /// </summary>
+        [Output("secretOutputs")]
+        public Output<bool?> SecretOutputs { get; private set; } = null!;
+
+        /// <summary>
+        /// The stderr of the last install or update
+        /// </summary>
+        [Output("stderr")]
+        public Output<string?> Stderr { get; private set; } = null!;
+
+        /// <summary>
+        /// Input to write to the stdin of the install, update and uninstall commands. This can be used
+        /// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+        /// </summary>
+        [Output("stdin")]
+        public Output<string?> Stdin { get; private set; } = null!;
+
+        /// <summary>
+        /// The stdout of the last install or update
+        /// </summary>
+        [Output("stdout")]
+        public Output<string?> Stdout { get; private set; } = null!;
+
+        /// <summary>
+        /// The results of the steps that were run by the last install or update
+        /// </summary>
+        [Output("steps")]
+        public Output<ImmutableArray<Outputs.StepResult>> Steps { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to record every path that the install creates in binLocation, $HOME/.local/share
+        /// 				and $HOME/.config so that they are all removed when the resource is deleted. This is useful for
+        /// 				installers that put files in more places than binLocation, e.g. completions or lib directories.
+        /// 				Nothing else runs commands while a tracked install runs
+        /// </summary>
+        [Output("trackChanges")]
+        public Output<bool?> TrackChanges { get; private set; } = null!;
+
+        /// <summary>
+        /// The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+        /// 				set, e.g. ~/.local/share/tool. binLocation is always watched
+        /// </summary>
+        [Output("trackPaths")]
+        public Output<ImmutableArray<string>> TrackPaths { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional Commands to run to uninstall the program
+        /// </summary>
+        [Output("uninstallCommands")]
+        public Output<ImmutableArray<string>> UninstallCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        /// 				A failing step fails the delete unless it sets continueOnError
+        /// </summary>
+        [Output("uninstallSteps")]
+        public Output<ImmutableArray<Outputs.Step>> UninstallSteps { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional Commands to run to update the program
+        /// </summary>
+        [Output("updateCommands")]
+        public Output<ImmutableArray<string>> UpdateCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional steps to run to update the program. These are run after updateCommands
+        /// </summary>
+        [Output("updateSteps")]
+        public Output<ImmutableArray<Outputs.Step>> UpdateSteps { get; private set; } = null!;
+
+        /// <summary>
+        /// A command to check that the program works after it is installed or updated. If this
+        /// 				fails then a create is cleaned up and an update is rolled back
+        /// </summary>
+        [Output("verify")]
+        public Output<Outputs.Verify?> Verify { get; private set; } = null!;
+
+        /// <summary>
+        /// The version of the program. This is the version reported by versionCommand, otherwise
+        /// 				the desiredVersion
+        /// </summary>
+        [Output("version")]
+        public Output<string?> Version { get; private set; } = null!;
+
+        /// <summary>
+        /// The command to run to get the version of the program. This is needed if you want to keep track of the version in state
+        /// </summary>
+        [Output("versionCommand")]
+        public Output<string?> VersionCommand { get; private set; } = null!;
+
+        /// <summary>
+        /// A regex used to extract the version from the output of versionCommand.
+        /// 				If the regex contains a capture group then the first group is used, otherwise the whole match is used
+        /// </summary>
+        [Output("versionRegex")]
+        public Output<string?> VersionRegex { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory to download the program to and run the commands in. This directory is never
+        /// 				removed by the resource. Defaults to a temporary directory
+        /// </summary>
+        [Output("workingDir")]
+        public Output<string?> WorkingDir { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to create a managed workspace at $HOME/.local/share/pde/shell/$NAME to download the
+        /// 				program to and run the commands in. The workspace is kept between create, update and delete so it can be
+        /// 				used by installers that build in place. Ignored if workingDir is set
+        /// </summary>
+        [Output("workspace")]
+        public Output<bool?> Workspace { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory the program was downloaded to and the commands were run in
+        /// </summary>
+        [Output("workspaceDir")]
+        public Output<string?> WorkspaceDir { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Shell resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Shell(string name, ShellArgs args, CustomResourceOptions? options = null)
+            : base("pde:installers:Shell", name, args ?? new ShellArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Shell(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("pde:installers:Shell", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "stdin",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Shell resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Shell Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Shell(name, id, options);
+        }
+    }
+
+    public sealed class ShellArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether to run the install, update and uninstall commands as root. If the become method
+        /// 				needs a password then it is read from the becomePassword provider config
+        /// </summary>
+        [Input("become")]
+        public Input<bool>? Become { get; set; }
+
+        /// <summary>
+        /// The method to use to become root. Either sudo or doas. Defaults to sudo
+        /// </summary>
+        [Input("becomeMethod")]
+        public Input<string>? BecomeMethod { get; set; }
+
+        /// <summary>
+        /// The location to put the program. Defaults to $HOME/.local/bin
+        /// </summary>
+        [Input("binLocation")]
+        public Input<string>? BinLocation { get; set; }
+
+        /// <summary>
+        /// The version of the program to install. This is available in downloadURL as
+        /// 				{{.Version}}. The version that versionCommand reports is in the version output
+        /// </summary>
+        [Input("desiredVersion")]
+        public Input<string>? DesiredVersion { get; set; }
+
+        /// <summary>
+        /// The URL to download the program from. This is a go template which can use
+        /// 				{{.Version}}, {{.OS}}, {{.Arch}} and {{.ArchAlias "x86_64"}}. ArchAlias returns the host
+        /// 				architecture using the naming scheme where amd64 is called by the given name, e.g. x86_64/aarch64
+        /// </summary>
+        [Input("downloadURL", required: true)]
+        public Input<string> DownloadURL { get; set; } = null!;
+
+        [Input("environment")]
+        private InputMap<string>? _environment;
+
+        /// <summary>
+        /// The environment variables to set when running the commands
+        /// </summary>
+        public InputMap<string> Environment
+        {
+            get => _environment ?? (_environment = new InputMap<string>());
+            set => _environment = value;
+        }
+
+        /// <summary>
+        /// Whether the program that is download is an executable
+        /// </summary>
+        [Input("executable")]
+        public Input<bool>? Executable { get; set; }
+
+        /// <summary>
+        /// Whether commands inherit the environment of the provider. If this is false then
+        /// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        /// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        /// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        /// 				directories. Defaults to true
+        /// </summary>
+        [Input("inheritEnvironment")]
+        public Input<bool>? InheritEnvironment { get; set; }
+
+        [Input("installCommands", required: true)]
+        private InputList<string>? _installCommands;
+
+        /// <summary>
+        /// The commands to run to install the program. Each command is run as a separate step
+        /// </summary>
+        public InputList<string> InstallCommands
+        {
+            get => _installCommands ?? (_installCommands = new InputList<string>());
+            set => _installCommands = value;
+        }
+
+        [Input("installSteps")]
+        private InputList<Inputs.StepArgs>? _installSteps;
+
+        /// <summary>
+        /// The steps to run to install the program. These are run after installCommands
+        /// </summary>
+        public InputList<Inputs.StepArgs> InstallSteps
+        {
+            get => _installSteps ?? (_installSteps = new InputList<Inputs.StepArgs>());
+            set => _installSteps = value;
+        }
+
+        [Input("interpreter")]
+        private InputList<string>? _interpreter;
+
+        /// <summary>
+        /// The interpreter to use to run the install commands. Defaults to ['/bin/sh', '-c']
+        /// </summary>
+        public InputList<string> Interpreter
+        {
+            get => _interpreter ?? (_interpreter = new InputList<string>());
+            set => _interpreter = value;
+        }
+
+        /// <summary>
+        /// How to create the outputs map from the install and update commands. The only supported
+        /// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        /// 				even if it sets continueOnError
+        /// </summary>
+        [Input("outputsFrom")]
+        public Input<string>? OutputsFrom { get; set; }
+
+        /// <summary>
+        /// The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+        /// 				used for {{.OS}} and {{.Arch}} in downloadURL and an executable that is built for another
+        /// 				platform is refused before it is installed. Defaults to the platform of the host
+        /// </summary>
+        [Input("platform")]
+        public Input<string>? Platform { get; set; }
+
+        [Input("postInstall")]
+        private InputList<string>? _postInstall;
+
+        /// <summary>
+        /// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that were installed
+        /// </summary>
+        public InputList<string> PostInstall
+        {
+            get => _postInstall ?? (_postInstall = new InputList<string>());
+            set => _postInstall = value;
+        }
+
+        [Input("postUninstall")]
+        private InputList<string>? _postUninstall;
+
+        /// <summary>
+        /// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        /// 				to the location and version that were removed
+        /// </summary>
+        public InputList<string> PostUninstall
+        {
+            get => _postUninstall ?? (_postUninstall = new InputList<string>());
+            set => _postUninstall = value;
+        }
+
+        [Input("preInstall")]
+        private InputList<string>? _preInstall;
+
+        /// <summary>
+        /// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        /// 				are set to the location and version that will be installed
+        /// </summary>
+        public InputList<string> PreInstall
+        {
+            get => _preInstall ?? (_preInstall = new InputList<string>());
+            set => _preInstall = value;
+        }
+
+        [Input("preUninstall")]
+        private InputList<string>? _preUninstall;
+
+        /// <summary>
+        /// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        /// 				and PDE_VERSION are set to the location and version that are installed
+        /// </summary>
+        public InputList<string> PreUninstall
+        {
+            get => _preUninstall ?? (_preUninstall = new InputList<string>());
+            set => _preUninstall = value;
+        }
+
+        /// <summary>
+        /// The name of the program. This is the name you would use to execute the program
+        /// </summary>
+        [Input("programName", required: true)]
+        public Input<string> ProgramName { get; set; } = null!;
+
+        /// <summary>
+        /// Whether to retain the managed workspace when the resource is deleted
+        /// </summary>
+        [Input("retain")]
+        public Input<bool>? Retain { get; set; }
+
+        /// <summary>
+        /// Whether to mark stdout, stderr and outputs as secret
+        /// </summary>
+        [Input("secretOutputs")]
+        public Input<bool>? SecretOutputs { get; set; }
+
+        [Input("stdin")]
+        private Input<string>? _stdin;
+
+        /// <summary>
+        /// Input to write to the stdin of the install, update and uninstall commands. This can be used
+        /// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+        /// </summary>
+        public Input<string>? Stdin
+        {
+            get => _stdin;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _stdin = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// Whether to record every path that the install creates in binLocation, $HOME/.local/share
+        /// 				and $HOME/.config so that they are all removed when the resource is deleted. This is useful for
+        /// 				installers that put files in more places than binLocation, e.g. completions or lib directories.
+        /// 				Nothing else runs commands while a tracked install runs
+        /// </summary>
+        [Input("trackChanges")]
+        public Input<bool>? TrackChanges { get; set; }
+
+        [Input("trackPaths")]
+        private InputList<string>? _trackPaths;
+
+        /// <summary>
+        /// The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+        /// 				set, e.g. ~/.local/share/tool. binLocation is always watched
+        /// </summary>
+        public InputList<string> TrackPaths
+        {
+            get => _trackPaths ?? (_trackPaths = new InputList<string>());
+            set => _trackPaths = value;
+        }
+
+        [Input("uninstallCommands")]
+        private InputList<string>? _uninstallCommands;
+
+        /// <summary>
+        /// Optional Commands to run to uninstall the program
+        /// </summary>
+        public InputList<string> UninstallCommands
+        {
+            get => _uninstallCommands ?? (_uninstallCommands = new InputList<string>());
+            set => _uninstallCommands = value;
+        }
+
+        [Input("uninstallSteps")]
+        private InputList<Inputs.StepArgs>? _uninstallSteps;
+
+        /// <summary>
+        /// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        /// 				A failing step fails the delete unless it sets continueOnError
+        /// </summary>
+        public InputList<Inputs.StepArgs> UninstallSteps
+        {
+            get => _uninstallSteps ?? (_uninstallSteps = new InputList<Inputs.StepArgs>());
+            set => _uninstallSteps = value;
+        }
+
+        [Input("updateCommands")]
+        private InputList<string>? _updateCommands;
+
+        /// <summary>
+        /// Optional Commands to run to update the program
+        /// </summary>
+        public InputList<string> UpdateCommands
+        {
+            get => _updateCommands ?? (_updateCommands = new InputList<string>());
+            set => _updateCommands = value;
+        }
+
+        [Input("updateSteps")]
+        private InputList<Inputs.StepArgs>? _updateSteps;
+
+        /// <summary>
+        /// Optional steps to run to update the program. These are run after updateCommands
+        /// </summary>
+        public InputList<Inputs.StepArgs> UpdateSteps
+        {
+            get => _updateSteps ?? (_updateSteps = new InputList<Inputs.StepArgs>());
+            set => _updateSteps = value;
+        }
+
+        /// <summary>
+        /// A command to check that the program works after it is installed or updated. If this
+        /// 				fails then a create is cleaned up and an update is rolled back
+        /// </summary>
+        [Input("verify")]
+        public Input<Inputs.VerifyArgs>? Verify { get; set; }
+
+        /// <summary>
+        /// The command to run to get the version of the program. This is needed if you want to keep track of the version in state
+        /// </summary>
+        [Input("versionCommand")]
+        public Input<string>? VersionCommand { get; set; }
+
+        /// <summary>
+        /// A regex used to extract the version from the output of versionCommand.
+        /// 				If the regex contains a capture group then the first group is used, otherwise the whole match is used
+        /// </summary>
+        [Input("versionRegex")]
+        public Input<string>? VersionRegex { get; set; }
+
+        /// <summary>
+        /// The directory to download the program to and run the commands in. This directory is never
+        /// 				removed by the resource. Defaults to a temporary directory
+        /// </summary>
+        [Input("workingDir")]
+        public Input<string>? WorkingDir { get; set; }
+
+        /// <summary>
+        /// Whether to create a managed workspace at $HOME/.local/share/pde/shell/$NAME to download the
+        /// 				program to and run the commands in. The workspace is kept between create, update and delete so it can be
+        /// 				used by installers that build in place. Ignored if workingDir is set
+        /// </summary>
+        [Input("workspace")]
+        public Input<bool>? Workspace { get; set; }
+
+        public ShellArgs()
+        {
+        }
+        public static new ShellArgs Empty => new ShellArgs();
+    }
+}
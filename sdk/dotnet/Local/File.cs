@@ -0,0 +1,110 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Local
+{
+    /// <summary>
+    /// A file projected into a pulumi resource
+    /// </summary>
+    [PdeResourceType("pde:local:File")]
+    public partial class File : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The content of the file.
+        /// </summary>
+        [Output("content")]
+        public Output<ImmutableArray<string>> Content { get; private set; } = null!;
+
+        /// <summary>
+        /// If an already existing file should be deleted if it exists.
+        /// </summary>
+        [Output("force")]
+        public Output<bool> Force { get; private set; } = null!;
+
+        /// <summary>
+        /// The path of the file.
+        /// </summary>
+        [Output("path")]
+        public Output<string> Path { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a File resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public File(string name, FileArgs args, CustomResourceOptions? options = null)
+            : base("pde:local:File", name, args ?? new FileArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private File(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("pde:local:File", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing File resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static File Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new File(name, id, options);
+        }
+    }
+
+    public sealed class FileArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("content", required: true)]
+        private InputList<string>? _content;
+
+        /// <summary>
+        /// The content of the file.
+        /// </summary>
+        public InputList<string> Content
+        {
+            get => _content ?? (_content = new InputList<string>());
+            set => _content = value;
+        }
+
+        /// <summary>
+        /// If an already existing file should be deleted if it exists.
+        /// </summary>
+        [Input("force")]
+        public Input<bool>? Force { get; set; }
+
+        /// <summary>
+        /// The path of the file. This defaults to the name of the pulumi resource.
+        /// </summary>
+        [Input("path", required: true)]
+        public Input<string> Path { get; set; } = null!;
+
+        public FileArgs()
+        {
+        }
+        public static new FileArgs Empty => new FileArgs();
+    }
+}
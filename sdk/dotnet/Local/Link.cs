@@ -0,0 +1,146 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde.Local
+{
+    /// <summary>
+    /// Create a symlink for a file or directory
+    /// </summary>
+    [PdeResourceType("pde:local:Link")]
+    public partial class Link : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Whether the source is a directory
+        /// </summary>
+        [Output("isDir")]
+        public Output<bool> IsDir { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the symlink has been created
+        /// </summary>
+        [Output("linked")]
+        public Output<bool> Linked { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to overwrite the target if it exists
+        /// </summary>
+        [Output("overwrite")]
+        public Output<bool?> Overwrite { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to recursively create links for directories
+        /// </summary>
+        [Output("recursive")]
+        public Output<bool?> Recursive { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to retain the link if the resource is deleted
+        /// </summary>
+        [Output("retain")]
+        public Output<bool?> Retain { get; private set; } = null!;
+
+        /// <summary>
+        /// The source file or directory to create a link to
+        /// </summary>
+        [Output("source")]
+        public Output<string> Source { get; private set; } = null!;
+
+        /// <summary>
+        /// The target file or directory to create a link at
+        /// </summary>
+        [Output("target")]
+        public Output<string> Target { get; private set; } = null!;
+
+        /// <summary>
+        /// The targets locations of the symlink
+        /// </summary>
+        [Output("targets")]
+        public Output<ImmutableArray<string>> Targets { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Link resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Link(string name, LinkArgs args, CustomResourceOptions? options = null)
+            : base("pde:local:Link", name, args ?? new LinkArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Link(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("pde:local:Link", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Link resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Link Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Link(name, id, options);
+        }
+    }
+
+    public sealed class LinkArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether to overwrite the target if it exists
+        /// </summary>
+        [Input("overwrite")]
+        public Input<bool>? Overwrite { get; set; }
+
+        /// <summary>
+        /// Whether to recursively create links for directories
+        /// </summary>
+        [Input("recursive")]
+        public Input<bool>? Recursive { get; set; }
+
+        /// <summary>
+        /// Whether to retain the link if the resource is deleted
+        /// </summary>
+        [Input("retain")]
+        public Input<bool>? Retain { get; set; }
+
+        /// <summary>
+        /// The source file or directory to create a link to
+        /// </summary>
+        [Input("source", required: true)]
+        public Input<string> Source { get; set; } = null!;
+
+        /// <summary>
+        /// The target file or directory to create a link at
+        /// </summary>
+        [Input("target", required: true)]
+        public Input<string> Target { get; set; } = null!;
+
+        public LinkArgs()
+        {
+        }
+        public static new LinkArgs Empty => new LinkArgs();
+    }
+}
@@ -0,0 +1,107 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Pde
+{
+    [PdeResourceType("pulumi:providers:pde")]
+    public partial class Provider : global::Pulumi.ProviderResource
+    {
+        /// <summary>
+        /// The password to use when running commands with become. This is
+        /// 				passed to the become method through an askpass helper and is never written to state or logs
+        /// </summary>
+        [Output("becomePassword")]
+        public Output<string?> BecomePassword { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+        /// 				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+        /// 				Defaults to cloning to $HOME/$REPO
+        /// </summary>
+        [Output("sourceRoot")]
+        public Output<string?> SourceRoot { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Provider resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Provider(string name, ProviderArgs? args = null, CustomResourceOptions? options = null)
+            : base("pde", name, args ?? new ProviderArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "becomePassword",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class ProviderArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("becomePassword")]
+        private Input<string>? _becomePassword;
+
+        /// <summary>
+        /// The password to use when running commands with become. This is
+        /// 				passed to the become method through an askpass helper and is never written to state or logs
+        /// </summary>
+        public Input<string>? BecomePassword
+        {
+            get => _becomePassword;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _becomePassword = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("binPaths", json: true)]
+        private InputList<string>? _binPaths;
+
+        /// <summary>
+        /// Directories to put on PATH for commands that don't inherit the environment. They come
+        /// 				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+        /// 				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+        /// 				resources it deletes, so this can be used to always find tools installed by other resources
+        /// </summary>
+        public InputList<string> BinPaths
+        {
+            get => _binPaths ?? (_binPaths = new InputList<string>());
+            set => _binPaths = value;
+        }
+
+        /// <summary>
+        /// The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+        /// 				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+        /// 				Defaults to cloning to $HOME/$REPO
+        /// </summary>
+        [Input("sourceRoot")]
+        public Input<string>? SourceRoot { get; set; }
+
+        public ProviderArgs()
+        {
+        }
+        public static new ProviderArgs Empty => new ProviderArgs();
+    }
+}
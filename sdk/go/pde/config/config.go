@@ -0,0 +1,36 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package config
+
+import (
+	"github.com/corymhall/pulumi-provider-pde/sdk/go/pde/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/config"
+)
+
+var _ = internal.GetEnvOrDefault
+
+// The password to use when running commands with become. This is
+//
+//	passed to the become method through an askpass helper and is never written to state or logs
+func GetBecomePassword(ctx *pulumi.Context) string {
+	return config.Get(ctx, "pde:becomePassword")
+}
+
+// Directories to put on PATH for commands that don't inherit the environment. They come
+//
+//	after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+//	provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+//	resources it deletes, so this can be used to always find tools installed by other resources
+func GetBinPaths(ctx *pulumi.Context) string {
+	return config.Get(ctx, "pde:binPaths")
+}
+
+// The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+//
+//	cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+//	Defaults to cloning to $HOME/$REPO
+func GetSourceRoot(ctx *pulumi.Context) string {
+	return config.Get(ctx, "pde:sourceRoot")
+}
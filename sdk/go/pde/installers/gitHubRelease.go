@@ -19,35 +19,100 @@ type GitHubRelease struct {
 	// The name of the release asset to install. If this is not provided then
 	// 				the resource will try and find the correct asset name to install. Supports regex
 	AssetName pulumi.StringPtrOutput `pulumi:"assetName"`
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become pulumi.BoolPtrOutput `pulumi:"become"`
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod pulumi.StringPtrOutput `pulumi:"becomeMethod"`
 	// Sometimes release assets contain a folder containing
 	// 				program binaries which can just be copied. If that is the case, then provide the
 	// 				location here. This will copy all files in the directory to the bin_location
 	BinFolder pulumi.StringPtrOutput `pulumi:"binFolder"`
 	// The location to put the program. Defaults to $HOME/.local/bin
 	BinLocation pulumi.StringPtrOutput `pulumi:"binLocation"`
+	// The paths that were created by the install when trackChanges is set
+	CreatedPaths pulumi.StringArrayOutput `pulumi:"createdPaths"`
 	// The URL of the GitHub release asset
 	DownloadURL pulumi.StringOutput `pulumi:"downloadURL"`
 	// The environment variables to set when running the commands
 	Environment pulumi.StringMapOutput `pulumi:"environment"`
 	// The name of the executable to create a symlink for. If not provided then the executable name will be the same as the repo name
 	Executable pulumi.StringPtrOutput `pulumi:"executable"`
-	// The commands to run to install the program
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment pulumi.BoolPtrOutput `pulumi:"inheritEnvironment"`
+	// The commands to run to install the program. Each command is run as a separate step
 	InstallCommands pulumi.StringArrayOutput `pulumi:"installCommands"`
+	// The steps to run to install the program. These are run after installCommands
+	InstallSteps StepArrayOutput `pulumi:"installSteps"`
 	// The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
 	Interpreter pulumi.StringArrayOutput `pulumi:"interpreter"`
 	// The locations the program was installed to
 	Locations pulumi.StringArrayOutput `pulumi:"locations"`
 	// The GitHub organization the repo belongs to
 	Org pulumi.StringOutput `pulumi:"org"`
+	// The outputs parsed from the last install or update. See outputsFrom
+	Outputs pulumi.MapOutput `pulumi:"outputs"`
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom pulumi.StringPtrOutput `pulumi:"outputsFrom"`
+	// What the last create or update did. During preview this is what it will do
+	Plan PlanPtrOutput `pulumi:"plan"`
+	// The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+	// 				used to find the release asset and executables that are built for another platform are
+	// 				refused. Defaults to the platform of the host
+	Platform pulumi.StringPtrOutput `pulumi:"platform"`
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall pulumi.StringArrayOutput `pulumi:"postInstall"`
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall pulumi.StringArrayOutput `pulumi:"postUninstall"`
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall pulumi.StringArrayOutput `pulumi:"preInstall"`
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall pulumi.StringArrayOutput `pulumi:"preUninstall"`
 	// The release version to install. If this is not provided then
 	// 				the resource will try and find the latest release version to install.
 	ReleaseVersion pulumi.StringPtrOutput `pulumi:"releaseVersion"`
 	// The GitHub repository name
 	Repo pulumi.StringOutput `pulumi:"repo"`
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs pulumi.BoolPtrOutput `pulumi:"secretOutputs"`
+	// The stderr of the last install or update
+	Stderr pulumi.StringPtrOutput `pulumi:"stderr"`
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin pulumi.StringPtrOutput `pulumi:"stdin"`
+	// The stdout of the last install or update
+	Stdout pulumi.StringPtrOutput `pulumi:"stdout"`
+	// The results of the steps that were run by the last install or update
+	Steps StepResultArrayOutput `pulumi:"steps"`
+	// Whether to record every path that the install creates in binLocation, $HOME/.local/share
+	// 				and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+	// 				commands while a tracked install runs
+	TrackChanges pulumi.BoolPtrOutput `pulumi:"trackChanges"`
+	// The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+	// 				set, e.g. ~/.local/share/tool. binLocation is always watched
+	TrackPaths pulumi.StringArrayOutput `pulumi:"trackPaths"`
 	// Optional Commands to run to uninstall the program
 	UninstallCommands pulumi.StringArrayOutput `pulumi:"uninstallCommands"`
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps StepArrayOutput `pulumi:"uninstallSteps"`
 	// Optional Commands to run to update the program
 	UpdateCommands pulumi.StringArrayOutput `pulumi:"updateCommands"`
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps StepArrayOutput `pulumi:"updateSteps"`
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify VerifyPtrOutput `pulumi:"verify"`
 }
 
 // NewGitHubRelease registers a new resource with the given unique name, arguments, and options.
@@ -63,6 +128,13 @@ func NewGitHubRelease(ctx *pulumi.Context,
 	if args.Repo == nil {
 		return nil, errors.New("invalid value for required argument 'Repo'")
 	}
+	if args.Stdin != nil {
+		args.Stdin = pulumi.ToSecret(args.Stdin).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"stdin",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource GitHubRelease
 	err := ctx.RegisterResource("pde:installers:GitHubRelease", name, args, &resource, opts...)
@@ -99,6 +171,11 @@ type gitHubReleaseArgs struct {
 	// The name of the release asset to install. If this is not provided then
 	// 				the resource will try and find the correct asset name to install. Supports regex
 	AssetName *string `pulumi:"assetName"`
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become *bool `pulumi:"become"`
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod *string `pulumi:"becomeMethod"`
 	// Sometimes release assets contain a folder containing
 	// 				program binaries which can just be copied. If that is the case, then provide the
 	// 				location here. This will copy all files in the directory to the bin_location
@@ -107,19 +184,67 @@ type gitHubReleaseArgs struct {
 	BinLocation *string `pulumi:"binLocation"`
 	// The name of the executable to create a symlink for. If not provided then the executable name will be the same as the repo name
 	Executable *string `pulumi:"executable"`
-	// The commands to run to install the program
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment *bool `pulumi:"inheritEnvironment"`
+	// The commands to run to install the program. Each command is run as a separate step
 	InstallCommands []string `pulumi:"installCommands"`
+	// The steps to run to install the program. These are run after installCommands
+	InstallSteps []Step `pulumi:"installSteps"`
 	// The GitHub organization the repo belongs to
 	Org string `pulumi:"org"`
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom *string `pulumi:"outputsFrom"`
+	// The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+	// 				used to find the release asset and executables that are built for another platform are
+	// 				refused. Defaults to the platform of the host
+	Platform *string `pulumi:"platform"`
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall []string `pulumi:"postInstall"`
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall []string `pulumi:"postUninstall"`
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall []string `pulumi:"preInstall"`
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall []string `pulumi:"preUninstall"`
 	// The release version to install. If this is not provided then
 	// 				the resource will try and find the latest release version to install.
 	ReleaseVersion *string `pulumi:"releaseVersion"`
 	// The GitHub repository name
 	Repo string `pulumi:"repo"`
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs *bool `pulumi:"secretOutputs"`
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin *string `pulumi:"stdin"`
+	// Whether to record every path that the install creates in binLocation, $HOME/.local/share
+	// 				and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+	// 				commands while a tracked install runs
+	TrackChanges *bool `pulumi:"trackChanges"`
+	// The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+	// 				set, e.g. ~/.local/share/tool. binLocation is always watched
+	TrackPaths []string `pulumi:"trackPaths"`
 	// Optional Commands to run to uninstall the program
 	UninstallCommands []string `pulumi:"uninstallCommands"`
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps []Step `pulumi:"uninstallSteps"`
 	// Optional Commands to run to update the program
 	UpdateCommands []string `pulumi:"updateCommands"`
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps []Step `pulumi:"updateSteps"`
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify *Verify `pulumi:"verify"`
 }
 
 // The set of arguments for constructing a GitHubRelease resource.
@@ -127,6 +252,11 @@ type GitHubReleaseArgs struct {
 	// The name of the release asset to install. If this is not provided then
 	// 				the resource will try and find the correct asset name to install. Supports regex
 	AssetName pulumi.StringPtrInput
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become pulumi.BoolPtrInput
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod pulumi.StringPtrInput
 	// Sometimes release assets contain a folder containing
 	// 				program binaries which can just be copied. If that is the case, then provide the
 	// 				location here. This will copy all files in the directory to the bin_location
@@ -135,19 +265,67 @@ type GitHubReleaseArgs struct {
 	BinLocation pulumi.StringPtrInput
 	// The name of the executable to create a symlink for. If not provided then the executable name will be the same as the repo name
 	Executable pulumi.StringPtrInput
-	// The commands to run to install the program
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment pulumi.BoolPtrInput
+	// The commands to run to install the program. Each command is run as a separate step
 	InstallCommands pulumi.StringArrayInput
+	// The steps to run to install the program. These are run after installCommands
+	InstallSteps StepArrayInput
 	// The GitHub organization the repo belongs to
 	Org pulumi.StringInput
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom pulumi.StringPtrInput
+	// The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+	// 				used to find the release asset and executables that are built for another platform are
+	// 				refused. Defaults to the platform of the host
+	Platform pulumi.StringPtrInput
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall pulumi.StringArrayInput
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall pulumi.StringArrayInput
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall pulumi.StringArrayInput
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall pulumi.StringArrayInput
 	// The release version to install. If this is not provided then
 	// 				the resource will try and find the latest release version to install.
 	ReleaseVersion pulumi.StringPtrInput
 	// The GitHub repository name
 	Repo pulumi.StringInput
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs pulumi.BoolPtrInput
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin pulumi.StringPtrInput
+	// Whether to record every path that the install creates in binLocation, $HOME/.local/share
+	// 				and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+	// 				commands while a tracked install runs
+	TrackChanges pulumi.BoolPtrInput
+	// The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+	// 				set, e.g. ~/.local/share/tool. binLocation is always watched
+	TrackPaths pulumi.StringArrayInput
 	// Optional Commands to run to uninstall the program
 	UninstallCommands pulumi.StringArrayInput
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps StepArrayInput
 	// Optional Commands to run to update the program
 	UpdateCommands pulumi.StringArrayInput
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps StepArrayInput
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify VerifyPtrInput
 }
 
 func (GitHubReleaseArgs) ElementType() reflect.Type {
@@ -244,6 +422,18 @@ func (o GitHubReleaseOutput) AssetName() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *GitHubRelease) pulumi.StringPtrOutput { return v.AssetName }).(pulumi.StringPtrOutput)
 }
 
+// Whether to run the install, update and uninstall commands as root. If the become method
+//
+//	needs a password then it is read from the becomePassword provider config
+func (o GitHubReleaseOutput) Become() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.BoolPtrOutput { return v.Become }).(pulumi.BoolPtrOutput)
+}
+
+// The method to use to become root. Either sudo or doas. Defaults to sudo
+func (o GitHubReleaseOutput) BecomeMethod() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringPtrOutput { return v.BecomeMethod }).(pulumi.StringPtrOutput)
+}
+
 // Sometimes release assets contain a folder containing
 //
 //	program binaries which can just be copied. If that is the case, then provide the
@@ -257,6 +447,11 @@ func (o GitHubReleaseOutput) BinLocation() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *GitHubRelease) pulumi.StringPtrOutput { return v.BinLocation }).(pulumi.StringPtrOutput)
 }
 
+// The paths that were created by the install when trackChanges is set
+func (o GitHubReleaseOutput) CreatedPaths() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringArrayOutput { return v.CreatedPaths }).(pulumi.StringArrayOutput)
+}
+
 // The URL of the GitHub release asset
 func (o GitHubReleaseOutput) DownloadURL() pulumi.StringOutput {
 	return o.ApplyT(func(v *GitHubRelease) pulumi.StringOutput { return v.DownloadURL }).(pulumi.StringOutput)
@@ -272,11 +467,26 @@ func (o GitHubReleaseOutput) Executable() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *GitHubRelease) pulumi.StringPtrOutput { return v.Executable }).(pulumi.StringPtrOutput)
 }
 
-// The commands to run to install the program
+// Whether commands inherit the environment of the provider. If this is false then
+//
+//	only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+//	the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+//	in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+//	directories. Defaults to true
+func (o GitHubReleaseOutput) InheritEnvironment() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.BoolPtrOutput { return v.InheritEnvironment }).(pulumi.BoolPtrOutput)
+}
+
+// The commands to run to install the program. Each command is run as a separate step
 func (o GitHubReleaseOutput) InstallCommands() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *GitHubRelease) pulumi.StringArrayOutput { return v.InstallCommands }).(pulumi.StringArrayOutput)
 }
 
+// The steps to run to install the program. These are run after installCommands
+func (o GitHubReleaseOutput) InstallSteps() StepArrayOutput {
+	return o.ApplyT(func(v *GitHubRelease) StepArrayOutput { return v.InstallSteps }).(StepArrayOutput)
+}
+
 // The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
 func (o GitHubReleaseOutput) Interpreter() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *GitHubRelease) pulumi.StringArrayOutput { return v.Interpreter }).(pulumi.StringArrayOutput)
@@ -292,6 +502,60 @@ func (o GitHubReleaseOutput) Org() pulumi.StringOutput {
 	return o.ApplyT(func(v *GitHubRelease) pulumi.StringOutput { return v.Org }).(pulumi.StringOutput)
 }
 
+// The outputs parsed from the last install or update. See outputsFrom
+func (o GitHubReleaseOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.MapOutput { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// How to create the outputs map from the install and update commands. The only supported
+//
+//	value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+//	even if it sets continueOnError
+func (o GitHubReleaseOutput) OutputsFrom() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringPtrOutput { return v.OutputsFrom }).(pulumi.StringPtrOutput)
+}
+
+// What the last create or update did. During preview this is what it will do
+func (o GitHubReleaseOutput) Plan() PlanPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) PlanPtrOutput { return v.Plan }).(PlanPtrOutput)
+}
+
+// The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+//
+//	used to find the release asset and executables that are built for another platform are
+//	refused. Defaults to the platform of the host
+func (o GitHubReleaseOutput) Platform() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringPtrOutput { return v.Platform }).(pulumi.StringPtrOutput)
+}
+
+// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+//
+//	are set to the location and version that were installed
+func (o GitHubReleaseOutput) PostInstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringArrayOutput { return v.PostInstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+//
+//	to the location and version that were removed
+func (o GitHubReleaseOutput) PostUninstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringArrayOutput { return v.PostUninstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+//
+//	are set to the location and version that will be installed
+func (o GitHubReleaseOutput) PreInstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringArrayOutput { return v.PreInstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+//
+//	and PDE_VERSION are set to the location and version that are installed
+func (o GitHubReleaseOutput) PreUninstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringArrayOutput { return v.PreUninstall }).(pulumi.StringArrayOutput)
+}
+
 // The release version to install. If this is not provided then
 //
 //	the resource will try and find the latest release version to install.
@@ -304,16 +568,77 @@ func (o GitHubReleaseOutput) Repo() pulumi.StringOutput {
 	return o.ApplyT(func(v *GitHubRelease) pulumi.StringOutput { return v.Repo }).(pulumi.StringOutput)
 }
 
+// Whether to mark stdout, stderr and outputs as secret
+func (o GitHubReleaseOutput) SecretOutputs() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.BoolPtrOutput { return v.SecretOutputs }).(pulumi.BoolPtrOutput)
+}
+
+// The stderr of the last install or update
+func (o GitHubReleaseOutput) Stderr() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringPtrOutput { return v.Stderr }).(pulumi.StringPtrOutput)
+}
+
+// Input to write to the stdin of the install, update and uninstall commands. This can be used
+//
+//	to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+func (o GitHubReleaseOutput) Stdin() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringPtrOutput { return v.Stdin }).(pulumi.StringPtrOutput)
+}
+
+// The stdout of the last install or update
+func (o GitHubReleaseOutput) Stdout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringPtrOutput { return v.Stdout }).(pulumi.StringPtrOutput)
+}
+
+// The results of the steps that were run by the last install or update
+func (o GitHubReleaseOutput) Steps() StepResultArrayOutput {
+	return o.ApplyT(func(v *GitHubRelease) StepResultArrayOutput { return v.Steps }).(StepResultArrayOutput)
+}
+
+// Whether to record every path that the install creates in binLocation, $HOME/.local/share
+//
+//	and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+//	commands while a tracked install runs
+func (o GitHubReleaseOutput) TrackChanges() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.BoolPtrOutput { return v.TrackChanges }).(pulumi.BoolPtrOutput)
+}
+
+// The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+//
+//	set, e.g. ~/.local/share/tool. binLocation is always watched
+func (o GitHubReleaseOutput) TrackPaths() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRelease) pulumi.StringArrayOutput { return v.TrackPaths }).(pulumi.StringArrayOutput)
+}
+
 // Optional Commands to run to uninstall the program
 func (o GitHubReleaseOutput) UninstallCommands() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *GitHubRelease) pulumi.StringArrayOutput { return v.UninstallCommands }).(pulumi.StringArrayOutput)
 }
 
+// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+//
+//	A failing step fails the delete unless it sets continueOnError
+func (o GitHubReleaseOutput) UninstallSteps() StepArrayOutput {
+	return o.ApplyT(func(v *GitHubRelease) StepArrayOutput { return v.UninstallSteps }).(StepArrayOutput)
+}
+
 // Optional Commands to run to update the program
 func (o GitHubReleaseOutput) UpdateCommands() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *GitHubRelease) pulumi.StringArrayOutput { return v.UpdateCommands }).(pulumi.StringArrayOutput)
 }
 
+// Optional steps to run to update the program. These are run after updateCommands
+func (o GitHubReleaseOutput) UpdateSteps() StepArrayOutput {
+	return o.ApplyT(func(v *GitHubRelease) StepArrayOutput { return v.UpdateSteps }).(StepArrayOutput)
+}
+
+// A command to check that the program works after it is installed or updated. If this
+//
+//	fails then a create is cleaned up and an update is rolled back
+func (o GitHubReleaseOutput) Verify() VerifyPtrOutput {
+	return o.ApplyT(func(v *GitHubRelease) VerifyPtrOutput { return v.Verify }).(VerifyPtrOutput)
+}
+
 type GitHubReleaseArrayOutput struct{ *pulumi.OutputState }
 
 func (GitHubReleaseArrayOutput) ElementType() reflect.Type {
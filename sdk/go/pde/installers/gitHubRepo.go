@@ -7,7 +7,6 @@ import (
 	"context"
 	"reflect"
 
-	"errors"
 	"github.com/corymhall/pulumi-provider-pde/sdk/go/pde/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -17,41 +16,154 @@ type GitHubRepo struct {
 	pulumi.CustomResourceState
 
 	// The absolute path to the folder the repo was cloned to
-	AbsFolderName pulumi.StringOutput `pulumi:"absFolderName"`
+	AbsFolderName pulumi.StringPtrOutput `pulumi:"absFolderName"`
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become pulumi.BoolPtrOutput `pulumi:"become"`
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod pulumi.StringPtrOutput `pulumi:"becomeMethod"`
+	// The location to link executables into. Defaults to $HOME/.local/bin
+	BinLocation pulumi.StringPtrOutput `pulumi:"binLocation"`
 	// The branch to clone from. Default to main
 	Branch pulumi.StringPtrOutput `pulumi:"branch"`
+	// Only fetch this many commits of history. Updates fetch more history if the new version
+	// 				is not in it
+	Depth pulumi.IntPtrOutput `pulumi:"depth"`
 	// The environment variables to set when running the commands
 	Environment pulumi.StringMapOutput `pulumi:"environment"`
-	// The folder to clone the repo to. By default this is will be $HOME/$REPO_NAME
+	// The programs that the install commands build, relative to the clone, e.g.
+	// 				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+	// 				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+	Executables pulumi.StringArrayOutput `pulumi:"executables"`
+	// Make a partial clone that fetches files as they are needed, either blob:none to leave out
+	// 				file contents or tree:0 to also leave out directories. This needs the git CLI
+	Filter pulumi.StringPtrOutput `pulumi:"filter"`
+	// The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+	// 				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+	// 				otherwise $HOME/$REPO
 	FolderName pulumi.StringPtrOutput `pulumi:"folderName"`
-	// The commands to run to install the program
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment pulumi.BoolPtrOutput `pulumi:"inheritEnvironment"`
+	// The commands to run to install the program. Each command is run as a separate step
 	InstallCommands pulumi.StringArrayOutput `pulumi:"installCommands"`
+	// The steps to run to install the program. These are run after installCommands
+	InstallSteps StepArrayOutput `pulumi:"installSteps"`
 	// The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
 	Interpreter pulumi.StringArrayOutput `pulumi:"interpreter"`
-	// The GitHub organization the repo belongs to
-	Org pulumi.StringOutput `pulumi:"org"`
-	// The GitHub repository name
-	Repo pulumi.StringOutput `pulumi:"repo"`
+	// Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+	Lfs pulumi.BoolPtrOutput `pulumi:"lfs"`
+	// The work in the clone that is not on the remote, as of the last refresh
+	LocalChanges LocalChangesPtrOutput `pulumi:"localChanges"`
+	// The links to executables that were created in binLocation
+	Locations pulumi.StringArrayOutput `pulumi:"locations"`
+	// What to do when an update or delete would lose changed files, unpushed commits or
+	// 				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+	// 				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+	// 				Defaults to fail
+	OnLocalChanges pulumi.StringPtrOutput `pulumi:"onLocalChanges"`
+	// The GitHub organization the repo belongs to. Not needed if url is set
+	Org pulumi.StringPtrOutput `pulumi:"org"`
+	// The outputs parsed from the last install or update. See outputsFrom
+	Outputs pulumi.MapOutput `pulumi:"outputs"`
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom pulumi.StringPtrOutput `pulumi:"outputsFrom"`
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall pulumi.StringArrayOutput `pulumi:"postInstall"`
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall pulumi.StringArrayOutput `pulumi:"postUninstall"`
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall pulumi.StringArrayOutput `pulumi:"preInstall"`
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall pulumi.StringArrayOutput `pulumi:"preUninstall"`
+	// The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+	// 				develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+	// 				the highest tag that matches. Prerelease tags are never matched
+	Ref pulumi.StringPtrOutput `pulumi:"ref"`
+	// More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+	// 				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+	// 				removed from the clone, remotes that were added by hand are left alone
+	Remotes GitRemoteMapOutput `pulumi:"remotes"`
+	// The GitHub repository name. Not needed if url is set
+	Repo pulumi.StringPtrOutput `pulumi:"repo"`
+	// Leave the clone on disk when the resource is deleted. The uninstall commands
+	// 				are still run
+	RetainOnDelete pulumi.BoolPtrOutput `pulumi:"retainOnDelete"`
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs pulumi.BoolPtrOutput `pulumi:"secretOutputs"`
+	// Only check out these directories of the repo, along with the files at its root.
+	// 				This needs the git CLI
+	SparsePaths pulumi.StringArrayOutput `pulumi:"sparsePaths"`
+	// The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+	// 				is used. The host key of the remote must be in known_hosts
+	SshKey pulumi.StringPtrOutput `pulumi:"sshKey"`
+	// The password of sshKey if it is encrypted
+	SshKeyPassword pulumi.StringPtrOutput `pulumi:"sshKeyPassword"`
+	// The stderr of the last install or update
+	Stderr pulumi.StringPtrOutput `pulumi:"stderr"`
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin pulumi.StringPtrOutput `pulumi:"stdin"`
+	// The stdout of the last install or update
+	Stdout pulumi.StringPtrOutput `pulumi:"stdout"`
+	// The results of the steps that were run by the last install or update
+	Steps StepResultArrayOutput `pulumi:"steps"`
+	// How to check out submodules whenever the version changes. One of none, shallow to check
+	// 				out only the commit each submodule points to, or recursive to also check out their submodules.
+	// 				Defaults to none. This needs the git CLI
+	Submodules pulumi.StringPtrOutput `pulumi:"submodules"`
+	// The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+	Tag pulumi.StringPtrOutput `pulumi:"tag"`
+	// The remote whose branches and tags define version, either origin or one of remotes.
+	// 				Defaults to origin
+	TrackRemote pulumi.StringPtrOutput `pulumi:"trackRemote"`
 	// Optional Commands to run to uninstall the program
 	UninstallCommands pulumi.StringArrayOutput `pulumi:"uninstallCommands"`
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps StepArrayOutput `pulumi:"uninstallSteps"`
 	// Optional Commands to run to update the program
 	UpdateCommands pulumi.StringArrayOutput `pulumi:"updateCommands"`
-	Version        pulumi.StringPtrOutput   `pulumi:"version"`
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps StepArrayOutput `pulumi:"updateSteps"`
+	// The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+	// 				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+	Url pulumi.StringPtrOutput `pulumi:"url"`
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify VerifyPtrOutput `pulumi:"verify"`
+	// The commit to check out. If this is not set then it is the commit that ref or the head of
+	// 				branch resolves to
+	Version pulumi.StringPtrOutput `pulumi:"version"`
 }
 
 // NewGitHubRepo registers a new resource with the given unique name, arguments, and options.
 func NewGitHubRepo(ctx *pulumi.Context,
 	name string, args *GitHubRepoArgs, opts ...pulumi.ResourceOption) (*GitHubRepo, error) {
 	if args == nil {
-		return nil, errors.New("missing one or more required arguments")
+		args = &GitHubRepoArgs{}
 	}
 
-	if args.Org == nil {
-		return nil, errors.New("invalid value for required argument 'Org'")
+	if args.SshKeyPassword != nil {
+		args.SshKeyPassword = pulumi.ToSecret(args.SshKeyPassword).(pulumi.StringPtrInput)
 	}
-	if args.Repo == nil {
-		return nil, errors.New("invalid value for required argument 'Repo'")
+	if args.Stdin != nil {
+		args.Stdin = pulumi.ToSecret(args.Stdin).(pulumi.StringPtrInput)
 	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"sshKeyPassword",
+		"stdin",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource GitHubRepo
 	err := ctx.RegisterResource("pde:installers:GitHubRepo", name, args, &resource, opts...)
@@ -85,40 +197,232 @@ func (GitHubRepoState) ElementType() reflect.Type {
 }
 
 type gitHubRepoArgs struct {
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become *bool `pulumi:"become"`
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod *string `pulumi:"becomeMethod"`
+	// The location to link executables into. Defaults to $HOME/.local/bin
+	BinLocation *string `pulumi:"binLocation"`
 	// The branch to clone from. Default to main
 	Branch *string `pulumi:"branch"`
-	// The folder to clone the repo to. By default this is will be $HOME/$REPO_NAME
+	// Only fetch this many commits of history. Updates fetch more history if the new version
+	// 				is not in it
+	Depth *int `pulumi:"depth"`
+	// The programs that the install commands build, relative to the clone, e.g.
+	// 				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+	// 				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+	Executables []string `pulumi:"executables"`
+	// Make a partial clone that fetches files as they are needed, either blob:none to leave out
+	// 				file contents or tree:0 to also leave out directories. This needs the git CLI
+	Filter *string `pulumi:"filter"`
+	// The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+	// 				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+	// 				otherwise $HOME/$REPO
 	FolderName *string `pulumi:"folderName"`
-	// The commands to run to install the program
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment *bool `pulumi:"inheritEnvironment"`
+	// The commands to run to install the program. Each command is run as a separate step
 	InstallCommands []string `pulumi:"installCommands"`
-	// The GitHub organization the repo belongs to
-	Org string `pulumi:"org"`
-	// The GitHub repository name
-	Repo string `pulumi:"repo"`
+	// The steps to run to install the program. These are run after installCommands
+	InstallSteps []Step `pulumi:"installSteps"`
+	// Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+	Lfs *bool `pulumi:"lfs"`
+	// What to do when an update or delete would lose changed files, unpushed commits or
+	// 				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+	// 				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+	// 				Defaults to fail
+	OnLocalChanges *string `pulumi:"onLocalChanges"`
+	// The GitHub organization the repo belongs to. Not needed if url is set
+	Org *string `pulumi:"org"`
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom *string `pulumi:"outputsFrom"`
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall []string `pulumi:"postInstall"`
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall []string `pulumi:"postUninstall"`
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall []string `pulumi:"preInstall"`
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall []string `pulumi:"preUninstall"`
+	// The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+	// 				develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+	// 				the highest tag that matches. Prerelease tags are never matched
+	Ref *string `pulumi:"ref"`
+	// More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+	// 				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+	// 				removed from the clone, remotes that were added by hand are left alone
+	Remotes map[string]GitRemote `pulumi:"remotes"`
+	// The GitHub repository name. Not needed if url is set
+	Repo *string `pulumi:"repo"`
+	// Leave the clone on disk when the resource is deleted. The uninstall commands
+	// 				are still run
+	RetainOnDelete *bool `pulumi:"retainOnDelete"`
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs *bool `pulumi:"secretOutputs"`
+	// Only check out these directories of the repo, along with the files at its root.
+	// 				This needs the git CLI
+	SparsePaths []string `pulumi:"sparsePaths"`
+	// The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+	// 				is used. The host key of the remote must be in known_hosts
+	SshKey *string `pulumi:"sshKey"`
+	// The password of sshKey if it is encrypted
+	SshKeyPassword *string `pulumi:"sshKeyPassword"`
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin *string `pulumi:"stdin"`
+	// How to check out submodules whenever the version changes. One of none, shallow to check
+	// 				out only the commit each submodule points to, or recursive to also check out their submodules.
+	// 				Defaults to none. This needs the git CLI
+	Submodules *string `pulumi:"submodules"`
+	// The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+	Tag *string `pulumi:"tag"`
+	// The remote whose branches and tags define version, either origin or one of remotes.
+	// 				Defaults to origin
+	TrackRemote *string `pulumi:"trackRemote"`
 	// Optional Commands to run to uninstall the program
 	UninstallCommands []string `pulumi:"uninstallCommands"`
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps []Step `pulumi:"uninstallSteps"`
 	// Optional Commands to run to update the program
 	UpdateCommands []string `pulumi:"updateCommands"`
-	Version        *string  `pulumi:"version"`
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps []Step `pulumi:"updateSteps"`
+	// The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+	// 				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+	Url *string `pulumi:"url"`
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify *Verify `pulumi:"verify"`
+	// The commit to check out. If this is not set then it is the commit that ref or the head of
+	// 				branch resolves to
+	Version *string `pulumi:"version"`
 }
 
 // The set of arguments for constructing a GitHubRepo resource.
 type GitHubRepoArgs struct {
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become pulumi.BoolPtrInput
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod pulumi.StringPtrInput
+	// The location to link executables into. Defaults to $HOME/.local/bin
+	BinLocation pulumi.StringPtrInput
 	// The branch to clone from. Default to main
 	Branch pulumi.StringPtrInput
-	// The folder to clone the repo to. By default this is will be $HOME/$REPO_NAME
+	// Only fetch this many commits of history. Updates fetch more history if the new version
+	// 				is not in it
+	Depth pulumi.IntPtrInput
+	// The programs that the install commands build, relative to the clone, e.g.
+	// 				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+	// 				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+	Executables pulumi.StringArrayInput
+	// Make a partial clone that fetches files as they are needed, either blob:none to leave out
+	// 				file contents or tree:0 to also leave out directories. This needs the git CLI
+	Filter pulumi.StringPtrInput
+	// The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+	// 				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+	// 				otherwise $HOME/$REPO
 	FolderName pulumi.StringPtrInput
-	// The commands to run to install the program
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment pulumi.BoolPtrInput
+	// The commands to run to install the program. Each command is run as a separate step
 	InstallCommands pulumi.StringArrayInput
-	// The GitHub organization the repo belongs to
-	Org pulumi.StringInput
-	// The GitHub repository name
-	Repo pulumi.StringInput
+	// The steps to run to install the program. These are run after installCommands
+	InstallSteps StepArrayInput
+	// Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+	Lfs pulumi.BoolPtrInput
+	// What to do when an update or delete would lose changed files, unpushed commits or
+	// 				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+	// 				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+	// 				Defaults to fail
+	OnLocalChanges pulumi.StringPtrInput
+	// The GitHub organization the repo belongs to. Not needed if url is set
+	Org pulumi.StringPtrInput
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom pulumi.StringPtrInput
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall pulumi.StringArrayInput
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall pulumi.StringArrayInput
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall pulumi.StringArrayInput
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall pulumi.StringArrayInput
+	// The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+	// 				develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+	// 				the highest tag that matches. Prerelease tags are never matched
+	Ref pulumi.StringPtrInput
+	// More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+	// 				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+	// 				removed from the clone, remotes that were added by hand are left alone
+	Remotes GitRemoteMapInput
+	// The GitHub repository name. Not needed if url is set
+	Repo pulumi.StringPtrInput
+	// Leave the clone on disk when the resource is deleted. The uninstall commands
+	// 				are still run
+	RetainOnDelete pulumi.BoolPtrInput
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs pulumi.BoolPtrInput
+	// Only check out these directories of the repo, along with the files at its root.
+	// 				This needs the git CLI
+	SparsePaths pulumi.StringArrayInput
+	// The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+	// 				is used. The host key of the remote must be in known_hosts
+	SshKey pulumi.StringPtrInput
+	// The password of sshKey if it is encrypted
+	SshKeyPassword pulumi.StringPtrInput
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin pulumi.StringPtrInput
+	// How to check out submodules whenever the version changes. One of none, shallow to check
+	// 				out only the commit each submodule points to, or recursive to also check out their submodules.
+	// 				Defaults to none. This needs the git CLI
+	Submodules pulumi.StringPtrInput
+	// The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+	Tag pulumi.StringPtrInput
+	// The remote whose branches and tags define version, either origin or one of remotes.
+	// 				Defaults to origin
+	TrackRemote pulumi.StringPtrInput
 	// Optional Commands to run to uninstall the program
 	UninstallCommands pulumi.StringArrayInput
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps StepArrayInput
 	// Optional Commands to run to update the program
 	UpdateCommands pulumi.StringArrayInput
-	Version        pulumi.StringPtrInput
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps StepArrayInput
+	// The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+	// 				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+	Url pulumi.StringPtrInput
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify VerifyPtrInput
+	// The commit to check out. If this is not set then it is the commit that ref or the head of
+	// 				branch resolves to
+	Version pulumi.StringPtrInput
 }
 
 func (GitHubRepoArgs) ElementType() reflect.Type {
@@ -209,8 +513,25 @@ func (o GitHubRepoOutput) ToGitHubRepoOutputWithContext(ctx context.Context) Git
 }
 
 // The absolute path to the folder the repo was cloned to
-func (o GitHubRepoOutput) AbsFolderName() pulumi.StringOutput {
-	return o.ApplyT(func(v *GitHubRepo) pulumi.StringOutput { return v.AbsFolderName }).(pulumi.StringOutput)
+func (o GitHubRepoOutput) AbsFolderName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.AbsFolderName }).(pulumi.StringPtrOutput)
+}
+
+// Whether to run the install, update and uninstall commands as root. If the become method
+//
+//	needs a password then it is read from the becomePassword provider config
+func (o GitHubRepoOutput) Become() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.BoolPtrOutput { return v.Become }).(pulumi.BoolPtrOutput)
+}
+
+// The method to use to become root. Either sudo or doas. Defaults to sudo
+func (o GitHubRepoOutput) BecomeMethod() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.BecomeMethod }).(pulumi.StringPtrOutput)
+}
+
+// The location to link executables into. Defaults to $HOME/.local/bin
+func (o GitHubRepoOutput) BinLocation() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.BinLocation }).(pulumi.StringPtrOutput)
 }
 
 // The branch to clone from. Default to main
@@ -218,34 +539,228 @@ func (o GitHubRepoOutput) Branch() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Branch }).(pulumi.StringPtrOutput)
 }
 
+// Only fetch this many commits of history. Updates fetch more history if the new version
+//
+//	is not in it
+func (o GitHubRepoOutput) Depth() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.IntPtrOutput { return v.Depth }).(pulumi.IntPtrOutput)
+}
+
 // The environment variables to set when running the commands
 func (o GitHubRepoOutput) Environment() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *GitHubRepo) pulumi.StringMapOutput { return v.Environment }).(pulumi.StringMapOutput)
 }
 
-// The folder to clone the repo to. By default this is will be $HOME/$REPO_NAME
+// The programs that the install commands build, relative to the clone, e.g.
+//
+//	target/release/foo. They are linked into binLocation after each install and update. Binaries that
+//	can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+func (o GitHubRepoOutput) Executables() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.Executables }).(pulumi.StringArrayOutput)
+}
+
+// Make a partial clone that fetches files as they are needed, either blob:none to leave out
+//
+//	file contents or tree:0 to also leave out directories. This needs the git CLI
+func (o GitHubRepoOutput) Filter() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Filter }).(pulumi.StringPtrOutput)
+}
+
+// The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+//
+//	are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+//	otherwise $HOME/$REPO
 func (o GitHubRepoOutput) FolderName() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.FolderName }).(pulumi.StringPtrOutput)
 }
 
-// The commands to run to install the program
+// Whether commands inherit the environment of the provider. If this is false then
+//
+//	only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+//	the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+//	in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+//	directories. Defaults to true
+func (o GitHubRepoOutput) InheritEnvironment() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.BoolPtrOutput { return v.InheritEnvironment }).(pulumi.BoolPtrOutput)
+}
+
+// The commands to run to install the program. Each command is run as a separate step
 func (o GitHubRepoOutput) InstallCommands() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.InstallCommands }).(pulumi.StringArrayOutput)
 }
 
+// The steps to run to install the program. These are run after installCommands
+func (o GitHubRepoOutput) InstallSteps() StepArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) StepArrayOutput { return v.InstallSteps }).(StepArrayOutput)
+}
+
 // The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
 func (o GitHubRepoOutput) Interpreter() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.Interpreter }).(pulumi.StringArrayOutput)
 }
 
-// The GitHub organization the repo belongs to
-func (o GitHubRepoOutput) Org() pulumi.StringOutput {
-	return o.ApplyT(func(v *GitHubRepo) pulumi.StringOutput { return v.Org }).(pulumi.StringOutput)
+// Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+func (o GitHubRepoOutput) Lfs() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.BoolPtrOutput { return v.Lfs }).(pulumi.BoolPtrOutput)
+}
+
+// The work in the clone that is not on the remote, as of the last refresh
+func (o GitHubRepoOutput) LocalChanges() LocalChangesPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) LocalChangesPtrOutput { return v.LocalChanges }).(LocalChangesPtrOutput)
+}
+
+// The links to executables that were created in binLocation
+func (o GitHubRepoOutput) Locations() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.Locations }).(pulumi.StringArrayOutput)
+}
+
+// What to do when an update or delete would lose changed files, unpushed commits or
+//
+//	stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+//	branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+//	Defaults to fail
+func (o GitHubRepoOutput) OnLocalChanges() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.OnLocalChanges }).(pulumi.StringPtrOutput)
+}
+
+// The GitHub organization the repo belongs to. Not needed if url is set
+func (o GitHubRepoOutput) Org() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Org }).(pulumi.StringPtrOutput)
+}
+
+// The outputs parsed from the last install or update. See outputsFrom
+func (o GitHubRepoOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.MapOutput { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// How to create the outputs map from the install and update commands. The only supported
+//
+//	value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+//	even if it sets continueOnError
+func (o GitHubRepoOutput) OutputsFrom() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.OutputsFrom }).(pulumi.StringPtrOutput)
+}
+
+// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+//
+//	are set to the location and version that were installed
+func (o GitHubRepoOutput) PostInstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.PostInstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+//
+//	to the location and version that were removed
+func (o GitHubRepoOutput) PostUninstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.PostUninstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+//
+//	are set to the location and version that will be installed
+func (o GitHubRepoOutput) PreInstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.PreInstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+//
+//	and PDE_VERSION are set to the location and version that are installed
+func (o GitHubRepoOutput) PreUninstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.PreUninstall }).(pulumi.StringArrayOutput)
+}
+
+// The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+//
+//	develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+//	the highest tag that matches. Prerelease tags are never matched
+func (o GitHubRepoOutput) Ref() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Ref }).(pulumi.StringPtrOutput)
+}
+
+// More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+//
+//	url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+//	removed from the clone, remotes that were added by hand are left alone
+func (o GitHubRepoOutput) Remotes() GitRemoteMapOutput {
+	return o.ApplyT(func(v *GitHubRepo) GitRemoteMapOutput { return v.Remotes }).(GitRemoteMapOutput)
+}
+
+// The GitHub repository name. Not needed if url is set
+func (o GitHubRepoOutput) Repo() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Repo }).(pulumi.StringPtrOutput)
+}
+
+// Leave the clone on disk when the resource is deleted. The uninstall commands
+//
+//	are still run
+func (o GitHubRepoOutput) RetainOnDelete() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.BoolPtrOutput { return v.RetainOnDelete }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to mark stdout, stderr and outputs as secret
+func (o GitHubRepoOutput) SecretOutputs() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.BoolPtrOutput { return v.SecretOutputs }).(pulumi.BoolPtrOutput)
+}
+
+// Only check out these directories of the repo, along with the files at its root.
+//
+//	This needs the git CLI
+func (o GitHubRepoOutput) SparsePaths() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.SparsePaths }).(pulumi.StringArrayOutput)
+}
+
+// The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+//
+//	is used. The host key of the remote must be in known_hosts
+func (o GitHubRepoOutput) SshKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.SshKey }).(pulumi.StringPtrOutput)
+}
+
+// The password of sshKey if it is encrypted
+func (o GitHubRepoOutput) SshKeyPassword() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.SshKeyPassword }).(pulumi.StringPtrOutput)
+}
+
+// The stderr of the last install or update
+func (o GitHubRepoOutput) Stderr() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Stderr }).(pulumi.StringPtrOutput)
+}
+
+// Input to write to the stdin of the install, update and uninstall commands. This can be used
+//
+//	to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+func (o GitHubRepoOutput) Stdin() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Stdin }).(pulumi.StringPtrOutput)
+}
+
+// The stdout of the last install or update
+func (o GitHubRepoOutput) Stdout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Stdout }).(pulumi.StringPtrOutput)
+}
+
+// The results of the steps that were run by the last install or update
+func (o GitHubRepoOutput) Steps() StepResultArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) StepResultArrayOutput { return v.Steps }).(StepResultArrayOutput)
 }
 
-// The GitHub repository name
-func (o GitHubRepoOutput) Repo() pulumi.StringOutput {
-	return o.ApplyT(func(v *GitHubRepo) pulumi.StringOutput { return v.Repo }).(pulumi.StringOutput)
+// How to check out submodules whenever the version changes. One of none, shallow to check
+//
+//	out only the commit each submodule points to, or recursive to also check out their submodules.
+//	Defaults to none. This needs the git CLI
+func (o GitHubRepoOutput) Submodules() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Submodules }).(pulumi.StringPtrOutput)
+}
+
+// The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+func (o GitHubRepoOutput) Tag() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Tag }).(pulumi.StringPtrOutput)
+}
+
+// The remote whose branches and tags define version, either origin or one of remotes.
+//
+//	Defaults to origin
+func (o GitHubRepoOutput) TrackRemote() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.TrackRemote }).(pulumi.StringPtrOutput)
 }
 
 // Optional Commands to run to uninstall the program
@@ -253,11 +768,40 @@ func (o GitHubRepoOutput) UninstallCommands() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.UninstallCommands }).(pulumi.StringArrayOutput)
 }
 
+// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+//
+//	A failing step fails the delete unless it sets continueOnError
+func (o GitHubRepoOutput) UninstallSteps() StepArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) StepArrayOutput { return v.UninstallSteps }).(StepArrayOutput)
+}
+
 // Optional Commands to run to update the program
 func (o GitHubRepoOutput) UpdateCommands() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *GitHubRepo) pulumi.StringArrayOutput { return v.UpdateCommands }).(pulumi.StringArrayOutput)
 }
 
+// Optional steps to run to update the program. These are run after updateCommands
+func (o GitHubRepoOutput) UpdateSteps() StepArrayOutput {
+	return o.ApplyT(func(v *GitHubRepo) StepArrayOutput { return v.UpdateSteps }).(StepArrayOutput)
+}
+
+// The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+//
+//	org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+func (o GitHubRepoOutput) Url() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Url }).(pulumi.StringPtrOutput)
+}
+
+// A command to check that the program works after it is installed or updated. If this
+//
+//	fails then a create is cleaned up and an update is rolled back
+func (o GitHubRepoOutput) Verify() VerifyPtrOutput {
+	return o.ApplyT(func(v *GitHubRepo) VerifyPtrOutput { return v.Verify }).(VerifyPtrOutput)
+}
+
+// The commit to check out. If this is not set then it is the commit that ref or the head of
+//
+//	branch resolves to
 func (o GitHubRepoOutput) Version() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *GitHubRepo) pulumi.StringPtrOutput { return v.Version }).(pulumi.StringPtrOutput)
 }
@@ -27,6 +27,8 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 		r = &GitHubRepo{}
 	case "pde:installers:Npm":
 		r = &Npm{}
+	case "pde:installers:Script":
+		r = &Script{}
 	case "pde:installers:Shell":
 		r = &Shell{}
 	default:
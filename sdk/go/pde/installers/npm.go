@@ -20,10 +20,66 @@ import (
 type Npm struct {
 	pulumi.CustomResourceState
 
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become pulumi.BoolPtrOutput `pulumi:"become"`
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod pulumi.StringPtrOutput `pulumi:"becomeMethod"`
+	// The environment variables to set when running the commands
+	Environment pulumi.StringMapOutput `pulumi:"environment"`
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment pulumi.BoolPtrOutput `pulumi:"inheritEnvironment"`
+	// The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+	Interpreter pulumi.StringArrayOutput `pulumi:"interpreter"`
 	// The location of the node project
 	Location pulumi.StringOutput `pulumi:"location"`
+	// The outputs parsed from the last install or update. See outputsFrom
+	Outputs pulumi.MapOutput `pulumi:"outputs"`
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom pulumi.StringPtrOutput `pulumi:"outputsFrom"`
 	// The npm package to install
 	Package pulumi.StringOutput `pulumi:"package"`
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall pulumi.StringArrayOutput `pulumi:"postInstall"`
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall pulumi.StringArrayOutput `pulumi:"postUninstall"`
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall pulumi.StringArrayOutput `pulumi:"preInstall"`
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall pulumi.StringArrayOutput `pulumi:"preUninstall"`
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs pulumi.BoolPtrOutput `pulumi:"secretOutputs"`
+	// The stderr of the last install or update
+	Stderr pulumi.StringPtrOutput `pulumi:"stderr"`
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin pulumi.StringPtrOutput `pulumi:"stdin"`
+	// The stdout of the last install or update
+	Stdout pulumi.StringPtrOutput `pulumi:"stdout"`
+	// The results of the steps that were run by the last install or update
+	Steps StepResultArrayOutput `pulumi:"steps"`
+	// Optional Commands to run to uninstall the program
+	UninstallCommands pulumi.StringArrayOutput `pulumi:"uninstallCommands"`
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps StepArrayOutput `pulumi:"uninstallSteps"`
+	// Optional Commands to run to update the program
+	UpdateCommands pulumi.StringArrayOutput `pulumi:"updateCommands"`
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps StepArrayOutput `pulumi:"updateSteps"`
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify VerifyPtrOutput `pulumi:"verify"`
 	// The version of the package to install
 	Version pulumi.StringPtrOutput `pulumi:"version"`
 }
@@ -41,6 +97,13 @@ func NewNpm(ctx *pulumi.Context,
 	if args.Package == nil {
 		return nil, errors.New("invalid value for required argument 'Package'")
 	}
+	if args.Stdin != nil {
+		args.Stdin = pulumi.ToSecret(args.Stdin).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"stdin",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Npm
 	err := ctx.RegisterResource("pde:installers:Npm", name, args, &resource, opts...)
@@ -74,20 +137,108 @@ func (NpmState) ElementType() reflect.Type {
 }
 
 type npmArgs struct {
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become *bool `pulumi:"become"`
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod *string `pulumi:"becomeMethod"`
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment *bool `pulumi:"inheritEnvironment"`
 	// The location of the node project
 	Location string `pulumi:"location"`
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom *string `pulumi:"outputsFrom"`
 	// The npm package to install
 	Package string `pulumi:"package"`
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall []string `pulumi:"postInstall"`
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall []string `pulumi:"postUninstall"`
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall []string `pulumi:"preInstall"`
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall []string `pulumi:"preUninstall"`
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs *bool `pulumi:"secretOutputs"`
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin *string `pulumi:"stdin"`
+	// Optional Commands to run to uninstall the program
+	UninstallCommands []string `pulumi:"uninstallCommands"`
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps []Step `pulumi:"uninstallSteps"`
+	// Optional Commands to run to update the program
+	UpdateCommands []string `pulumi:"updateCommands"`
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps []Step `pulumi:"updateSteps"`
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify *Verify `pulumi:"verify"`
 	// The version of the package to install
 	Version *string `pulumi:"version"`
 }
 
 // The set of arguments for constructing a Npm resource.
 type NpmArgs struct {
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become pulumi.BoolPtrInput
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod pulumi.StringPtrInput
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment pulumi.BoolPtrInput
 	// The location of the node project
 	Location pulumi.StringInput
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom pulumi.StringPtrInput
 	// The npm package to install
 	Package pulumi.StringInput
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall pulumi.StringArrayInput
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall pulumi.StringArrayInput
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall pulumi.StringArrayInput
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall pulumi.StringArrayInput
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs pulumi.BoolPtrInput
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin pulumi.StringPtrInput
+	// Optional Commands to run to uninstall the program
+	UninstallCommands pulumi.StringArrayInput
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps StepArrayInput
+	// Optional Commands to run to update the program
+	UpdateCommands pulumi.StringArrayInput
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps StepArrayInput
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify VerifyPtrInput
 	// The version of the package to install
 	Version pulumi.StringPtrInput
 }
@@ -179,16 +330,145 @@ func (o NpmOutput) ToNpmOutputWithContext(ctx context.Context) NpmOutput {
 	return o
 }
 
+// Whether to run the install, update and uninstall commands as root. If the become method
+//
+//	needs a password then it is read from the becomePassword provider config
+func (o NpmOutput) Become() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Npm) pulumi.BoolPtrOutput { return v.Become }).(pulumi.BoolPtrOutput)
+}
+
+// The method to use to become root. Either sudo or doas. Defaults to sudo
+func (o NpmOutput) BecomeMethod() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringPtrOutput { return v.BecomeMethod }).(pulumi.StringPtrOutput)
+}
+
+// The environment variables to set when running the commands
+func (o NpmOutput) Environment() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringMapOutput { return v.Environment }).(pulumi.StringMapOutput)
+}
+
+// Whether commands inherit the environment of the provider. If this is false then
+//
+//	only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+//	the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+//	in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+//	directories. Defaults to true
+func (o NpmOutput) InheritEnvironment() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Npm) pulumi.BoolPtrOutput { return v.InheritEnvironment }).(pulumi.BoolPtrOutput)
+}
+
+// The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+func (o NpmOutput) Interpreter() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringArrayOutput { return v.Interpreter }).(pulumi.StringArrayOutput)
+}
+
 // The location of the node project
 func (o NpmOutput) Location() pulumi.StringOutput {
 	return o.ApplyT(func(v *Npm) pulumi.StringOutput { return v.Location }).(pulumi.StringOutput)
 }
 
+// The outputs parsed from the last install or update. See outputsFrom
+func (o NpmOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v *Npm) pulumi.MapOutput { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// How to create the outputs map from the install and update commands. The only supported
+//
+//	value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+//	even if it sets continueOnError
+func (o NpmOutput) OutputsFrom() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringPtrOutput { return v.OutputsFrom }).(pulumi.StringPtrOutput)
+}
+
 // The npm package to install
 func (o NpmOutput) Package() pulumi.StringOutput {
 	return o.ApplyT(func(v *Npm) pulumi.StringOutput { return v.Package }).(pulumi.StringOutput)
 }
 
+// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+//
+//	are set to the location and version that were installed
+func (o NpmOutput) PostInstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringArrayOutput { return v.PostInstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+//
+//	to the location and version that were removed
+func (o NpmOutput) PostUninstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringArrayOutput { return v.PostUninstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+//
+//	are set to the location and version that will be installed
+func (o NpmOutput) PreInstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringArrayOutput { return v.PreInstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+//
+//	and PDE_VERSION are set to the location and version that are installed
+func (o NpmOutput) PreUninstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringArrayOutput { return v.PreUninstall }).(pulumi.StringArrayOutput)
+}
+
+// Whether to mark stdout, stderr and outputs as secret
+func (o NpmOutput) SecretOutputs() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Npm) pulumi.BoolPtrOutput { return v.SecretOutputs }).(pulumi.BoolPtrOutput)
+}
+
+// The stderr of the last install or update
+func (o NpmOutput) Stderr() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringPtrOutput { return v.Stderr }).(pulumi.StringPtrOutput)
+}
+
+// Input to write to the stdin of the install, update and uninstall commands. This can be used
+//
+//	to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+func (o NpmOutput) Stdin() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringPtrOutput { return v.Stdin }).(pulumi.StringPtrOutput)
+}
+
+// The stdout of the last install or update
+func (o NpmOutput) Stdout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringPtrOutput { return v.Stdout }).(pulumi.StringPtrOutput)
+}
+
+// The results of the steps that were run by the last install or update
+func (o NpmOutput) Steps() StepResultArrayOutput {
+	return o.ApplyT(func(v *Npm) StepResultArrayOutput { return v.Steps }).(StepResultArrayOutput)
+}
+
+// Optional Commands to run to uninstall the program
+func (o NpmOutput) UninstallCommands() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringArrayOutput { return v.UninstallCommands }).(pulumi.StringArrayOutput)
+}
+
+// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+//
+//	A failing step fails the delete unless it sets continueOnError
+func (o NpmOutput) UninstallSteps() StepArrayOutput {
+	return o.ApplyT(func(v *Npm) StepArrayOutput { return v.UninstallSteps }).(StepArrayOutput)
+}
+
+// Optional Commands to run to update the program
+func (o NpmOutput) UpdateCommands() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Npm) pulumi.StringArrayOutput { return v.UpdateCommands }).(pulumi.StringArrayOutput)
+}
+
+// Optional steps to run to update the program. These are run after updateCommands
+func (o NpmOutput) UpdateSteps() StepArrayOutput {
+	return o.ApplyT(func(v *Npm) StepArrayOutput { return v.UpdateSteps }).(StepArrayOutput)
+}
+
+// A command to check that the program works after it is installed or updated. If this
+//
+//	fails then a create is cleaned up and an update is rolled back
+func (o NpmOutput) Verify() VerifyPtrOutput {
+	return o.ApplyT(func(v *Npm) VerifyPtrOutput { return v.Verify }).(VerifyPtrOutput)
+}
+
 // The version of the package to install
 func (o NpmOutput) Version() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Npm) pulumi.StringPtrOutput { return v.Version }).(pulumi.StringPtrOutput)
@@ -0,0 +1,718 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package installers
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/corymhall/pulumi-provider-pde/sdk/go/pde/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+var _ = internal.GetEnvOrDefault
+
+type GitRemote struct {
+	// The URL to push to. Defaults to url
+	PushUrl *string `pulumi:"pushUrl"`
+	// The URL to fetch from
+	Url string `pulumi:"url"`
+}
+
+// GitRemoteInput is an input type that accepts GitRemoteArgs and GitRemoteOutput values.
+// You can construct a concrete instance of `GitRemoteInput` via:
+//
+//	GitRemoteArgs{...}
+type GitRemoteInput interface {
+	pulumi.Input
+
+	ToGitRemoteOutput() GitRemoteOutput
+	ToGitRemoteOutputWithContext(context.Context) GitRemoteOutput
+}
+
+type GitRemoteArgs struct {
+	// The URL to push to. Defaults to url
+	PushUrl pulumi.StringPtrInput `pulumi:"pushUrl"`
+	// The URL to fetch from
+	Url pulumi.StringInput `pulumi:"url"`
+}
+
+func (GitRemoteArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GitRemote)(nil)).Elem()
+}
+
+func (i GitRemoteArgs) ToGitRemoteOutput() GitRemoteOutput {
+	return i.ToGitRemoteOutputWithContext(context.Background())
+}
+
+func (i GitRemoteArgs) ToGitRemoteOutputWithContext(ctx context.Context) GitRemoteOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GitRemoteOutput)
+}
+
+// GitRemoteMapInput is an input type that accepts GitRemoteMap and GitRemoteMapOutput values.
+// You can construct a concrete instance of `GitRemoteMapInput` via:
+//
+//	GitRemoteMap{ "key": GitRemoteArgs{...} }
+type GitRemoteMapInput interface {
+	pulumi.Input
+
+	ToGitRemoteMapOutput() GitRemoteMapOutput
+	ToGitRemoteMapOutputWithContext(context.Context) GitRemoteMapOutput
+}
+
+type GitRemoteMap map[string]GitRemoteInput
+
+func (GitRemoteMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]GitRemote)(nil)).Elem()
+}
+
+func (i GitRemoteMap) ToGitRemoteMapOutput() GitRemoteMapOutput {
+	return i.ToGitRemoteMapOutputWithContext(context.Background())
+}
+
+func (i GitRemoteMap) ToGitRemoteMapOutputWithContext(ctx context.Context) GitRemoteMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GitRemoteMapOutput)
+}
+
+type GitRemoteOutput struct{ *pulumi.OutputState }
+
+func (GitRemoteOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GitRemote)(nil)).Elem()
+}
+
+func (o GitRemoteOutput) ToGitRemoteOutput() GitRemoteOutput {
+	return o
+}
+
+func (o GitRemoteOutput) ToGitRemoteOutputWithContext(ctx context.Context) GitRemoteOutput {
+	return o
+}
+
+// The URL to push to. Defaults to url
+func (o GitRemoteOutput) PushUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GitRemote) *string { return v.PushUrl }).(pulumi.StringPtrOutput)
+}
+
+// The URL to fetch from
+func (o GitRemoteOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v GitRemote) string { return v.Url }).(pulumi.StringOutput)
+}
+
+type GitRemoteMapOutput struct{ *pulumi.OutputState }
+
+func (GitRemoteMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]GitRemote)(nil)).Elem()
+}
+
+func (o GitRemoteMapOutput) ToGitRemoteMapOutput() GitRemoteMapOutput {
+	return o
+}
+
+func (o GitRemoteMapOutput) ToGitRemoteMapOutputWithContext(ctx context.Context) GitRemoteMapOutput {
+	return o
+}
+
+func (o GitRemoteMapOutput) MapIndex(k pulumi.StringInput) GitRemoteOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) GitRemote {
+		return vs[0].(map[string]GitRemote)[vs[1].(string)]
+	}).(GitRemoteOutput)
+}
+
+type LocalChanges struct {
+	// The tracked files that have uncommitted changes
+	Files []string `pulumi:"files"`
+	// The number of stash entries
+	Stashes int `pulumi:"stashes"`
+	// The commits on HEAD or a local branch that are not on any remote branch or tag,
+	// 				as the short hash and subject
+	UnpushedCommits []string `pulumi:"unpushedCommits"`
+}
+
+type LocalChangesOutput struct{ *pulumi.OutputState }
+
+func (LocalChangesOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LocalChanges)(nil)).Elem()
+}
+
+func (o LocalChangesOutput) ToLocalChangesOutput() LocalChangesOutput {
+	return o
+}
+
+func (o LocalChangesOutput) ToLocalChangesOutputWithContext(ctx context.Context) LocalChangesOutput {
+	return o
+}
+
+// The tracked files that have uncommitted changes
+func (o LocalChangesOutput) Files() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v LocalChanges) []string { return v.Files }).(pulumi.StringArrayOutput)
+}
+
+// The number of stash entries
+func (o LocalChangesOutput) Stashes() pulumi.IntOutput {
+	return o.ApplyT(func(v LocalChanges) int { return v.Stashes }).(pulumi.IntOutput)
+}
+
+// The commits on HEAD or a local branch that are not on any remote branch or tag,
+//
+//	as the short hash and subject
+func (o LocalChangesOutput) UnpushedCommits() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v LocalChanges) []string { return v.UnpushedCommits }).(pulumi.StringArrayOutput)
+}
+
+type LocalChangesPtrOutput struct{ *pulumi.OutputState }
+
+func (LocalChangesPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**LocalChanges)(nil)).Elem()
+}
+
+func (o LocalChangesPtrOutput) ToLocalChangesPtrOutput() LocalChangesPtrOutput {
+	return o
+}
+
+func (o LocalChangesPtrOutput) ToLocalChangesPtrOutputWithContext(ctx context.Context) LocalChangesPtrOutput {
+	return o
+}
+
+func (o LocalChangesPtrOutput) Elem() LocalChangesOutput {
+	return o.ApplyT(func(v *LocalChanges) LocalChanges {
+		if v != nil {
+			return *v
+		}
+		var ret LocalChanges
+		return ret
+	}).(LocalChangesOutput)
+}
+
+// The tracked files that have uncommitted changes
+func (o LocalChangesPtrOutput) Files() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *LocalChanges) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Files
+	}).(pulumi.StringArrayOutput)
+}
+
+// The number of stash entries
+func (o LocalChangesPtrOutput) Stashes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *LocalChanges) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.Stashes
+	}).(pulumi.IntPtrOutput)
+}
+
+// The commits on HEAD or a local branch that are not on any remote branch or tag,
+//
+//	as the short hash and subject
+func (o LocalChangesPtrOutput) UnpushedCommits() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *LocalChanges) []string {
+		if v == nil {
+			return nil
+		}
+		return v.UnpushedCommits
+	}).(pulumi.StringArrayOutput)
+}
+
+type Plan struct {
+	// The release asset that is installed
+	Asset *string `pulumi:"asset"`
+	// The commands that are run, in order
+	Commands []string `pulumi:"commands"`
+	// The resolved URL the program is downloaded from
+	DownloadURL *string `pulumi:"downloadURL"`
+	// The files that are removed
+	Removes []string `pulumi:"removes"`
+	// The files that are written
+	Writes []string `pulumi:"writes"`
+}
+
+type PlanOutput struct{ *pulumi.OutputState }
+
+func (PlanOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Plan)(nil)).Elem()
+}
+
+func (o PlanOutput) ToPlanOutput() PlanOutput {
+	return o
+}
+
+func (o PlanOutput) ToPlanOutputWithContext(ctx context.Context) PlanOutput {
+	return o
+}
+
+// The release asset that is installed
+func (o PlanOutput) Asset() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Plan) *string { return v.Asset }).(pulumi.StringPtrOutput)
+}
+
+// The commands that are run, in order
+func (o PlanOutput) Commands() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Plan) []string { return v.Commands }).(pulumi.StringArrayOutput)
+}
+
+// The resolved URL the program is downloaded from
+func (o PlanOutput) DownloadURL() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Plan) *string { return v.DownloadURL }).(pulumi.StringPtrOutput)
+}
+
+// The files that are removed
+func (o PlanOutput) Removes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Plan) []string { return v.Removes }).(pulumi.StringArrayOutput)
+}
+
+// The files that are written
+func (o PlanOutput) Writes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Plan) []string { return v.Writes }).(pulumi.StringArrayOutput)
+}
+
+type PlanPtrOutput struct{ *pulumi.OutputState }
+
+func (PlanPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Plan)(nil)).Elem()
+}
+
+func (o PlanPtrOutput) ToPlanPtrOutput() PlanPtrOutput {
+	return o
+}
+
+func (o PlanPtrOutput) ToPlanPtrOutputWithContext(ctx context.Context) PlanPtrOutput {
+	return o
+}
+
+func (o PlanPtrOutput) Elem() PlanOutput {
+	return o.ApplyT(func(v *Plan) Plan {
+		if v != nil {
+			return *v
+		}
+		var ret Plan
+		return ret
+	}).(PlanOutput)
+}
+
+// The release asset that is installed
+func (o PlanPtrOutput) Asset() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Plan) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Asset
+	}).(pulumi.StringPtrOutput)
+}
+
+// The commands that are run, in order
+func (o PlanPtrOutput) Commands() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Plan) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Commands
+	}).(pulumi.StringArrayOutput)
+}
+
+// The resolved URL the program is downloaded from
+func (o PlanPtrOutput) DownloadURL() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Plan) *string {
+		if v == nil {
+			return nil
+		}
+		return v.DownloadURL
+	}).(pulumi.StringPtrOutput)
+}
+
+// The files that are removed
+func (o PlanPtrOutput) Removes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Plan) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Removes
+	}).(pulumi.StringArrayOutput)
+}
+
+// The files that are written
+func (o PlanPtrOutput) Writes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Plan) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Writes
+	}).(pulumi.StringArrayOutput)
+}
+
+type Step struct {
+	// The command to run
+	Command string `pulumi:"command"`
+	// Whether to continue with the next step if this step fails
+	ContinueOnError *bool `pulumi:"continueOnError"`
+	// The directory to run the command in. Relative paths are relative to
+	// 				the directory the resource runs its commands in
+	Dir *string `pulumi:"dir"`
+	// The name of the step. This is used in logs and errors. Defaults to the command
+	Name *string `pulumi:"name"`
+}
+
+// StepInput is an input type that accepts StepArgs and StepOutput values.
+// You can construct a concrete instance of `StepInput` via:
+//
+//	StepArgs{...}
+type StepInput interface {
+	pulumi.Input
+
+	ToStepOutput() StepOutput
+	ToStepOutputWithContext(context.Context) StepOutput
+}
+
+type StepArgs struct {
+	// The command to run
+	Command pulumi.StringInput `pulumi:"command"`
+	// Whether to continue with the next step if this step fails
+	ContinueOnError pulumi.BoolPtrInput `pulumi:"continueOnError"`
+	// The directory to run the command in. Relative paths are relative to
+	// 				the directory the resource runs its commands in
+	Dir pulumi.StringPtrInput `pulumi:"dir"`
+	// The name of the step. This is used in logs and errors. Defaults to the command
+	Name pulumi.StringPtrInput `pulumi:"name"`
+}
+
+func (StepArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Step)(nil)).Elem()
+}
+
+func (i StepArgs) ToStepOutput() StepOutput {
+	return i.ToStepOutputWithContext(context.Background())
+}
+
+func (i StepArgs) ToStepOutputWithContext(ctx context.Context) StepOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(StepOutput)
+}
+
+// StepArrayInput is an input type that accepts StepArray and StepArrayOutput values.
+// You can construct a concrete instance of `StepArrayInput` via:
+//
+//	StepArray{ StepArgs{...} }
+type StepArrayInput interface {
+	pulumi.Input
+
+	ToStepArrayOutput() StepArrayOutput
+	ToStepArrayOutputWithContext(context.Context) StepArrayOutput
+}
+
+type StepArray []StepInput
+
+func (StepArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Step)(nil)).Elem()
+}
+
+func (i StepArray) ToStepArrayOutput() StepArrayOutput {
+	return i.ToStepArrayOutputWithContext(context.Background())
+}
+
+func (i StepArray) ToStepArrayOutputWithContext(ctx context.Context) StepArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(StepArrayOutput)
+}
+
+type StepOutput struct{ *pulumi.OutputState }
+
+func (StepOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Step)(nil)).Elem()
+}
+
+func (o StepOutput) ToStepOutput() StepOutput {
+	return o
+}
+
+func (o StepOutput) ToStepOutputWithContext(ctx context.Context) StepOutput {
+	return o
+}
+
+// The command to run
+func (o StepOutput) Command() pulumi.StringOutput {
+	return o.ApplyT(func(v Step) string { return v.Command }).(pulumi.StringOutput)
+}
+
+// Whether to continue with the next step if this step fails
+func (o StepOutput) ContinueOnError() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Step) *bool { return v.ContinueOnError }).(pulumi.BoolPtrOutput)
+}
+
+// The directory to run the command in. Relative paths are relative to
+//
+//	the directory the resource runs its commands in
+func (o StepOutput) Dir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Step) *string { return v.Dir }).(pulumi.StringPtrOutput)
+}
+
+// The name of the step. This is used in logs and errors. Defaults to the command
+func (o StepOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Step) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+type StepArrayOutput struct{ *pulumi.OutputState }
+
+func (StepArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Step)(nil)).Elem()
+}
+
+func (o StepArrayOutput) ToStepArrayOutput() StepArrayOutput {
+	return o
+}
+
+func (o StepArrayOutput) ToStepArrayOutputWithContext(ctx context.Context) StepArrayOutput {
+	return o
+}
+
+func (o StepArrayOutput) Index(i pulumi.IntInput) StepOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Step {
+		return vs[0].([]Step)[vs[1].(int)]
+	}).(StepOutput)
+}
+
+type StepResult struct {
+	// How long the step took to run
+	Duration string `pulumi:"duration"`
+	// The exit code of the step. This is -1 if the step could not be started
+	ExitCode int `pulumi:"exitCode"`
+	// The name of the step
+	Name string `pulumi:"name"`
+}
+
+type StepResultOutput struct{ *pulumi.OutputState }
+
+func (StepResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*StepResult)(nil)).Elem()
+}
+
+func (o StepResultOutput) ToStepResultOutput() StepResultOutput {
+	return o
+}
+
+func (o StepResultOutput) ToStepResultOutputWithContext(ctx context.Context) StepResultOutput {
+	return o
+}
+
+// How long the step took to run
+func (o StepResultOutput) Duration() pulumi.StringOutput {
+	return o.ApplyT(func(v StepResult) string { return v.Duration }).(pulumi.StringOutput)
+}
+
+// The exit code of the step. This is -1 if the step could not be started
+func (o StepResultOutput) ExitCode() pulumi.IntOutput {
+	return o.ApplyT(func(v StepResult) int { return v.ExitCode }).(pulumi.IntOutput)
+}
+
+// The name of the step
+func (o StepResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v StepResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+type StepResultArrayOutput struct{ *pulumi.OutputState }
+
+func (StepResultArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]StepResult)(nil)).Elem()
+}
+
+func (o StepResultArrayOutput) ToStepResultArrayOutput() StepResultArrayOutput {
+	return o
+}
+
+func (o StepResultArrayOutput) ToStepResultArrayOutputWithContext(ctx context.Context) StepResultArrayOutput {
+	return o
+}
+
+func (o StepResultArrayOutput) Index(i pulumi.IntInput) StepResultOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) StepResult {
+		return vs[0].([]StepResult)[vs[1].(int)]
+	}).(StepResultOutput)
+}
+
+type Verify struct {
+	// The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+	// 				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+	Command string `pulumi:"command"`
+	// A regex that the output of the command must match. Both stdout and stderr are
+	// 				matched since some programs print their version to stderr
+	ExpectedOutput *string `pulumi:"expectedOutput"`
+}
+
+// VerifyInput is an input type that accepts VerifyArgs and VerifyOutput values.
+// You can construct a concrete instance of `VerifyInput` via:
+//
+//	VerifyArgs{...}
+type VerifyInput interface {
+	pulumi.Input
+
+	ToVerifyOutput() VerifyOutput
+	ToVerifyOutputWithContext(context.Context) VerifyOutput
+}
+
+type VerifyArgs struct {
+	// The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+	// 				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+	Command pulumi.StringInput `pulumi:"command"`
+	// A regex that the output of the command must match. Both stdout and stderr are
+	// 				matched since some programs print their version to stderr
+	ExpectedOutput pulumi.StringPtrInput `pulumi:"expectedOutput"`
+}
+
+func (VerifyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Verify)(nil)).Elem()
+}
+
+func (i VerifyArgs) ToVerifyOutput() VerifyOutput {
+	return i.ToVerifyOutputWithContext(context.Background())
+}
+
+func (i VerifyArgs) ToVerifyOutputWithContext(ctx context.Context) VerifyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VerifyOutput)
+}
+
+func (i VerifyArgs) ToVerifyPtrOutput() VerifyPtrOutput {
+	return i.ToVerifyPtrOutputWithContext(context.Background())
+}
+
+func (i VerifyArgs) ToVerifyPtrOutputWithContext(ctx context.Context) VerifyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VerifyOutput).ToVerifyPtrOutputWithContext(ctx)
+}
+
+// VerifyPtrInput is an input type that accepts VerifyArgs, VerifyPtr and VerifyPtrOutput values.
+// You can construct a concrete instance of `VerifyPtrInput` via:
+//
+//	        VerifyArgs{...}
+//
+//	or:
+//
+//	        nil
+type VerifyPtrInput interface {
+	pulumi.Input
+
+	ToVerifyPtrOutput() VerifyPtrOutput
+	ToVerifyPtrOutputWithContext(context.Context) VerifyPtrOutput
+}
+
+type verifyPtrType VerifyArgs
+
+func VerifyPtr(v *VerifyArgs) VerifyPtrInput {
+	return (*verifyPtrType)(v)
+}
+
+func (*verifyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Verify)(nil)).Elem()
+}
+
+func (i *verifyPtrType) ToVerifyPtrOutput() VerifyPtrOutput {
+	return i.ToVerifyPtrOutputWithContext(context.Background())
+}
+
+func (i *verifyPtrType) ToVerifyPtrOutputWithContext(ctx context.Context) VerifyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VerifyPtrOutput)
+}
+
+type VerifyOutput struct{ *pulumi.OutputState }
+
+func (VerifyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Verify)(nil)).Elem()
+}
+
+func (o VerifyOutput) ToVerifyOutput() VerifyOutput {
+	return o
+}
+
+func (o VerifyOutput) ToVerifyOutputWithContext(ctx context.Context) VerifyOutput {
+	return o
+}
+
+func (o VerifyOutput) ToVerifyPtrOutput() VerifyPtrOutput {
+	return o.ToVerifyPtrOutputWithContext(context.Background())
+}
+
+func (o VerifyOutput) ToVerifyPtrOutputWithContext(ctx context.Context) VerifyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Verify) *Verify {
+		return &v
+	}).(VerifyPtrOutput)
+}
+
+// The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+//
+//	PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+func (o VerifyOutput) Command() pulumi.StringOutput {
+	return o.ApplyT(func(v Verify) string { return v.Command }).(pulumi.StringOutput)
+}
+
+// A regex that the output of the command must match. Both stdout and stderr are
+//
+//	matched since some programs print their version to stderr
+func (o VerifyOutput) ExpectedOutput() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Verify) *string { return v.ExpectedOutput }).(pulumi.StringPtrOutput)
+}
+
+type VerifyPtrOutput struct{ *pulumi.OutputState }
+
+func (VerifyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Verify)(nil)).Elem()
+}
+
+func (o VerifyPtrOutput) ToVerifyPtrOutput() VerifyPtrOutput {
+	return o
+}
+
+func (o VerifyPtrOutput) ToVerifyPtrOutputWithContext(ctx context.Context) VerifyPtrOutput {
+	return o
+}
+
+func (o VerifyPtrOutput) Elem() VerifyOutput {
+	return o.ApplyT(func(v *Verify) Verify {
+		if v != nil {
+			return *v
+		}
+		var ret Verify
+		return ret
+	}).(VerifyOutput)
+}
+
+// The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+//
+//	PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+func (o VerifyPtrOutput) Command() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Verify) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Command
+	}).(pulumi.StringPtrOutput)
+}
+
+// A regex that the output of the command must match. Both stdout and stderr are
+//
+//	matched since some programs print their version to stderr
+func (o VerifyPtrOutput) ExpectedOutput() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Verify) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ExpectedOutput
+	}).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*GitRemoteInput)(nil)).Elem(), GitRemoteArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GitRemoteMapInput)(nil)).Elem(), GitRemoteMap{})
+	pulumi.RegisterInputType(reflect.TypeOf((*StepInput)(nil)).Elem(), StepArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*StepArrayInput)(nil)).Elem(), StepArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VerifyInput)(nil)).Elem(), VerifyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VerifyPtrInput)(nil)).Elem(), VerifyArgs{})
+	pulumi.RegisterOutputType(GitRemoteOutput{})
+	pulumi.RegisterOutputType(GitRemoteMapOutput{})
+	pulumi.RegisterOutputType(LocalChangesOutput{})
+	pulumi.RegisterOutputType(LocalChangesPtrOutput{})
+	pulumi.RegisterOutputType(PlanOutput{})
+	pulumi.RegisterOutputType(PlanPtrOutput{})
+	pulumi.RegisterOutputType(StepOutput{})
+	pulumi.RegisterOutputType(StepArrayOutput{})
+	pulumi.RegisterOutputType(StepResultOutput{})
+	pulumi.RegisterOutputType(StepResultArrayOutput{})
+	pulumi.RegisterOutputType(VerifyOutput{})
+	pulumi.RegisterOutputType(VerifyPtrOutput{})
+}
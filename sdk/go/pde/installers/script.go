@@ -0,0 +1,567 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package installers
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/corymhall/pulumi-provider-pde/sdk/go/pde/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Run inline scripts to install, update and uninstall something.
+//
+// Each script is written to a private temporary file and run using
+// interpreter, or the interpreter from its shebang, e.g. #!/usr/bin/env python3.
+// Scripts without either are run with /bin/sh.
+type Script struct {
+	pulumi.CustomResourceState
+
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become pulumi.BoolPtrOutput `pulumi:"become"`
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod pulumi.StringPtrOutput `pulumi:"becomeMethod"`
+	// The script to run when the resource is created
+	Create pulumi.StringOutput `pulumi:"create"`
+	// The script to run when the resource is deleted. It is followed by uninstallCommands and uninstallSteps
+	Delete pulumi.StringPtrOutput `pulumi:"delete"`
+	// The directory to run the scripts in
+	Dir pulumi.StringPtrOutput `pulumi:"dir"`
+	// The environment variables to set when running the commands
+	Environment pulumi.StringMapOutput `pulumi:"environment"`
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment pulumi.BoolPtrOutput `pulumi:"inheritEnvironment"`
+	// The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+	Interpreter pulumi.StringArrayOutput `pulumi:"interpreter"`
+	// The outputs parsed from the last install or update. See outputsFrom
+	Outputs pulumi.MapOutput `pulumi:"outputs"`
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom pulumi.StringPtrOutput `pulumi:"outputsFrom"`
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall pulumi.StringArrayOutput `pulumi:"postInstall"`
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall pulumi.StringArrayOutput `pulumi:"postUninstall"`
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall pulumi.StringArrayOutput `pulumi:"preInstall"`
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall pulumi.StringArrayOutput `pulumi:"preUninstall"`
+	// The script to run to read the state of the resource. This runs after create,
+	// 				update and during refresh. If it prints a JSON object then that becomes the outputs of the resource
+	Read pulumi.StringPtrOutput `pulumi:"read"`
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs pulumi.BoolPtrOutput `pulumi:"secretOutputs"`
+	// The stderr of the last install or update
+	Stderr pulumi.StringPtrOutput `pulumi:"stderr"`
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin pulumi.StringPtrOutput `pulumi:"stdin"`
+	// The stdout of the last install or update
+	Stdout pulumi.StringPtrOutput `pulumi:"stdout"`
+	// The results of the steps that were run by the last install or update
+	Steps StepResultArrayOutput `pulumi:"steps"`
+	// Optional Commands to run to uninstall the program
+	UninstallCommands pulumi.StringArrayOutput `pulumi:"uninstallCommands"`
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps StepArrayOutput `pulumi:"uninstallSteps"`
+	// The script to run when the create or update script changes. It is followed by
+	// 				updateCommands and updateSteps. If none of them are provided then changing the create script
+	// 				will replace the resource
+	Update pulumi.StringPtrOutput `pulumi:"update"`
+	// Optional Commands to run to update the program
+	UpdateCommands pulumi.StringArrayOutput `pulumi:"updateCommands"`
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps StepArrayOutput `pulumi:"updateSteps"`
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify VerifyPtrOutput `pulumi:"verify"`
+}
+
+// NewScript registers a new resource with the given unique name, arguments, and options.
+func NewScript(ctx *pulumi.Context,
+	name string, args *ScriptArgs, opts ...pulumi.ResourceOption) (*Script, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Create == nil {
+		return nil, errors.New("invalid value for required argument 'Create'")
+	}
+	if args.Stdin != nil {
+		args.Stdin = pulumi.ToSecret(args.Stdin).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"stdin",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Script
+	err := ctx.RegisterResource("pde:installers:Script", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetScript gets an existing Script resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetScript(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ScriptState, opts ...pulumi.ResourceOption) (*Script, error) {
+	var resource Script
+	err := ctx.ReadResource("pde:installers:Script", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Script resources.
+type scriptState struct {
+}
+
+type ScriptState struct {
+}
+
+func (ScriptState) ElementType() reflect.Type {
+	return reflect.TypeOf((*scriptState)(nil)).Elem()
+}
+
+type scriptArgs struct {
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become *bool `pulumi:"become"`
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod *string `pulumi:"becomeMethod"`
+	// The script to run when the resource is created
+	Create string `pulumi:"create"`
+	// The script to run when the resource is deleted. It is followed by uninstallCommands and uninstallSteps
+	Delete *string `pulumi:"delete"`
+	// The directory to run the scripts in
+	Dir *string `pulumi:"dir"`
+	// The environment variables to set when running the scripts
+	Environment map[string]string `pulumi:"environment"`
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment *bool `pulumi:"inheritEnvironment"`
+	// The interpreter to run the scripts with, e.g. ['python3', '-u']. The path of the script
+	// 				is added as the last argument. Defaults to the shebang of each script, or /bin/sh
+	Interpreter []string `pulumi:"interpreter"`
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom *string `pulumi:"outputsFrom"`
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall []string `pulumi:"postInstall"`
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall []string `pulumi:"postUninstall"`
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall []string `pulumi:"preInstall"`
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall []string `pulumi:"preUninstall"`
+	// The script to run to read the state of the resource. This runs after create,
+	// 				update and during refresh. If it prints a JSON object then that becomes the outputs of the resource
+	Read *string `pulumi:"read"`
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs *bool `pulumi:"secretOutputs"`
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin *string `pulumi:"stdin"`
+	// Optional Commands to run to uninstall the program
+	UninstallCommands []string `pulumi:"uninstallCommands"`
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps []Step `pulumi:"uninstallSteps"`
+	// The script to run when the create or update script changes. It is followed by
+	// 				updateCommands and updateSteps. If none of them are provided then changing the create script
+	// 				will replace the resource
+	Update *string `pulumi:"update"`
+	// Optional Commands to run to update the program
+	UpdateCommands []string `pulumi:"updateCommands"`
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps []Step `pulumi:"updateSteps"`
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify *Verify `pulumi:"verify"`
+}
+
+// The set of arguments for constructing a Script resource.
+type ScriptArgs struct {
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become pulumi.BoolPtrInput
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod pulumi.StringPtrInput
+	// The script to run when the resource is created
+	Create pulumi.StringInput
+	// The script to run when the resource is deleted. It is followed by uninstallCommands and uninstallSteps
+	Delete pulumi.StringPtrInput
+	// The directory to run the scripts in
+	Dir pulumi.StringPtrInput
+	// The environment variables to set when running the scripts
+	Environment pulumi.StringMapInput
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment pulumi.BoolPtrInput
+	// The interpreter to run the scripts with, e.g. ['python3', '-u']. The path of the script
+	// 				is added as the last argument. Defaults to the shebang of each script, or /bin/sh
+	Interpreter pulumi.StringArrayInput
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom pulumi.StringPtrInput
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall pulumi.StringArrayInput
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall pulumi.StringArrayInput
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall pulumi.StringArrayInput
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall pulumi.StringArrayInput
+	// The script to run to read the state of the resource. This runs after create,
+	// 				update and during refresh. If it prints a JSON object then that becomes the outputs of the resource
+	Read pulumi.StringPtrInput
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs pulumi.BoolPtrInput
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin pulumi.StringPtrInput
+	// Optional Commands to run to uninstall the program
+	UninstallCommands pulumi.StringArrayInput
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps StepArrayInput
+	// The script to run when the create or update script changes. It is followed by
+	// 				updateCommands and updateSteps. If none of them are provided then changing the create script
+	// 				will replace the resource
+	Update pulumi.StringPtrInput
+	// Optional Commands to run to update the program
+	UpdateCommands pulumi.StringArrayInput
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps StepArrayInput
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify VerifyPtrInput
+}
+
+func (ScriptArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*scriptArgs)(nil)).Elem()
+}
+
+type ScriptInput interface {
+	pulumi.Input
+
+	ToScriptOutput() ScriptOutput
+	ToScriptOutputWithContext(ctx context.Context) ScriptOutput
+}
+
+func (*Script) ElementType() reflect.Type {
+	return reflect.TypeOf((**Script)(nil)).Elem()
+}
+
+func (i *Script) ToScriptOutput() ScriptOutput {
+	return i.ToScriptOutputWithContext(context.Background())
+}
+
+func (i *Script) ToScriptOutputWithContext(ctx context.Context) ScriptOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ScriptOutput)
+}
+
+// ScriptArrayInput is an input type that accepts ScriptArray and ScriptArrayOutput values.
+// You can construct a concrete instance of `ScriptArrayInput` via:
+//
+//	ScriptArray{ ScriptArgs{...} }
+type ScriptArrayInput interface {
+	pulumi.Input
+
+	ToScriptArrayOutput() ScriptArrayOutput
+	ToScriptArrayOutputWithContext(context.Context) ScriptArrayOutput
+}
+
+type ScriptArray []ScriptInput
+
+func (ScriptArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Script)(nil)).Elem()
+}
+
+func (i ScriptArray) ToScriptArrayOutput() ScriptArrayOutput {
+	return i.ToScriptArrayOutputWithContext(context.Background())
+}
+
+func (i ScriptArray) ToScriptArrayOutputWithContext(ctx context.Context) ScriptArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ScriptArrayOutput)
+}
+
+// ScriptMapInput is an input type that accepts ScriptMap and ScriptMapOutput values.
+// You can construct a concrete instance of `ScriptMapInput` via:
+//
+//	ScriptMap{ "key": ScriptArgs{...} }
+type ScriptMapInput interface {
+	pulumi.Input
+
+	ToScriptMapOutput() ScriptMapOutput
+	ToScriptMapOutputWithContext(context.Context) ScriptMapOutput
+}
+
+type ScriptMap map[string]ScriptInput
+
+func (ScriptMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Script)(nil)).Elem()
+}
+
+func (i ScriptMap) ToScriptMapOutput() ScriptMapOutput {
+	return i.ToScriptMapOutputWithContext(context.Background())
+}
+
+func (i ScriptMap) ToScriptMapOutputWithContext(ctx context.Context) ScriptMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ScriptMapOutput)
+}
+
+type ScriptOutput struct{ *pulumi.OutputState }
+
+func (ScriptOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Script)(nil)).Elem()
+}
+
+func (o ScriptOutput) ToScriptOutput() ScriptOutput {
+	return o
+}
+
+func (o ScriptOutput) ToScriptOutputWithContext(ctx context.Context) ScriptOutput {
+	return o
+}
+
+// Whether to run the install, update and uninstall commands as root. If the become method
+//
+//	needs a password then it is read from the becomePassword provider config
+func (o ScriptOutput) Become() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.BoolPtrOutput { return v.Become }).(pulumi.BoolPtrOutput)
+}
+
+// The method to use to become root. Either sudo or doas. Defaults to sudo
+func (o ScriptOutput) BecomeMethod() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringPtrOutput { return v.BecomeMethod }).(pulumi.StringPtrOutput)
+}
+
+// The script to run when the resource is created
+func (o ScriptOutput) Create() pulumi.StringOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringOutput { return v.Create }).(pulumi.StringOutput)
+}
+
+// The script to run when the resource is deleted. It is followed by uninstallCommands and uninstallSteps
+func (o ScriptOutput) Delete() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringPtrOutput { return v.Delete }).(pulumi.StringPtrOutput)
+}
+
+// The directory to run the scripts in
+func (o ScriptOutput) Dir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringPtrOutput { return v.Dir }).(pulumi.StringPtrOutput)
+}
+
+// The environment variables to set when running the commands
+func (o ScriptOutput) Environment() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringMapOutput { return v.Environment }).(pulumi.StringMapOutput)
+}
+
+// Whether commands inherit the environment of the provider. If this is false then
+//
+//	only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+//	the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+//	in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+//	directories. Defaults to true
+func (o ScriptOutput) InheritEnvironment() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.BoolPtrOutput { return v.InheritEnvironment }).(pulumi.BoolPtrOutput)
+}
+
+// The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+func (o ScriptOutput) Interpreter() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringArrayOutput { return v.Interpreter }).(pulumi.StringArrayOutput)
+}
+
+// The outputs parsed from the last install or update. See outputsFrom
+func (o ScriptOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v *Script) pulumi.MapOutput { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// How to create the outputs map from the install and update commands. The only supported
+//
+//	value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+//	even if it sets continueOnError
+func (o ScriptOutput) OutputsFrom() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringPtrOutput { return v.OutputsFrom }).(pulumi.StringPtrOutput)
+}
+
+// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+//
+//	are set to the location and version that were installed
+func (o ScriptOutput) PostInstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringArrayOutput { return v.PostInstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+//
+//	to the location and version that were removed
+func (o ScriptOutput) PostUninstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringArrayOutput { return v.PostUninstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+//
+//	are set to the location and version that will be installed
+func (o ScriptOutput) PreInstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringArrayOutput { return v.PreInstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+//
+//	and PDE_VERSION are set to the location and version that are installed
+func (o ScriptOutput) PreUninstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringArrayOutput { return v.PreUninstall }).(pulumi.StringArrayOutput)
+}
+
+// The script to run to read the state of the resource. This runs after create,
+//
+//	update and during refresh. If it prints a JSON object then that becomes the outputs of the resource
+func (o ScriptOutput) Read() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringPtrOutput { return v.Read }).(pulumi.StringPtrOutput)
+}
+
+// Whether to mark stdout, stderr and outputs as secret
+func (o ScriptOutput) SecretOutputs() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.BoolPtrOutput { return v.SecretOutputs }).(pulumi.BoolPtrOutput)
+}
+
+// The stderr of the last install or update
+func (o ScriptOutput) Stderr() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringPtrOutput { return v.Stderr }).(pulumi.StringPtrOutput)
+}
+
+// Input to write to the stdin of the install, update and uninstall commands. This can be used
+//
+//	to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+func (o ScriptOutput) Stdin() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringPtrOutput { return v.Stdin }).(pulumi.StringPtrOutput)
+}
+
+// The stdout of the last install or update
+func (o ScriptOutput) Stdout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringPtrOutput { return v.Stdout }).(pulumi.StringPtrOutput)
+}
+
+// The results of the steps that were run by the last install or update
+func (o ScriptOutput) Steps() StepResultArrayOutput {
+	return o.ApplyT(func(v *Script) StepResultArrayOutput { return v.Steps }).(StepResultArrayOutput)
+}
+
+// Optional Commands to run to uninstall the program
+func (o ScriptOutput) UninstallCommands() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringArrayOutput { return v.UninstallCommands }).(pulumi.StringArrayOutput)
+}
+
+// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+//
+//	A failing step fails the delete unless it sets continueOnError
+func (o ScriptOutput) UninstallSteps() StepArrayOutput {
+	return o.ApplyT(func(v *Script) StepArrayOutput { return v.UninstallSteps }).(StepArrayOutput)
+}
+
+// The script to run when the create or update script changes. It is followed by
+//
+//	updateCommands and updateSteps. If none of them are provided then changing the create script
+//	will replace the resource
+func (o ScriptOutput) Update() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringPtrOutput { return v.Update }).(pulumi.StringPtrOutput)
+}
+
+// Optional Commands to run to update the program
+func (o ScriptOutput) UpdateCommands() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Script) pulumi.StringArrayOutput { return v.UpdateCommands }).(pulumi.StringArrayOutput)
+}
+
+// Optional steps to run to update the program. These are run after updateCommands
+func (o ScriptOutput) UpdateSteps() StepArrayOutput {
+	return o.ApplyT(func(v *Script) StepArrayOutput { return v.UpdateSteps }).(StepArrayOutput)
+}
+
+// A command to check that the program works after it is installed or updated. If this
+//
+//	fails then a create is cleaned up and an update is rolled back
+func (o ScriptOutput) Verify() VerifyPtrOutput {
+	return o.ApplyT(func(v *Script) VerifyPtrOutput { return v.Verify }).(VerifyPtrOutput)
+}
+
+type ScriptArrayOutput struct{ *pulumi.OutputState }
+
+func (ScriptArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Script)(nil)).Elem()
+}
+
+func (o ScriptArrayOutput) ToScriptArrayOutput() ScriptArrayOutput {
+	return o
+}
+
+func (o ScriptArrayOutput) ToScriptArrayOutputWithContext(ctx context.Context) ScriptArrayOutput {
+	return o
+}
+
+func (o ScriptArrayOutput) Index(i pulumi.IntInput) ScriptOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Script {
+		return vs[0].([]*Script)[vs[1].(int)]
+	}).(ScriptOutput)
+}
+
+type ScriptMapOutput struct{ *pulumi.OutputState }
+
+func (ScriptMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Script)(nil)).Elem()
+}
+
+func (o ScriptMapOutput) ToScriptMapOutput() ScriptMapOutput {
+	return o
+}
+
+func (o ScriptMapOutput) ToScriptMapOutputWithContext(ctx context.Context) ScriptMapOutput {
+	return o
+}
+
+func (o ScriptMapOutput) MapIndex(k pulumi.StringInput) ScriptOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Script {
+		return vs[0].(map[string]*Script)[vs[1].(string)]
+	}).(ScriptOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*ScriptInput)(nil)).Elem(), &Script{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ScriptArrayInput)(nil)).Elem(), ScriptArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ScriptMapInput)(nil)).Elem(), ScriptMap{})
+	pulumi.RegisterOutputType(ScriptOutput{})
+	pulumi.RegisterOutputType(ScriptArrayOutput{})
+	pulumi.RegisterOutputType(ScriptMapOutput{})
+}
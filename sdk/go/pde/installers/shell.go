@@ -16,30 +16,116 @@ import (
 type Shell struct {
 	pulumi.CustomResourceState
 
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become pulumi.BoolPtrOutput `pulumi:"become"`
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod pulumi.StringPtrOutput `pulumi:"becomeMethod"`
 	// The location to put the program. Defaults to $HOME/.local/bin
 	BinLocation pulumi.StringPtrOutput `pulumi:"binLocation"`
-	// The URL to download the program from
+	// The paths that were created by the install when trackChanges is set
+	CreatedPaths pulumi.StringArrayOutput `pulumi:"createdPaths"`
+	// The version of the program to install. This is available in downloadURL as
+	// 				{{.Version}}. The version that versionCommand reports is in the version output
+	DesiredVersion pulumi.StringPtrOutput `pulumi:"desiredVersion"`
+	// The URL to download the program from. This is a go template which can use
+	// 				{{.Version}}, {{.OS}}, {{.Arch}} and {{.ArchAlias "x86_64"}}. ArchAlias returns the host
+	// 				architecture using the naming scheme where amd64 is called by the given name, e.g. x86_64/aarch64
 	DownloadURL pulumi.StringOutput `pulumi:"downloadURL"`
 	// The environment variables to set when running the commands
 	Environment pulumi.StringMapOutput `pulumi:"environment"`
 	// Whether the program that is download is an executable
 	Executable pulumi.BoolPtrOutput `pulumi:"executable"`
-	// The commands to run to install the program
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment pulumi.BoolPtrOutput `pulumi:"inheritEnvironment"`
+	// The commands to run to install the program. Each command is run as a separate step
 	InstallCommands pulumi.StringArrayOutput `pulumi:"installCommands"`
+	// The steps to run to install the program. These are run after installCommands
+	InstallSteps StepArrayOutput `pulumi:"installSteps"`
 	// The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
 	Interpreter pulumi.StringArrayOutput `pulumi:"interpreter"`
 	// The location the program was installed to
 	Location pulumi.StringPtrOutput `pulumi:"location"`
+	// The outputs parsed from the last install or update. See outputsFrom
+	Outputs pulumi.MapOutput `pulumi:"outputs"`
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom pulumi.StringPtrOutput `pulumi:"outputsFrom"`
+	// What the last create or update did. During preview this is what it will do
+	Plan PlanPtrOutput `pulumi:"plan"`
+	// The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+	// 				used for {{.OS}} and {{.Arch}} in downloadURL and an executable that is built for another
+	// 				platform is refused before it is installed. Defaults to the platform of the host
+	Platform pulumi.StringPtrOutput `pulumi:"platform"`
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall pulumi.StringArrayOutput `pulumi:"postInstall"`
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall pulumi.StringArrayOutput `pulumi:"postUninstall"`
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall pulumi.StringArrayOutput `pulumi:"preInstall"`
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall pulumi.StringArrayOutput `pulumi:"preUninstall"`
 	// The name of the program. This is the name you would use to execute the program
 	ProgramName pulumi.StringOutput `pulumi:"programName"`
+	// Whether to retain the managed workspace when the resource is deleted
+	Retain pulumi.BoolPtrOutput `pulumi:"retain"`
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs pulumi.BoolPtrOutput `pulumi:"secretOutputs"`
+	// The stderr of the last install or update
+	Stderr pulumi.StringPtrOutput `pulumi:"stderr"`
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin pulumi.StringPtrOutput `pulumi:"stdin"`
+	// The stdout of the last install or update
+	Stdout pulumi.StringPtrOutput `pulumi:"stdout"`
+	// The results of the steps that were run by the last install or update
+	Steps StepResultArrayOutput `pulumi:"steps"`
+	// Whether to record every path that the install creates in binLocation, $HOME/.local/share
+	// 				and $HOME/.config so that they are all removed when the resource is deleted. This is useful for
+	// 				installers that put files in more places than binLocation, e.g. completions or lib directories.
+	// 				Nothing else runs commands while a tracked install runs
+	TrackChanges pulumi.BoolPtrOutput `pulumi:"trackChanges"`
+	// The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+	// 				set, e.g. ~/.local/share/tool. binLocation is always watched
+	TrackPaths pulumi.StringArrayOutput `pulumi:"trackPaths"`
 	// Optional Commands to run to uninstall the program
 	UninstallCommands pulumi.StringArrayOutput `pulumi:"uninstallCommands"`
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps StepArrayOutput `pulumi:"uninstallSteps"`
 	// Optional Commands to run to update the program
 	UpdateCommands pulumi.StringArrayOutput `pulumi:"updateCommands"`
-	// The version of the program
-	Version pulumi.StringOutput `pulumi:"version"`
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps StepArrayOutput `pulumi:"updateSteps"`
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify VerifyPtrOutput `pulumi:"verify"`
+	// The version of the program. This is the version reported by versionCommand, otherwise
+	// 				the desiredVersion
+	Version pulumi.StringPtrOutput `pulumi:"version"`
 	// The command to run to get the version of the program. This is needed if you want to keep track of the version in state
 	VersionCommand pulumi.StringPtrOutput `pulumi:"versionCommand"`
+	// A regex used to extract the version from the output of versionCommand.
+	// 				If the regex contains a capture group then the first group is used, otherwise the whole match is used
+	VersionRegex pulumi.StringPtrOutput `pulumi:"versionRegex"`
+	// The directory to download the program to and run the commands in. This directory is never
+	// 				removed by the resource. Defaults to a temporary directory
+	WorkingDir pulumi.StringPtrOutput `pulumi:"workingDir"`
+	// Whether to create a managed workspace at $HOME/.local/share/pde/shell/$NAME to download the
+	// 				program to and run the commands in. The workspace is kept between create, update and delete so it can be
+	// 				used by installers that build in place. Ignored if workingDir is set
+	Workspace pulumi.BoolPtrOutput `pulumi:"workspace"`
+	// The directory the program was downloaded to and the commands were run in
+	WorkspaceDir pulumi.StringPtrOutput `pulumi:"workspaceDir"`
 }
 
 // NewShell registers a new resource with the given unique name, arguments, and options.
@@ -58,6 +144,13 @@ func NewShell(ctx *pulumi.Context,
 	if args.ProgramName == nil {
 		return nil, errors.New("invalid value for required argument 'ProgramName'")
 	}
+	if args.Stdin != nil {
+		args.Stdin = pulumi.ToSecret(args.Stdin).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"stdin",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Shell
 	err := ctx.RegisterResource("pde:installers:Shell", name, args, &resource, opts...)
@@ -91,50 +184,192 @@ func (ShellState) ElementType() reflect.Type {
 }
 
 type shellArgs struct {
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become *bool `pulumi:"become"`
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod *string `pulumi:"becomeMethod"`
 	// The location to put the program. Defaults to $HOME/.local/bin
 	BinLocation *string `pulumi:"binLocation"`
-	// The URL to download the program from
+	// The version of the program to install. This is available in downloadURL as
+	// 				{{.Version}}. The version that versionCommand reports is in the version output
+	DesiredVersion *string `pulumi:"desiredVersion"`
+	// The URL to download the program from. This is a go template which can use
+	// 				{{.Version}}, {{.OS}}, {{.Arch}} and {{.ArchAlias "x86_64"}}. ArchAlias returns the host
+	// 				architecture using the naming scheme where amd64 is called by the given name, e.g. x86_64/aarch64
 	DownloadURL string `pulumi:"downloadURL"`
 	// The environment variables to set when running the commands
 	Environment map[string]string `pulumi:"environment"`
 	// Whether the program that is download is an executable
 	Executable *bool `pulumi:"executable"`
-	// The commands to run to install the program
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment *bool `pulumi:"inheritEnvironment"`
+	// The commands to run to install the program. Each command is run as a separate step
 	InstallCommands []string `pulumi:"installCommands"`
+	// The steps to run to install the program. These are run after installCommands
+	InstallSteps []Step `pulumi:"installSteps"`
 	// The interpreter to use to run the install commands. Defaults to ['/bin/sh', '-c']
 	Interpreter []string `pulumi:"interpreter"`
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom *string `pulumi:"outputsFrom"`
+	// The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+	// 				used for {{.OS}} and {{.Arch}} in downloadURL and an executable that is built for another
+	// 				platform is refused before it is installed. Defaults to the platform of the host
+	Platform *string `pulumi:"platform"`
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall []string `pulumi:"postInstall"`
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall []string `pulumi:"postUninstall"`
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall []string `pulumi:"preInstall"`
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall []string `pulumi:"preUninstall"`
 	// The name of the program. This is the name you would use to execute the program
 	ProgramName string `pulumi:"programName"`
+	// Whether to retain the managed workspace when the resource is deleted
+	Retain *bool `pulumi:"retain"`
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs *bool `pulumi:"secretOutputs"`
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin *string `pulumi:"stdin"`
+	// Whether to record every path that the install creates in binLocation, $HOME/.local/share
+	// 				and $HOME/.config so that they are all removed when the resource is deleted. This is useful for
+	// 				installers that put files in more places than binLocation, e.g. completions or lib directories.
+	// 				Nothing else runs commands while a tracked install runs
+	TrackChanges *bool `pulumi:"trackChanges"`
+	// The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+	// 				set, e.g. ~/.local/share/tool. binLocation is always watched
+	TrackPaths []string `pulumi:"trackPaths"`
 	// Optional Commands to run to uninstall the program
 	UninstallCommands []string `pulumi:"uninstallCommands"`
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps []Step `pulumi:"uninstallSteps"`
 	// Optional Commands to run to update the program
 	UpdateCommands []string `pulumi:"updateCommands"`
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps []Step `pulumi:"updateSteps"`
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify *Verify `pulumi:"verify"`
 	// The command to run to get the version of the program. This is needed if you want to keep track of the version in state
 	VersionCommand *string `pulumi:"versionCommand"`
+	// A regex used to extract the version from the output of versionCommand.
+	// 				If the regex contains a capture group then the first group is used, otherwise the whole match is used
+	VersionRegex *string `pulumi:"versionRegex"`
+	// The directory to download the program to and run the commands in. This directory is never
+	// 				removed by the resource. Defaults to a temporary directory
+	WorkingDir *string `pulumi:"workingDir"`
+	// Whether to create a managed workspace at $HOME/.local/share/pde/shell/$NAME to download the
+	// 				program to and run the commands in. The workspace is kept between create, update and delete so it can be
+	// 				used by installers that build in place. Ignored if workingDir is set
+	Workspace *bool `pulumi:"workspace"`
 }
 
 // The set of arguments for constructing a Shell resource.
 type ShellArgs struct {
+	// Whether to run the install, update and uninstall commands as root. If the become method
+	// 				needs a password then it is read from the becomePassword provider config
+	Become pulumi.BoolPtrInput
+	// The method to use to become root. Either sudo or doas. Defaults to sudo
+	BecomeMethod pulumi.StringPtrInput
 	// The location to put the program. Defaults to $HOME/.local/bin
 	BinLocation pulumi.StringPtrInput
-	// The URL to download the program from
+	// The version of the program to install. This is available in downloadURL as
+	// 				{{.Version}}. The version that versionCommand reports is in the version output
+	DesiredVersion pulumi.StringPtrInput
+	// The URL to download the program from. This is a go template which can use
+	// 				{{.Version}}, {{.OS}}, {{.Arch}} and {{.ArchAlias "x86_64"}}. ArchAlias returns the host
+	// 				architecture using the naming scheme where amd64 is called by the given name, e.g. x86_64/aarch64
 	DownloadURL pulumi.StringInput
 	// The environment variables to set when running the commands
 	Environment pulumi.StringMapInput
 	// Whether the program that is download is an executable
 	Executable pulumi.BoolPtrInput
-	// The commands to run to install the program
+	// Whether commands inherit the environment of the provider. If this is false then
+	// 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+	// 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+	// 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+	// 				directories. Defaults to true
+	InheritEnvironment pulumi.BoolPtrInput
+	// The commands to run to install the program. Each command is run as a separate step
 	InstallCommands pulumi.StringArrayInput
+	// The steps to run to install the program. These are run after installCommands
+	InstallSteps StepArrayInput
 	// The interpreter to use to run the install commands. Defaults to ['/bin/sh', '-c']
 	Interpreter pulumi.StringArrayInput
+	// How to create the outputs map from the install and update commands. The only supported
+	// 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+	// 				even if it sets continueOnError
+	OutputsFrom pulumi.StringPtrInput
+	// The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+	// 				used for {{.OS}} and {{.Arch}} in downloadURL and an executable that is built for another
+	// 				platform is refused before it is installed. Defaults to the platform of the host
+	Platform pulumi.StringPtrInput
+	// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that were installed
+	PostInstall pulumi.StringArrayInput
+	// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+	// 				to the location and version that were removed
+	PostUninstall pulumi.StringArrayInput
+	// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+	// 				are set to the location and version that will be installed
+	PreInstall pulumi.StringArrayInput
+	// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+	// 				and PDE_VERSION are set to the location and version that are installed
+	PreUninstall pulumi.StringArrayInput
 	// The name of the program. This is the name you would use to execute the program
 	ProgramName pulumi.StringInput
+	// Whether to retain the managed workspace when the resource is deleted
+	Retain pulumi.BoolPtrInput
+	// Whether to mark stdout, stderr and outputs as secret
+	SecretOutputs pulumi.BoolPtrInput
+	// Input to write to the stdin of the install, update and uninstall commands. This can be used
+	// 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+	Stdin pulumi.StringPtrInput
+	// Whether to record every path that the install creates in binLocation, $HOME/.local/share
+	// 				and $HOME/.config so that they are all removed when the resource is deleted. This is useful for
+	// 				installers that put files in more places than binLocation, e.g. completions or lib directories.
+	// 				Nothing else runs commands while a tracked install runs
+	TrackChanges pulumi.BoolPtrInput
+	// The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+	// 				set, e.g. ~/.local/share/tool. binLocation is always watched
+	TrackPaths pulumi.StringArrayInput
 	// Optional Commands to run to uninstall the program
 	UninstallCommands pulumi.StringArrayInput
+	// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+	// 				A failing step fails the delete unless it sets continueOnError
+	UninstallSteps StepArrayInput
 	// Optional Commands to run to update the program
 	UpdateCommands pulumi.StringArrayInput
+	// Optional steps to run to update the program. These are run after updateCommands
+	UpdateSteps StepArrayInput
+	// A command to check that the program works after it is installed or updated. If this
+	// 				fails then a create is cleaned up and an update is rolled back
+	Verify VerifyPtrInput
 	// The command to run to get the version of the program. This is needed if you want to keep track of the version in state
 	VersionCommand pulumi.StringPtrInput
+	// A regex used to extract the version from the output of versionCommand.
+	// 				If the regex contains a capture group then the first group is used, otherwise the whole match is used
+	VersionRegex pulumi.StringPtrInput
+	// The directory to download the program to and run the commands in. This directory is never
+	// 				removed by the resource. Defaults to a temporary directory
+	WorkingDir pulumi.StringPtrInput
+	// Whether to create a managed workspace at $HOME/.local/share/pde/shell/$NAME to download the
+	// 				program to and run the commands in. The workspace is kept between create, update and delete so it can be
+	// 				used by installers that build in place. Ignored if workingDir is set
+	Workspace pulumi.BoolPtrInput
 }
 
 func (ShellArgs) ElementType() reflect.Type {
@@ -224,12 +459,39 @@ func (o ShellOutput) ToShellOutputWithContext(ctx context.Context) ShellOutput {
 	return o
 }
 
+// Whether to run the install, update and uninstall commands as root. If the become method
+//
+//	needs a password then it is read from the becomePassword provider config
+func (o ShellOutput) Become() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.BoolPtrOutput { return v.Become }).(pulumi.BoolPtrOutput)
+}
+
+// The method to use to become root. Either sudo or doas. Defaults to sudo
+func (o ShellOutput) BecomeMethod() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.BecomeMethod }).(pulumi.StringPtrOutput)
+}
+
 // The location to put the program. Defaults to $HOME/.local/bin
 func (o ShellOutput) BinLocation() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.BinLocation }).(pulumi.StringPtrOutput)
 }
 
-// The URL to download the program from
+// The paths that were created by the install when trackChanges is set
+func (o ShellOutput) CreatedPaths() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringArrayOutput { return v.CreatedPaths }).(pulumi.StringArrayOutput)
+}
+
+// The version of the program to install. This is available in downloadURL as
+//
+//	{{.Version}}. The version that versionCommand reports is in the version output
+func (o ShellOutput) DesiredVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.DesiredVersion }).(pulumi.StringPtrOutput)
+}
+
+// The URL to download the program from. This is a go template which can use
+//
+//	{{.Version}}, {{.OS}}, {{.Arch}} and {{.ArchAlias "x86_64"}}. ArchAlias returns the host
+//	architecture using the naming scheme where amd64 is called by the given name, e.g. x86_64/aarch64
 func (o ShellOutput) DownloadURL() pulumi.StringOutput {
 	return o.ApplyT(func(v *Shell) pulumi.StringOutput { return v.DownloadURL }).(pulumi.StringOutput)
 }
@@ -244,11 +506,26 @@ func (o ShellOutput) Executable() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *Shell) pulumi.BoolPtrOutput { return v.Executable }).(pulumi.BoolPtrOutput)
 }
 
-// The commands to run to install the program
+// Whether commands inherit the environment of the provider. If this is false then
+//
+//	only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+//	the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+//	in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+//	directories. Defaults to true
+func (o ShellOutput) InheritEnvironment() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.BoolPtrOutput { return v.InheritEnvironment }).(pulumi.BoolPtrOutput)
+}
+
+// The commands to run to install the program. Each command is run as a separate step
 func (o ShellOutput) InstallCommands() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *Shell) pulumi.StringArrayOutput { return v.InstallCommands }).(pulumi.StringArrayOutput)
 }
 
+// The steps to run to install the program. These are run after installCommands
+func (o ShellOutput) InstallSteps() StepArrayOutput {
+	return o.ApplyT(func(v *Shell) StepArrayOutput { return v.InstallSteps }).(StepArrayOutput)
+}
+
 // The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
 func (o ShellOutput) Interpreter() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *Shell) pulumi.StringArrayOutput { return v.Interpreter }).(pulumi.StringArrayOutput)
@@ -259,24 +536,147 @@ func (o ShellOutput) Location() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.Location }).(pulumi.StringPtrOutput)
 }
 
+// The outputs parsed from the last install or update. See outputsFrom
+func (o ShellOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v *Shell) pulumi.MapOutput { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// How to create the outputs map from the install and update commands. The only supported
+//
+//	value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+//	even if it sets continueOnError
+func (o ShellOutput) OutputsFrom() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.OutputsFrom }).(pulumi.StringPtrOutput)
+}
+
+// What the last create or update did. During preview this is what it will do
+func (o ShellOutput) Plan() PlanPtrOutput {
+	return o.ApplyT(func(v *Shell) PlanPtrOutput { return v.Plan }).(PlanPtrOutput)
+}
+
+// The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+//
+//	used for {{.OS}} and {{.Arch}} in downloadURL and an executable that is built for another
+//	platform is refused before it is installed. Defaults to the platform of the host
+func (o ShellOutput) Platform() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.Platform }).(pulumi.StringPtrOutput)
+}
+
+// Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+//
+//	are set to the location and version that were installed
+func (o ShellOutput) PostInstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringArrayOutput { return v.PostInstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+//
+//	to the location and version that were removed
+func (o ShellOutput) PostUninstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringArrayOutput { return v.PostUninstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+//
+//	are set to the location and version that will be installed
+func (o ShellOutput) PreInstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringArrayOutput { return v.PreInstall }).(pulumi.StringArrayOutput)
+}
+
+// Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+//
+//	and PDE_VERSION are set to the location and version that are installed
+func (o ShellOutput) PreUninstall() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringArrayOutput { return v.PreUninstall }).(pulumi.StringArrayOutput)
+}
+
 // The name of the program. This is the name you would use to execute the program
 func (o ShellOutput) ProgramName() pulumi.StringOutput {
 	return o.ApplyT(func(v *Shell) pulumi.StringOutput { return v.ProgramName }).(pulumi.StringOutput)
 }
 
+// Whether to retain the managed workspace when the resource is deleted
+func (o ShellOutput) Retain() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.BoolPtrOutput { return v.Retain }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to mark stdout, stderr and outputs as secret
+func (o ShellOutput) SecretOutputs() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.BoolPtrOutput { return v.SecretOutputs }).(pulumi.BoolPtrOutput)
+}
+
+// The stderr of the last install or update
+func (o ShellOutput) Stderr() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.Stderr }).(pulumi.StringPtrOutput)
+}
+
+// Input to write to the stdin of the install, update and uninstall commands. This can be used
+//
+//	to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+func (o ShellOutput) Stdin() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.Stdin }).(pulumi.StringPtrOutput)
+}
+
+// The stdout of the last install or update
+func (o ShellOutput) Stdout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.Stdout }).(pulumi.StringPtrOutput)
+}
+
+// The results of the steps that were run by the last install or update
+func (o ShellOutput) Steps() StepResultArrayOutput {
+	return o.ApplyT(func(v *Shell) StepResultArrayOutput { return v.Steps }).(StepResultArrayOutput)
+}
+
+// Whether to record every path that the install creates in binLocation, $HOME/.local/share
+//
+//	and $HOME/.config so that they are all removed when the resource is deleted. This is useful for
+//	installers that put files in more places than binLocation, e.g. completions or lib directories.
+//	Nothing else runs commands while a tracked install runs
+func (o ShellOutput) TrackChanges() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.BoolPtrOutput { return v.TrackChanges }).(pulumi.BoolPtrOutput)
+}
+
+// The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+//
+//	set, e.g. ~/.local/share/tool. binLocation is always watched
+func (o ShellOutput) TrackPaths() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringArrayOutput { return v.TrackPaths }).(pulumi.StringArrayOutput)
+}
+
 // Optional Commands to run to uninstall the program
 func (o ShellOutput) UninstallCommands() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *Shell) pulumi.StringArrayOutput { return v.UninstallCommands }).(pulumi.StringArrayOutput)
 }
 
+// Optional steps to run to uninstall the program. These are run after uninstallCommands.
+//
+//	A failing step fails the delete unless it sets continueOnError
+func (o ShellOutput) UninstallSteps() StepArrayOutput {
+	return o.ApplyT(func(v *Shell) StepArrayOutput { return v.UninstallSteps }).(StepArrayOutput)
+}
+
 // Optional Commands to run to update the program
 func (o ShellOutput) UpdateCommands() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *Shell) pulumi.StringArrayOutput { return v.UpdateCommands }).(pulumi.StringArrayOutput)
 }
 
-// The version of the program
-func (o ShellOutput) Version() pulumi.StringOutput {
-	return o.ApplyT(func(v *Shell) pulumi.StringOutput { return v.Version }).(pulumi.StringOutput)
+// Optional steps to run to update the program. These are run after updateCommands
+func (o ShellOutput) UpdateSteps() StepArrayOutput {
+	return o.ApplyT(func(v *Shell) StepArrayOutput { return v.UpdateSteps }).(StepArrayOutput)
+}
+
+// A command to check that the program works after it is installed or updated. If this
+//
+//	fails then a create is cleaned up and an update is rolled back
+func (o ShellOutput) Verify() VerifyPtrOutput {
+	return o.ApplyT(func(v *Shell) VerifyPtrOutput { return v.Verify }).(VerifyPtrOutput)
+}
+
+// The version of the program. This is the version reported by versionCommand, otherwise
+//
+//	the desiredVersion
+func (o ShellOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.Version }).(pulumi.StringPtrOutput)
 }
 
 // The command to run to get the version of the program. This is needed if you want to keep track of the version in state
@@ -284,6 +684,33 @@ func (o ShellOutput) VersionCommand() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.VersionCommand }).(pulumi.StringPtrOutput)
 }
 
+// A regex used to extract the version from the output of versionCommand.
+//
+//	If the regex contains a capture group then the first group is used, otherwise the whole match is used
+func (o ShellOutput) VersionRegex() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.VersionRegex }).(pulumi.StringPtrOutput)
+}
+
+// The directory to download the program to and run the commands in. This directory is never
+//
+//	removed by the resource. Defaults to a temporary directory
+func (o ShellOutput) WorkingDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.WorkingDir }).(pulumi.StringPtrOutput)
+}
+
+// Whether to create a managed workspace at $HOME/.local/share/pde/shell/$NAME to download the
+//
+//	program to and run the commands in. The workspace is kept between create, update and delete so it can be
+//	used by installers that build in place. Ignored if workingDir is set
+func (o ShellOutput) Workspace() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.BoolPtrOutput { return v.Workspace }).(pulumi.BoolPtrOutput)
+}
+
+// The directory the program was downloaded to and the commands were run in
+func (o ShellOutput) WorkspaceDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Shell) pulumi.StringPtrOutput { return v.WorkspaceDir }).(pulumi.StringPtrOutput)
+}
+
 type ShellArrayOutput struct{ *pulumi.OutputState }
 
 func (ShellArrayOutput) ElementType() reflect.Type {
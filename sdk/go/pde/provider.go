@@ -13,6 +13,14 @@ import (
 
 type Provider struct {
 	pulumi.ProviderResourceState
+
+	// The password to use when running commands with become. This is
+	// 				passed to the become method through an askpass helper and is never written to state or logs
+	BecomePassword pulumi.StringPtrOutput `pulumi:"becomePassword"`
+	// The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+	// 				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+	// 				Defaults to cloning to $HOME/$REPO
+	SourceRoot pulumi.StringPtrOutput `pulumi:"sourceRoot"`
 }
 
 // NewProvider registers a new resource with the given unique name, arguments, and options.
@@ -22,6 +30,13 @@ func NewProvider(ctx *pulumi.Context,
 		args = &ProviderArgs{}
 	}
 
+	if args.BecomePassword != nil {
+		args.BecomePassword = pulumi.ToSecret(args.BecomePassword).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"becomePassword",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Provider
 	err := ctx.RegisterResource("pulumi:providers:pde", name, args, &resource, opts...)
@@ -32,10 +47,34 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
+	// The password to use when running commands with become. This is
+	// 				passed to the become method through an askpass helper and is never written to state or logs
+	BecomePassword *string `pulumi:"becomePassword"`
+	// Directories to put on PATH for commands that don't inherit the environment. They come
+	// 				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+	// 				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+	// 				resources it deletes, so this can be used to always find tools installed by other resources
+	BinPaths []string `pulumi:"binPaths"`
+	// The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+	// 				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+	// 				Defaults to cloning to $HOME/$REPO
+	SourceRoot *string `pulumi:"sourceRoot"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
+	// The password to use when running commands with become. This is
+	// 				passed to the become method through an askpass helper and is never written to state or logs
+	BecomePassword pulumi.StringPtrInput
+	// Directories to put on PATH for commands that don't inherit the environment. They come
+	// 				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+	// 				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+	// 				resources it deletes, so this can be used to always find tools installed by other resources
+	BinPaths pulumi.StringArrayInput
+	// The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+	// 				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+	// 				Defaults to cloning to $HOME/$REPO
+	SourceRoot pulumi.StringPtrInput
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
@@ -75,6 +114,21 @@ func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) Provide
 	return o
 }
 
+// The password to use when running commands with become. This is
+//
+//	passed to the become method through an askpass helper and is never written to state or logs
+func (o ProviderOutput) BecomePassword() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.BecomePassword }).(pulumi.StringPtrOutput)
+}
+
+// The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+//
+//	cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+//	Defaults to cloning to $HOME/$REPO
+func (o ProviderOutput) SourceRoot() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.SourceRoot }).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
 	pulumi.RegisterOutputType(ProviderOutput{})
@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+declare var exports: any;
+const __config = new pulumi.Config("pde");
+
+/**
+ * The password to use when running commands with become. This is
+ * 				passed to the become method through an askpass helper and is never written to state or logs
+ */
+export declare const becomePassword: string | undefined;
+Object.defineProperty(exports, "becomePassword", {
+    get() {
+        return __config.get("becomePassword");
+    },
+    enumerable: true,
+});
+
+/**
+ * Directories to put on PATH for commands that don't inherit the environment. They come
+ * 				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+ * 				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+ * 				resources it deletes, so this can be used to always find tools installed by other resources
+ */
+export declare const binPaths: string[] | undefined;
+Object.defineProperty(exports, "binPaths", {
+    get() {
+        return __config.getObject<string[]>("binPaths");
+    },
+    enumerable: true,
+});
+
+/**
+ * The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+ * 				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+ * 				Defaults to cloning to $HOME/$REPO
+ */
+export declare const sourceRoot: string | undefined;
+Object.defineProperty(exports, "sourceRoot", {
+    get() {
+        return __config.get("sourceRoot");
+    },
+    enumerable: true,
+});
+
@@ -0,0 +1,446 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Install a program from a GitHub release
+ */
+export class GitHubRelease extends pulumi.CustomResource {
+    /**
+     * Get an existing GitHubRelease resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): GitHubRelease {
+        return new GitHubRelease(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'pde:installers:GitHubRelease';
+
+    /**
+     * Returns true if the given object is an instance of GitHubRelease.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is GitHubRelease {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === GitHubRelease.__pulumiType;
+    }
+
+    /**
+     * The name of the release asset to install. If this is not provided then
+     * 				the resource will try and find the correct asset name to install. Supports regex
+     */
+    public readonly assetName!: pulumi.Output<string | undefined>;
+    /**
+     * Whether to run the install, update and uninstall commands as root. If the become method
+     * 				needs a password then it is read from the becomePassword provider config
+     */
+    public readonly become!: pulumi.Output<boolean | undefined>;
+    /**
+     * The method to use to become root. Either sudo or doas. Defaults to sudo
+     */
+    public readonly becomeMethod!: pulumi.Output<string | undefined>;
+    /**
+     * Sometimes release assets contain a folder containing
+     * 				program binaries which can just be copied. If that is the case, then provide the
+     * 				location here. This will copy all files in the directory to the bin_location
+     */
+    public readonly binFolder!: pulumi.Output<string | undefined>;
+    /**
+     * The location to put the program. Defaults to $HOME/.local/bin
+     */
+    public readonly binLocation!: pulumi.Output<string | undefined>;
+    /**
+     * The paths that were created by the install when trackChanges is set
+     */
+    public /*out*/ readonly createdPaths!: pulumi.Output<string[] | undefined>;
+    /**
+     * The URL of the GitHub release asset
+     */
+    public /*out*/ readonly downloadURL!: pulumi.Output<string>;
+    /**
+     * The environment variables to set when running the commands
+     */
+    public /*out*/ readonly environment!: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * The name of the executable to create a symlink for. If not provided then the executable name will be the same as the repo name
+     */
+    public readonly executable!: pulumi.Output<string | undefined>;
+    /**
+     * Whether commands inherit the environment of the provider. If this is false then
+     * 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+     * 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+     * 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+     * 				directories. Defaults to true
+     */
+    public readonly inheritEnvironment!: pulumi.Output<boolean | undefined>;
+    /**
+     * The commands to run to install the program. Each command is run as a separate step
+     */
+    public readonly installCommands!: pulumi.Output<string[] | undefined>;
+    /**
+     * The steps to run to install the program. These are run after installCommands
+     */
+    public readonly installSteps!: pulumi.Output<outputs.installers.Step[] | undefined>;
+    /**
+     * The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+     */
+    public /*out*/ readonly interpreter!: pulumi.Output<string[] | undefined>;
+    /**
+     * The locations the program was installed to
+     */
+    public /*out*/ readonly locations!: pulumi.Output<string[] | undefined>;
+    /**
+     * The GitHub organization the repo belongs to
+     */
+    public readonly org!: pulumi.Output<string>;
+    /**
+     * The outputs parsed from the last install or update. See outputsFrom
+     */
+    public /*out*/ readonly outputs!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * How to create the outputs map from the install and update commands. The only supported
+     * 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+     * 				even if it sets continueOnError
+     */
+    public readonly outputsFrom!: pulumi.Output<string | undefined>;
+    /**
+     * What the last create or update did. During preview this is what it will do
+     */
+    public /*out*/ readonly plan!: pulumi.Output<outputs.installers.Plan | undefined>;
+    /**
+     * The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+     * 				used to find the release asset and executables that are built for another platform are
+     * 				refused. Defaults to the platform of the host
+     */
+    public readonly platform!: pulumi.Output<string | undefined>;
+    /**
+     * Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that were installed
+     */
+    public readonly postInstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+     * 				to the location and version that were removed
+     */
+    public readonly postUninstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that will be installed
+     */
+    public readonly preInstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+     * 				and PDE_VERSION are set to the location and version that are installed
+     */
+    public readonly preUninstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * The release version to install. If this is not provided then
+     * 				the resource will try and find the latest release version to install.
+     */
+    public readonly releaseVersion!: pulumi.Output<string | undefined>;
+    /**
+     * The GitHub repository name
+     */
+    public readonly repo!: pulumi.Output<string>;
+    /**
+     * Whether to mark stdout, stderr and outputs as secret
+     */
+    public readonly secretOutputs!: pulumi.Output<boolean | undefined>;
+    /**
+     * The stderr of the last install or update
+     */
+    public /*out*/ readonly stderr!: pulumi.Output<string | undefined>;
+    /**
+     * Input to write to the stdin of the install, update and uninstall commands. This can be used
+     * 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+     */
+    public readonly stdin!: pulumi.Output<string | undefined>;
+    /**
+     * The stdout of the last install or update
+     */
+    public /*out*/ readonly stdout!: pulumi.Output<string | undefined>;
+    /**
+     * The results of the steps that were run by the last install or update
+     */
+    public /*out*/ readonly steps!: pulumi.Output<outputs.installers.StepResult[] | undefined>;
+    /**
+     * Whether to record every path that the install creates in binLocation, $HOME/.local/share
+     * 				and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+     * 				commands while a tracked install runs
+     */
+    public readonly trackChanges!: pulumi.Output<boolean | undefined>;
+    /**
+     * The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+     * 				set, e.g. ~/.local/share/tool. binLocation is always watched
+     */
+    public readonly trackPaths!: pulumi.Output<string[] | undefined>;
+    /**
+     * Optional Commands to run to uninstall the program
+     */
+    public readonly uninstallCommands!: pulumi.Output<string[] | undefined>;
+    /**
+     * Optional steps to run to uninstall the program. These are run after uninstallCommands.
+     * 				A failing step fails the delete unless it sets continueOnError
+     */
+    public readonly uninstallSteps!: pulumi.Output<outputs.installers.Step[] | undefined>;
+    /**
+     * Optional Commands to run to update the program
+     */
+    public readonly updateCommands!: pulumi.Output<string[] | undefined>;
+    /**
+     * Optional steps to run to update the program. These are run after updateCommands
+     */
+    public readonly updateSteps!: pulumi.Output<outputs.installers.Step[] | undefined>;
+    /**
+     * A command to check that the program works after it is installed or updated. If this
+     * 				fails then a create is cleaned up and an update is rolled back
+     */
+    public readonly verify!: pulumi.Output<outputs.installers.Verify | undefined>;
+
+    /**
+     * Create a GitHubRelease resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: GitHubReleaseArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.org === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'org'");
+            }
+            if ((!args || args.repo === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'repo'");
+            }
+            resourceInputs["assetName"] = args ? args.assetName : undefined;
+            resourceInputs["become"] = args ? args.become : undefined;
+            resourceInputs["becomeMethod"] = args ? args.becomeMethod : undefined;
+            resourceInputs["binFolder"] = args ? args.binFolder : undefined;
+            resourceInputs["binLocation"] = args ? args.binLocation : undefined;
+            resourceInputs["executable"] = args ? args.executable : undefined;
+            resourceInputs["inheritEnvironment"] = args ? args.inheritEnvironment : undefined;
+            resourceInputs["installCommands"] = args ? args.installCommands : undefined;
+            resourceInputs["installSteps"] = args ? args.installSteps : undefined;
+            resourceInputs["org"] = args ? args.org : undefined;
+            resourceInputs["outputsFrom"] = args ? args.outputsFrom : undefined;
+            resourceInputs["platform"] = args ? args.platform : undefined;
+            resourceInputs["postInstall"] = args ? args.postInstall : undefined;
+            resourceInputs["postUninstall"] = args ? args.postUninstall : undefined;
+            resourceInputs["preInstall"] = args ? args.preInstall : undefined;
+            resourceInputs["preUninstall"] = args ? args.preUninstall : undefined;
+            resourceInputs["releaseVersion"] = args ? args.releaseVersion : undefined;
+            resourceInputs["repo"] = args ? args.repo : undefined;
+            resourceInputs["secretOutputs"] = args ? args.secretOutputs : undefined;
+            resourceInputs["stdin"] = args?.stdin ? pulumi.secret(args.stdin) : undefined;
+            resourceInputs["trackChanges"] = args ? args.trackChanges : undefined;
+            resourceInputs["trackPaths"] = args ? args.trackPaths : undefined;
+            resourceInputs["uninstallCommands"] = args ? args.uninstallCommands : undefined;
+            resourceInputs["uninstallSteps"] = args ? args.uninstallSteps : undefined;
+            resourceInputs["updateCommands"] = args ? args.updateCommands : undefined;
+            resourceInputs["updateSteps"] = args ? args.updateSteps : undefined;
+            resourceInputs["verify"] = args ? args.verify : undefined;
+            resourceInputs["createdPaths"] = undefined /*out*/;
+            resourceInputs["downloadURL"] = undefined /*out*/;
+            resourceInputs["environment"] = undefined /*out*/;
+            resourceInputs["interpreter"] = undefined /*out*/;
+            resourceInputs["locations"] = undefined /*out*/;
+            resourceInputs["outputs"] = undefined /*out*/;
+            resourceInputs["plan"] = undefined /*out*/;
+            resourceInputs["stderr"] = undefined /*out*/;
+            resourceInputs["stdout"] = undefined /*out*/;
+            resourceInputs["steps"] = undefined /*out*/;
+        } else {
+            resourceInputs["assetName"] = undefined /*out*/;
+            resourceInputs["become"] = undefined /*out*/;
+            resourceInputs["becomeMethod"] = undefined /*out*/;
+            resourceInputs["binFolder"] = undefined /*out*/;
+            resourceInputs["binLocation"] = undefined /*out*/;
+            resourceInputs["createdPaths"] = undefined /*out*/;
+            resourceInputs["downloadURL"] = undefined /*out*/;
+            resourceInputs["environment"] = undefined /*out*/;
+            resourceInputs["executable"] = undefined /*out*/;
+            resourceInputs["inheritEnvironment"] = undefined /*out*/;
+            resourceInputs["installCommands"] = undefined /*out*/;
+            resourceInputs["installSteps"] = undefined /*out*/;
+            resourceInputs["interpreter"] = undefined /*out*/;
+            resourceInputs["locations"] = undefined /*out*/;
+            resourceInputs["org"] = undefined /*out*/;
+            resourceInputs["outputs"] = undefined /*out*/;
+            resourceInputs["outputsFrom"] = undefined /*out*/;
+            resourceInputs["plan"] = undefined /*out*/;
+            resourceInputs["platform"] = undefined /*out*/;
+            resourceInputs["postInstall"] = undefined /*out*/;
+            resourceInputs["postUninstall"] = undefined /*out*/;
+            resourceInputs["preInstall"] = undefined /*out*/;
+            resourceInputs["preUninstall"] = undefined /*out*/;
+            resourceInputs["releaseVersion"] = undefined /*out*/;
+            resourceInputs["repo"] = undefined /*out*/;
+            resourceInputs["secretOutputs"] = undefined /*out*/;
+            resourceInputs["stderr"] = undefined /*out*/;
+            resourceInputs["stdin"] = undefined /*out*/;
+            resourceInputs["stdout"] = undefined /*out*/;
+            resourceInputs["steps"] = undefined /*out*/;
+            resourceInputs["trackChanges"] = undefined /*out*/;
+            resourceInputs["trackPaths"] = undefined /*out*/;
+            resourceInputs["uninstallCommands"] = undefined /*out*/;
+            resourceInputs["uninstallSteps"] = undefined /*out*/;
+            resourceInputs["updateCommands"] = undefined /*out*/;
+            resourceInputs["updateSteps"] = undefined /*out*/;
+            resourceInputs["verify"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["stdin"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(GitHubRelease.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a GitHubRelease resource.
+ */
+export interface GitHubReleaseArgs {
+    /**
+     * The name of the release asset to install. If this is not provided then
+     * 				the resource will try and find the correct asset name to install. Supports regex
+     */
+    assetName?: pulumi.Input<string>;
+    /**
+     * Whether to run the install, update and uninstall commands as root. If the become method
+     * 				needs a password then it is read from the becomePassword provider config
+     */
+    become?: pulumi.Input<boolean>;
+    /**
+     * The method to use to become root. Either sudo or doas. Defaults to sudo
+     */
+    becomeMethod?: pulumi.Input<string>;
+    /**
+     * Sometimes release assets contain a folder containing
+     * 				program binaries which can just be copied. If that is the case, then provide the
+     * 				location here. This will copy all files in the directory to the bin_location
+     */
+    binFolder?: pulumi.Input<string>;
+    /**
+     * The location to put the program. Defaults to $HOME/.local/bin
+     */
+    binLocation?: pulumi.Input<string>;
+    /**
+     * The name of the executable to create a symlink for. If not provided then the executable name will be the same as the repo name
+     */
+    executable?: pulumi.Input<string>;
+    /**
+     * Whether commands inherit the environment of the provider. If this is false then
+     * 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+     * 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+     * 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+     * 				directories. Defaults to true
+     */
+    inheritEnvironment?: pulumi.Input<boolean>;
+    /**
+     * The commands to run to install the program. Each command is run as a separate step
+     */
+    installCommands?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The steps to run to install the program. These are run after installCommands
+     */
+    installSteps?: pulumi.Input<pulumi.Input<inputs.installers.StepArgs>[]>;
+    /**
+     * The GitHub organization the repo belongs to
+     */
+    org: pulumi.Input<string>;
+    /**
+     * How to create the outputs map from the install and update commands. The only supported
+     * 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+     * 				even if it sets continueOnError
+     */
+    outputsFrom?: pulumi.Input<string>;
+    /**
+     * The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+     * 				used to find the release asset and executables that are built for another platform are
+     * 				refused. Defaults to the platform of the host
+     */
+    platform?: pulumi.Input<string>;
+    /**
+     * Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that were installed
+     */
+    postInstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+     * 				to the location and version that were removed
+     */
+    postUninstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that will be installed
+     */
+    preInstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+     * 				and PDE_VERSION are set to the location and version that are installed
+     */
+    preUninstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The release version to install. If this is not provided then
+     * 				the resource will try and find the latest release version to install.
+     */
+    releaseVersion?: pulumi.Input<string>;
+    /**
+     * The GitHub repository name
+     */
+    repo: pulumi.Input<string>;
+    /**
+     * Whether to mark stdout, stderr and outputs as secret
+     */
+    secretOutputs?: pulumi.Input<boolean>;
+    /**
+     * Input to write to the stdin of the install, update and uninstall commands. This can be used
+     * 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+     */
+    stdin?: pulumi.Input<string>;
+    /**
+     * Whether to record every path that the install creates in binLocation, $HOME/.local/share
+     * 				and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+     * 				commands while a tracked install runs
+     */
+    trackChanges?: pulumi.Input<boolean>;
+    /**
+     * The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+     * 				set, e.g. ~/.local/share/tool. binLocation is always watched
+     */
+    trackPaths?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Optional Commands to run to uninstall the program
+     */
+    uninstallCommands?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Optional steps to run to uninstall the program. These are run after uninstallCommands.
+     * 				A failing step fails the delete unless it sets continueOnError
+     */
+    uninstallSteps?: pulumi.Input<pulumi.Input<inputs.installers.StepArgs>[]>;
+    /**
+     * Optional Commands to run to update the program
+     */
+    updateCommands?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Optional steps to run to update the program. These are run after updateCommands
+     */
+    updateSteps?: pulumi.Input<pulumi.Input<inputs.installers.StepArgs>[]>;
+    /**
+     * A command to check that the program works after it is installed or updated. If this
+     * 				fails then a create is cleaned up and an update is rolled back
+     */
+    verify?: pulumi.Input<inputs.installers.VerifyArgs>;
+}
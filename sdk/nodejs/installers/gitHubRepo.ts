@@ -0,0 +1,568 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Install a program from a GitHub repository
+ */
+export class GitHubRepo extends pulumi.CustomResource {
+    /**
+     * Get an existing GitHubRepo resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): GitHubRepo {
+        return new GitHubRepo(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'pde:installers:GitHubRepo';
+
+    /**
+     * Returns true if the given object is an instance of GitHubRepo.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is GitHubRepo {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === GitHubRepo.__pulumiType;
+    }
+
+    /**
+     * The absolute path to the folder the repo was cloned to
+     */
+    public /*out*/ readonly absFolderName!: pulumi.Output<string | undefined>;
+    /**
+     * Whether to run the install, update and uninstall commands as root. If the become method
+     * 				needs a password then it is read from the becomePassword provider config
+     */
+    public readonly become!: pulumi.Output<boolean | undefined>;
+    /**
+     * The method to use to become root. Either sudo or doas. Defaults to sudo
+     */
+    public readonly becomeMethod!: pulumi.Output<string | undefined>;
+    /**
+     * The location to link executables into. Defaults to $HOME/.local/bin
+     */
+    public readonly binLocation!: pulumi.Output<string | undefined>;
+    /**
+     * The branch to clone from. Default to main
+     */
+    public readonly branch!: pulumi.Output<string | undefined>;
+    /**
+     * Only fetch this many commits of history. Updates fetch more history if the new version
+     * 				is not in it
+     */
+    public readonly depth!: pulumi.Output<number | undefined>;
+    /**
+     * The environment variables to set when running the commands
+     */
+    public /*out*/ readonly environment!: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * The programs that the install commands build, relative to the clone, e.g.
+     * 				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+     * 				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+     */
+    public readonly executables!: pulumi.Output<string[] | undefined>;
+    /**
+     * Make a partial clone that fetches files as they are needed, either blob:none to leave out
+     * 				file contents or tree:0 to also leave out directories. This needs the git CLI
+     */
+    public readonly filter!: pulumi.Output<string | undefined>;
+    /**
+     * The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+     * 				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+     * 				otherwise $HOME/$REPO
+     */
+    public readonly folderName!: pulumi.Output<string | undefined>;
+    /**
+     * Whether commands inherit the environment of the provider. If this is false then
+     * 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+     * 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+     * 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+     * 				directories. Defaults to true
+     */
+    public readonly inheritEnvironment!: pulumi.Output<boolean | undefined>;
+    /**
+     * The commands to run to install the program. Each command is run as a separate step
+     */
+    public readonly installCommands!: pulumi.Output<string[] | undefined>;
+    /**
+     * The steps to run to install the program. These are run after installCommands
+     */
+    public readonly installSteps!: pulumi.Output<outputs.installers.Step[] | undefined>;
+    /**
+     * The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+     */
+    public /*out*/ readonly interpreter!: pulumi.Output<string[] | undefined>;
+    /**
+     * Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+     */
+    public readonly lfs!: pulumi.Output<boolean | undefined>;
+    /**
+     * The work in the clone that is not on the remote, as of the last refresh
+     */
+    public /*out*/ readonly localChanges!: pulumi.Output<outputs.installers.LocalChanges | undefined>;
+    /**
+     * The links to executables that were created in binLocation
+     */
+    public /*out*/ readonly locations!: pulumi.Output<string[] | undefined>;
+    /**
+     * What to do when an update or delete would lose changed files, unpushed commits or
+     * 				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+     * 				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+     * 				Defaults to fail
+     */
+    public readonly onLocalChanges!: pulumi.Output<string | undefined>;
+    /**
+     * The GitHub organization the repo belongs to. Not needed if url is set
+     */
+    public readonly org!: pulumi.Output<string | undefined>;
+    /**
+     * The outputs parsed from the last install or update. See outputsFrom
+     */
+    public /*out*/ readonly outputs!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * How to create the outputs map from the install and update commands. The only supported
+     * 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+     * 				even if it sets continueOnError
+     */
+    public readonly outputsFrom!: pulumi.Output<string | undefined>;
+    /**
+     * Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that were installed
+     */
+    public readonly postInstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+     * 				to the location and version that were removed
+     */
+    public readonly postUninstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that will be installed
+     */
+    public readonly preInstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+     * 				and PDE_VERSION are set to the location and version that are installed
+     */
+    public readonly preUninstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+     * 				develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+     * 				the highest tag that matches. Prerelease tags are never matched
+     */
+    public readonly ref!: pulumi.Output<string | undefined>;
+    /**
+     * More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+     * 				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+     * 				removed from the clone, remotes that were added by hand are left alone
+     */
+    public readonly remotes!: pulumi.Output<{[key: string]: outputs.installers.GitRemote} | undefined>;
+    /**
+     * The GitHub repository name. Not needed if url is set
+     */
+    public readonly repo!: pulumi.Output<string | undefined>;
+    /**
+     * Leave the clone on disk when the resource is deleted. The uninstall commands
+     * 				are still run
+     */
+    public readonly retainOnDelete!: pulumi.Output<boolean | undefined>;
+    /**
+     * Whether to mark stdout, stderr and outputs as secret
+     */
+    public readonly secretOutputs!: pulumi.Output<boolean | undefined>;
+    /**
+     * Only check out these directories of the repo, along with the files at its root.
+     * 				This needs the git CLI
+     */
+    public readonly sparsePaths!: pulumi.Output<string[] | undefined>;
+    /**
+     * The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+     * 				is used. The host key of the remote must be in known_hosts
+     */
+    public readonly sshKey!: pulumi.Output<string | undefined>;
+    /**
+     * The password of sshKey if it is encrypted
+     */
+    public readonly sshKeyPassword!: pulumi.Output<string | undefined>;
+    /**
+     * The stderr of the last install or update
+     */
+    public /*out*/ readonly stderr!: pulumi.Output<string | undefined>;
+    /**
+     * Input to write to the stdin of the install, update and uninstall commands. This can be used
+     * 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+     */
+    public readonly stdin!: pulumi.Output<string | undefined>;
+    /**
+     * The stdout of the last install or update
+     */
+    public /*out*/ readonly stdout!: pulumi.Output<string | undefined>;
+    /**
+     * The results of the steps that were run by the last install or update
+     */
+    public /*out*/ readonly steps!: pulumi.Output<outputs.installers.StepResult[] | undefined>;
+    /**
+     * How to check out submodules whenever the version changes. One of none, shallow to check
+     * 				out only the commit each submodule points to, or recursive to also check out their submodules.
+     * 				Defaults to none. This needs the git CLI
+     */
+    public readonly submodules!: pulumi.Output<string | undefined>;
+    /**
+     * The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+     */
+    public readonly tag!: pulumi.Output<string | undefined>;
+    /**
+     * The remote whose branches and tags define version, either origin or one of remotes.
+     * 				Defaults to origin
+     */
+    public readonly trackRemote!: pulumi.Output<string | undefined>;
+    /**
+     * Optional Commands to run to uninstall the program
+     */
+    public readonly uninstallCommands!: pulumi.Output<string[] | undefined>;
+    /**
+     * Optional steps to run to uninstall the program. These are run after uninstallCommands.
+     * 				A failing step fails the delete unless it sets continueOnError
+     */
+    public readonly uninstallSteps!: pulumi.Output<outputs.installers.Step[] | undefined>;
+    /**
+     * Optional Commands to run to update the program
+     */
+    public readonly updateCommands!: pulumi.Output<string[] | undefined>;
+    /**
+     * Optional steps to run to update the program. These are run after updateCommands
+     */
+    public readonly updateSteps!: pulumi.Output<outputs.installers.Step[] | undefined>;
+    /**
+     * The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+     * 				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+     */
+    public readonly url!: pulumi.Output<string | undefined>;
+    /**
+     * A command to check that the program works after it is installed or updated. If this
+     * 				fails then a create is cleaned up and an update is rolled back
+     */
+    public readonly verify!: pulumi.Output<outputs.installers.Verify | undefined>;
+    /**
+     * The commit to check out. If this is not set then it is the commit that ref or the head of
+     * 				branch resolves to
+     */
+    public readonly version!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a GitHubRepo resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: GitHubRepoArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            resourceInputs["become"] = args ? args.become : undefined;
+            resourceInputs["becomeMethod"] = args ? args.becomeMethod : undefined;
+            resourceInputs["binLocation"] = args ? args.binLocation : undefined;
+            resourceInputs["branch"] = args ? args.branch : undefined;
+            resourceInputs["depth"] = args ? args.depth : undefined;
+            resourceInputs["executables"] = args ? args.executables : undefined;
+            resourceInputs["filter"] = args ? args.filter : undefined;
+            resourceInputs["folderName"] = args ? args.folderName : undefined;
+            resourceInputs["inheritEnvironment"] = args ? args.inheritEnvironment : undefined;
+            resourceInputs["installCommands"] = args ? args.installCommands : undefined;
+            resourceInputs["installSteps"] = args ? args.installSteps : undefined;
+            resourceInputs["lfs"] = args ? args.lfs : undefined;
+            resourceInputs["onLocalChanges"] = args ? args.onLocalChanges : undefined;
+            resourceInputs["org"] = args ? args.org : undefined;
+            resourceInputs["outputsFrom"] = args ? args.outputsFrom : undefined;
+            resourceInputs["postInstall"] = args ? args.postInstall : undefined;
+            resourceInputs["postUninstall"] = args ? args.postUninstall : undefined;
+            resourceInputs["preInstall"] = args ? args.preInstall : undefined;
+            resourceInputs["preUninstall"] = args ? args.preUninstall : undefined;
+            resourceInputs["ref"] = args ? args.ref : undefined;
+            resourceInputs["remotes"] = args ? args.remotes : undefined;
+            resourceInputs["repo"] = args ? args.repo : undefined;
+            resourceInputs["retainOnDelete"] = args ? args.retainOnDelete : undefined;
+            resourceInputs["secretOutputs"] = args ? args.secretOutputs : undefined;
+            resourceInputs["sparsePaths"] = args ? args.sparsePaths : undefined;
+            resourceInputs["sshKey"] = args ? args.sshKey : undefined;
+            resourceInputs["sshKeyPassword"] = args?.sshKeyPassword ? pulumi.secret(args.sshKeyPassword) : undefined;
+            resourceInputs["stdin"] = args?.stdin ? pulumi.secret(args.stdin) : undefined;
+            resourceInputs["submodules"] = args ? args.submodules : undefined;
+            resourceInputs["tag"] = args ? args.tag : undefined;
+            resourceInputs["trackRemote"] = args ? args.trackRemote : undefined;
+            resourceInputs["uninstallCommands"] = args ? args.uninstallCommands : undefined;
+            resourceInputs["uninstallSteps"] = args ? args.uninstallSteps : undefined;
+            resourceInputs["updateCommands"] = args ? args.updateCommands : undefined;
+            resourceInputs["updateSteps"] = args ? args.updateSteps : undefined;
+            resourceInputs["url"] = args ? args.url : undefined;
+            resourceInputs["verify"] = args ? args.verify : undefined;
+            resourceInputs["version"] = args ? args.version : undefined;
+            resourceInputs["absFolderName"] = undefined /*out*/;
+            resourceInputs["environment"] = undefined /*out*/;
+            resourceInputs["interpreter"] = undefined /*out*/;
+            resourceInputs["localChanges"] = undefined /*out*/;
+            resourceInputs["locations"] = undefined /*out*/;
+            resourceInputs["outputs"] = undefined /*out*/;
+            resourceInputs["stderr"] = undefined /*out*/;
+            resourceInputs["stdout"] = undefined /*out*/;
+            resourceInputs["steps"] = undefined /*out*/;
+        } else {
+            resourceInputs["absFolderName"] = undefined /*out*/;
+            resourceInputs["become"] = undefined /*out*/;
+            resourceInputs["becomeMethod"] = undefined /*out*/;
+            resourceInputs["binLocation"] = undefined /*out*/;
+            resourceInputs["branch"] = undefined /*out*/;
+            resourceInputs["depth"] = undefined /*out*/;
+            resourceInputs["environment"] = undefined /*out*/;
+            resourceInputs["executables"] = undefined /*out*/;
+            resourceInputs["filter"] = undefined /*out*/;
+            resourceInputs["folderName"] = undefined /*out*/;
+            resourceInputs["inheritEnvironment"] = undefined /*out*/;
+            resourceInputs["installCommands"] = undefined /*out*/;
+            resourceInputs["installSteps"] = undefined /*out*/;
+            resourceInputs["interpreter"] = undefined /*out*/;
+            resourceInputs["lfs"] = undefined /*out*/;
+            resourceInputs["localChanges"] = undefined /*out*/;
+            resourceInputs["locations"] = undefined /*out*/;
+            resourceInputs["onLocalChanges"] = undefined /*out*/;
+            resourceInputs["org"] = undefined /*out*/;
+            resourceInputs["outputs"] = undefined /*out*/;
+            resourceInputs["outputsFrom"] = undefined /*out*/;
+            resourceInputs["postInstall"] = undefined /*out*/;
+            resourceInputs["postUninstall"] = undefined /*out*/;
+            resourceInputs["preInstall"] = undefined /*out*/;
+            resourceInputs["preUninstall"] = undefined /*out*/;
+            resourceInputs["ref"] = undefined /*out*/;
+            resourceInputs["remotes"] = undefined /*out*/;
+            resourceInputs["repo"] = undefined /*out*/;
+            resourceInputs["retainOnDelete"] = undefined /*out*/;
+            resourceInputs["secretOutputs"] = undefined /*out*/;
+            resourceInputs["sparsePaths"] = undefined /*out*/;
+            resourceInputs["sshKey"] = undefined /*out*/;
+            resourceInputs["sshKeyPassword"] = undefined /*out*/;
+            resourceInputs["stderr"] = undefined /*out*/;
+            resourceInputs["stdin"] = undefined /*out*/;
+            resourceInputs["stdout"] = undefined /*out*/;
+            resourceInputs["steps"] = undefined /*out*/;
+            resourceInputs["submodules"] = undefined /*out*/;
+            resourceInputs["tag"] = undefined /*out*/;
+            resourceInputs["trackRemote"] = undefined /*out*/;
+            resourceInputs["uninstallCommands"] = undefined /*out*/;
+            resourceInputs["uninstallSteps"] = undefined /*out*/;
+            resourceInputs["updateCommands"] = undefined /*out*/;
+            resourceInputs["updateSteps"] = undefined /*out*/;
+            resourceInputs["url"] = undefined /*out*/;
+            resourceInputs["verify"] = undefined /*out*/;
+            resourceInputs["version"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["sshKeyPassword", "stdin"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(GitHubRepo.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a GitHubRepo resource.
+ */
+export interface GitHubRepoArgs {
+    /**
+     * Whether to run the install, update and uninstall commands as root. If the become method
+     * 				needs a password then it is read from the becomePassword provider config
+     */
+    become?: pulumi.Input<boolean>;
+    /**
+     * The method to use to become root. Either sudo or doas. Defaults to sudo
+     */
+    becomeMethod?: pulumi.Input<string>;
+    /**
+     * The location to link executables into. Defaults to $HOME/.local/bin
+     */
+    binLocation?: pulumi.Input<string>;
+    /**
+     * The branch to clone from. Default to main
+     */
+    branch?: pulumi.Input<string>;
+    /**
+     * Only fetch this many commits of history. Updates fetch more history if the new version
+     * 				is not in it
+     */
+    depth?: pulumi.Input<number>;
+    /**
+     * The programs that the install commands build, relative to the clone, e.g.
+     * 				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+     * 				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+     */
+    executables?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Make a partial clone that fetches files as they are needed, either blob:none to leave out
+     * 				file contents or tree:0 to also leave out directories. This needs the git CLI
+     */
+    filter?: pulumi.Input<string>;
+    /**
+     * The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+     * 				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+     * 				otherwise $HOME/$REPO
+     */
+    folderName?: pulumi.Input<string>;
+    /**
+     * Whether commands inherit the environment of the provider. If this is false then
+     * 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+     * 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+     * 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+     * 				directories. Defaults to true
+     */
+    inheritEnvironment?: pulumi.Input<boolean>;
+    /**
+     * The commands to run to install the program. Each command is run as a separate step
+     */
+    installCommands?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The steps to run to install the program. These are run after installCommands
+     */
+    installSteps?: pulumi.Input<pulumi.Input<inputs.installers.StepArgs>[]>;
+    /**
+     * Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+     */
+    lfs?: pulumi.Input<boolean>;
+    /**
+     * What to do when an update or delete would lose changed files, unpushed commits or
+     * 				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+     * 				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+     * 				Defaults to fail
+     */
+    onLocalChanges?: pulumi.Input<string>;
+    /**
+     * The GitHub organization the repo belongs to. Not needed if url is set
+     */
+    org?: pulumi.Input<string>;
+    /**
+     * How to create the outputs map from the install and update commands. The only supported
+     * 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+     * 				even if it sets continueOnError
+     */
+    outputsFrom?: pulumi.Input<string>;
+    /**
+     * Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that were installed
+     */
+    postInstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+     * 				to the location and version that were removed
+     */
+    postUninstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that will be installed
+     */
+    preInstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+     * 				and PDE_VERSION are set to the location and version that are installed
+     */
+    preUninstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+     * 				develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+     * 				the highest tag that matches. Prerelease tags are never matched
+     */
+    ref?: pulumi.Input<string>;
+    /**
+     * More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+     * 				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+     * 				removed from the clone, remotes that were added by hand are left alone
+     */
+    remotes?: pulumi.Input<{[key: string]: pulumi.Input<inputs.installers.GitRemoteArgs>}>;
+    /**
+     * The GitHub repository name. Not needed if url is set
+     */
+    repo?: pulumi.Input<string>;
+    /**
+     * Leave the clone on disk when the resource is deleted. The uninstall commands
+     * 				are still run
+     */
+    retainOnDelete?: pulumi.Input<boolean>;
+    /**
+     * Whether to mark stdout, stderr and outputs as secret
+     */
+    secretOutputs?: pulumi.Input<boolean>;
+    /**
+     * Only check out these directories of the repo, along with the files at its root.
+     * 				This needs the git CLI
+     */
+    sparsePaths?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+     * 				is used. The host key of the remote must be in known_hosts
+     */
+    sshKey?: pulumi.Input<string>;
+    /**
+     * The password of sshKey if it is encrypted
+     */
+    sshKeyPassword?: pulumi.Input<string>;
+    /**
+     * Input to write to the stdin of the install, update and uninstall commands. This can be used
+     * 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+     */
+    stdin?: pulumi.Input<string>;
+    /**
+     * How to check out submodules whenever the version changes. One of none, shallow to check
+     * 				out only the commit each submodule points to, or recursive to also check out their submodules.
+     * 				Defaults to none. This needs the git CLI
+     */
+    submodules?: pulumi.Input<string>;
+    /**
+     * The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+     */
+    tag?: pulumi.Input<string>;
+    /**
+     * The remote whose branches and tags define version, either origin or one of remotes.
+     * 				Defaults to origin
+     */
+    trackRemote?: pulumi.Input<string>;
+    /**
+     * Optional Commands to run to uninstall the program
+     */
+    uninstallCommands?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Optional steps to run to uninstall the program. These are run after uninstallCommands.
+     * 				A failing step fails the delete unless it sets continueOnError
+     */
+    uninstallSteps?: pulumi.Input<pulumi.Input<inputs.installers.StepArgs>[]>;
+    /**
+     * Optional Commands to run to update the program
+     */
+    updateCommands?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Optional steps to run to update the program. These are run after updateCommands
+     */
+    updateSteps?: pulumi.Input<pulumi.Input<inputs.installers.StepArgs>[]>;
+    /**
+     * The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+     * 				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+     */
+    url?: pulumi.Input<string>;
+    /**
+     * A command to check that the program works after it is installed or updated. If this
+     * 				fails then a create is cleaned up and an update is rolled back
+     */
+    verify?: pulumi.Input<inputs.installers.VerifyArgs>;
+    /**
+     * The commit to check out. If this is not set then it is the commit that ref or the head of
+     * 				branch resolves to
+     */
+    version?: pulumi.Input<string>;
+}
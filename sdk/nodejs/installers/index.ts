@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+// Export members:
+export { GitHubReleaseArgs } from "./gitHubRelease";
+export type GitHubRelease = import("./gitHubRelease").GitHubRelease;
+export const GitHubRelease: typeof import("./gitHubRelease").GitHubRelease = null as any;
+utilities.lazyLoad(exports, ["GitHubRelease"], () => require("./gitHubRelease"));
+
+export { GitHubRepoArgs } from "./gitHubRepo";
+export type GitHubRepo = import("./gitHubRepo").GitHubRepo;
+export const GitHubRepo: typeof import("./gitHubRepo").GitHubRepo = null as any;
+utilities.lazyLoad(exports, ["GitHubRepo"], () => require("./gitHubRepo"));
+
+export { NpmArgs } from "./npm";
+export type Npm = import("./npm").Npm;
+export const Npm: typeof import("./npm").Npm = null as any;
+utilities.lazyLoad(exports, ["Npm"], () => require("./npm"));
+
+export { ScriptArgs } from "./script";
+export type Script = import("./script").Script;
+export const Script: typeof import("./script").Script = null as any;
+utilities.lazyLoad(exports, ["Script"], () => require("./script"));
+
+export { ShellArgs } from "./shell";
+export type Shell = import("./shell").Shell;
+export const Shell: typeof import("./shell").Shell = null as any;
+utilities.lazyLoad(exports, ["Shell"], () => require("./shell"));
+
+
+const _module = {
+    version: utilities.getVersion(),
+    construct: (name: string, type: string, urn: string): pulumi.Resource => {
+        switch (type) {
+            case "pde:installers:GitHubRelease":
+                return new GitHubRelease(name, <any>undefined, { urn })
+            case "pde:installers:GitHubRepo":
+                return new GitHubRepo(name, <any>undefined, { urn })
+            case "pde:installers:Npm":
+                return new Npm(name, <any>undefined, { urn })
+            case "pde:installers:Script":
+                return new Script(name, <any>undefined, { urn })
+            case "pde:installers:Shell":
+                return new Shell(name, <any>undefined, { urn })
+            default:
+                throw new Error(`unknown resource type ${type}`);
+        }
+    },
+};
+pulumi.runtime.registerResourceModule("pde", "installers", _module)
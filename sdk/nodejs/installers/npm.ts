@@ -0,0 +1,318 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Install global npm packages.
+ *
+ * This resource will create a local node project at the location you specify
+ * and will then symlink the node_modules/.bin directory so that all the executables
+ * are available globally.
+ */
+export class Npm extends pulumi.CustomResource {
+    /**
+     * Get an existing Npm resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Npm {
+        return new Npm(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'pde:installers:Npm';
+
+    /**
+     * Returns true if the given object is an instance of Npm.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Npm {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Npm.__pulumiType;
+    }
+
+    /**
+     * Whether to run the install, update and uninstall commands as root. If the become method
+     * 				needs a password then it is read from the becomePassword provider config
+     */
+    public readonly become!: pulumi.Output<boolean | undefined>;
+    /**
+     * The method to use to become root. Either sudo or doas. Defaults to sudo
+     */
+    public readonly becomeMethod!: pulumi.Output<string | undefined>;
+    /**
+     * The environment variables to set when running the commands
+     */
+    public /*out*/ readonly environment!: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * Whether commands inherit the environment of the provider. If this is false then
+     * 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+     * 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+     * 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+     * 				directories. Defaults to true
+     */
+    public readonly inheritEnvironment!: pulumi.Output<boolean | undefined>;
+    /**
+     * The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+     */
+    public /*out*/ readonly interpreter!: pulumi.Output<string[] | undefined>;
+    /**
+     * The location of the node project
+     */
+    public readonly location!: pulumi.Output<string>;
+    /**
+     * The outputs parsed from the last install or update. See outputsFrom
+     */
+    public /*out*/ readonly outputs!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * How to create the outputs map from the install and update commands. The only supported
+     * 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+     * 				even if it sets continueOnError
+     */
+    public readonly outputsFrom!: pulumi.Output<string | undefined>;
+    /**
+     * The npm package to install
+     */
+    public readonly package!: pulumi.Output<string>;
+    /**
+     * Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that were installed
+     */
+    public readonly postInstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+     * 				to the location and version that were removed
+     */
+    public readonly postUninstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that will be installed
+     */
+    public readonly preInstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+     * 				and PDE_VERSION are set to the location and version that are installed
+     */
+    public readonly preUninstall!: pulumi.Output<string[] | undefined>;
+    /**
+     * Whether to mark stdout, stderr and outputs as secret
+     */
+    public readonly secretOutputs!: pulumi.Output<boolean | undefined>;
+    /**
+     * The stderr of the last install or update
+     */
+    public /*out*/ readonly stderr!: pulumi.Output<string | undefined>;
+    /**
+     * Input to write to the stdin of the install, update and uninstall commands. This can be used
+     * 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+     */
+    public readonly stdin!: pulumi.Output<string | undefined>;
+    /**
+     * The stdout of the last install or update
+     */
+    public /*out*/ readonly stdout!: pulumi.Output<string | undefined>;
+    /**
+     * The results of the steps that were run by the last install or update
+     */
+    public /*out*/ readonly steps!: pulumi.Output<outputs.installers.StepResult[] | undefined>;
+    /**
+     * Optional Commands to run to uninstall the program
+     */
+    public readonly uninstallCommands!: pulumi.Output<string[] | undefined>;
+    /**
+     * Optional steps to run to uninstall the program. These are run after uninstallCommands.
+     * 				A failing step fails the delete unless it sets continueOnError
+     */
+    public readonly uninstallSteps!: pulumi.Output<outputs.installers.Step[] | undefined>;
+    /**
+     * Optional Commands to run to update the program
+     */
+    public readonly updateCommands!: pulumi.Output<string[] | undefined>;
+    /**
+     * Optional steps to run to update the program. These are run after updateCommands
+     */
+    public readonly updateSteps!: pulumi.Output<outputs.installers.Step[] | undefined>;
+    /**
+     * A command to check that the program works after it is installed or updated. If this
+     * 				fails then a create is cleaned up and an update is rolled back
+     */
+    public readonly verify!: pulumi.Output<outputs.installers.Verify | undefined>;
+    /**
+     * The version of the package to install
+     */
+    public readonly version!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a Npm resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: NpmArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.location === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'location'");
+            }
+            if ((!args || args.package === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'package'");
+            }
+            resourceInputs["become"] = args ? args.become : undefined;
+            resourceInputs["becomeMethod"] = args ? args.becomeMethod : undefined;
+            resourceInputs["inheritEnvironment"] = args ? args.inheritEnvironment : undefined;
+            resourceInputs["location"] = args ? args.location : undefined;
+            resourceInputs["outputsFrom"] = args ? args.outputsFrom : undefined;
+            resourceInputs["package"] = args ? args.package : undefined;
+            resourceInputs["postInstall"] = args ? args.postInstall : undefined;
+            resourceInputs["postUninstall"] = args ? args.postUninstall : undefined;
+            resourceInputs["preInstall"] = args ? args.preInstall : undefined;
+            resourceInputs["preUninstall"] = args ? args.preUninstall : undefined;
+            resourceInputs["secretOutputs"] = args ? args.secretOutputs : undefined;
+            resourceInputs["stdin"] = args?.stdin ? pulumi.secret(args.stdin) : undefined;
+            resourceInputs["uninstallCommands"] = args ? args.uninstallCommands : undefined;
+            resourceInputs["uninstallSteps"] = args ? args.uninstallSteps : undefined;
+            resourceInputs["updateCommands"] = args ? args.updateCommands : undefined;
+            resourceInputs["updateSteps"] = args ? args.updateSteps : undefined;
+            resourceInputs["verify"] = args ? args.verify : undefined;
+            resourceInputs["version"] = args ? args.version : undefined;
+            resourceInputs["environment"] = undefined /*out*/;
+            resourceInputs["interpreter"] = undefined /*out*/;
+            resourceInputs["outputs"] = undefined /*out*/;
+            resourceInputs["stderr"] = undefined /*out*/;
+            resourceInputs["stdout"] = undefined /*out*/;
+            resourceInputs["steps"] = undefined /*out*/;
+        } else {
+            resourceInputs["become"] = undefined /*out*/;
+            resourceInputs["becomeMethod"] = undefined /*out*/;
+            resourceInputs["environment"] = undefined /*out*/;
+            resourceInputs["inheritEnvironment"] = undefined /*out*/;
+            resourceInputs["interpreter"] = undefined /*out*/;
+            resourceInputs["location"] = undefined /*out*/;
+            resourceInputs["outputs"] = undefined /*out*/;
+            resourceInputs["outputsFrom"] = undefined /*out*/;
+            resourceInputs["package"] = undefined /*out*/;
+            resourceInputs["postInstall"] = undefined /*out*/;
+            resourceInputs["postUninstall"] = undefined /*out*/;
+            resourceInputs["preInstall"] = undefined /*out*/;
+            resourceInputs["preUninstall"] = undefined /*out*/;
+            resourceInputs["secretOutputs"] = undefined /*out*/;
+            resourceInputs["stderr"] = undefined /*out*/;
+            resourceInputs["stdin"] = undefined /*out*/;
+            resourceInputs["stdout"] = undefined /*out*/;
+            resourceInputs["steps"] = undefined /*out*/;
+            resourceInputs["uninstallCommands"] = undefined /*out*/;
+            resourceInputs["uninstallSteps"] = undefined /*out*/;
+            resourceInputs["updateCommands"] = undefined /*out*/;
+            resourceInputs["updateSteps"] = undefined /*out*/;
+            resourceInputs["verify"] = undefined /*out*/;
+            resourceInputs["version"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["stdin"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(Npm.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Npm resource.
+ */
+export interface NpmArgs {
+    /**
+     * Whether to run the install, update and uninstall commands as root. If the become method
+     * 				needs a password then it is read from the becomePassword provider config
+     */
+    become?: pulumi.Input<boolean>;
+    /**
+     * The method to use to become root. Either sudo or doas. Defaults to sudo
+     */
+    becomeMethod?: pulumi.Input<string>;
+    /**
+     * Whether commands inherit the environment of the provider. If this is false then
+     * 				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+     * 				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+     * 				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+     * 				directories. Defaults to true
+     */
+    inheritEnvironment?: pulumi.Input<boolean>;
+    /**
+     * The location of the node project
+     */
+    location: pulumi.Input<string>;
+    /**
+     * How to create the outputs map from the install and update commands. The only supported
+     * 				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+     * 				even if it sets continueOnError
+     */
+    outputsFrom?: pulumi.Input<string>;
+    /**
+     * The npm package to install
+     */
+    package: pulumi.Input<string>;
+    /**
+     * Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that were installed
+     */
+    postInstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+     * 				to the location and version that were removed
+     */
+    postUninstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+     * 				are set to the location and version that will be installed
+     */
+    preInstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+     * 				and PDE_VERSION are set to the location and version that are installed
+     */
+    preUninstall?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Whether to mark stdout, stderr and outputs as secret
+     */
+    secretOutputs?: pulumi.Input<boolean>;
+    /**
+     * Input to write to the stdin of the install, update and uninstall commands. This can be used
+     * 				to answer the prompts of interactive installers, e.g. "y\n" to accept a license
+     */
+    stdin?: pulumi.Input<string>;
+    /**
+     * Optional Commands to run to uninstall the program
+     */
+    uninstallCommands?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Optional steps to run to uninstall the program. These are run after uninstallCommands.
+     * 				A failing step fails the delete unless it sets continueOnError
+     */
+    uninstallSteps?: pulumi.Input<pulumi.Input<inputs.installers.StepArgs>[]>;
+    /**
+     * Optional Commands to run to update the program
+     */
+    updateCommands?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Optional steps to run to update the program. These are run after updateCommands
+     */
+    updateSteps?: pulumi.Input<pulumi.Input<inputs.installers.StepArgs>[]>;
+    /**
+     * A command to check that the program works after it is installed or updated. If this
+     * 				fails then a create is cleaned up and an update is rolled back
+     */
+    verify?: pulumi.Input<inputs.installers.VerifyArgs>;
+    /**
+     * The version of the package to install
+     */
+    version?: pulumi.Input<string>;
+}
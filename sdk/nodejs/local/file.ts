@@ -0,0 +1,96 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A file projected into a pulumi resource
+ */
+export class File extends pulumi.CustomResource {
+    /**
+     * Get an existing File resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): File {
+        return new File(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'pde:local:File';
+
+    /**
+     * Returns true if the given object is an instance of File.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is File {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === File.__pulumiType;
+    }
+
+    /**
+     * The content of the file.
+     */
+    public readonly content!: pulumi.Output<string[]>;
+    /**
+     * If an already existing file should be deleted if it exists.
+     */
+    public readonly force!: pulumi.Output<boolean>;
+    /**
+     * The path of the file.
+     */
+    public readonly path!: pulumi.Output<string>;
+
+    /**
+     * Create a File resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: FileArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.content === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'content'");
+            }
+            if ((!args || args.path === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'path'");
+            }
+            resourceInputs["content"] = args ? args.content : undefined;
+            resourceInputs["force"] = args ? args.force : undefined;
+            resourceInputs["path"] = args ? args.path : undefined;
+        } else {
+            resourceInputs["content"] = undefined /*out*/;
+            resourceInputs["force"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(File.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a File resource.
+ */
+export interface FileArgs {
+    /**
+     * The content of the file.
+     */
+    content: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * If an already existing file should be deleted if it exists.
+     */
+    force?: pulumi.Input<boolean>;
+    /**
+     * The path of the file. This defaults to the name of the pulumi resource.
+     */
+    path: pulumi.Input<string>;
+}
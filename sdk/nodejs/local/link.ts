@@ -0,0 +1,134 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Create a symlink for a file or directory
+ */
+export class Link extends pulumi.CustomResource {
+    /**
+     * Get an existing Link resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Link {
+        return new Link(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'pde:local:Link';
+
+    /**
+     * Returns true if the given object is an instance of Link.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Link {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Link.__pulumiType;
+    }
+
+    /**
+     * Whether the source is a directory
+     */
+    public /*out*/ readonly isDir!: pulumi.Output<boolean>;
+    /**
+     * Whether the symlink has been created
+     */
+    public /*out*/ readonly linked!: pulumi.Output<boolean>;
+    /**
+     * Whether to overwrite the target if it exists
+     */
+    public readonly overwrite!: pulumi.Output<boolean | undefined>;
+    /**
+     * Whether to recursively create links for directories
+     */
+    public readonly recursive!: pulumi.Output<boolean | undefined>;
+    /**
+     * Whether to retain the link if the resource is deleted
+     */
+    public readonly retain!: pulumi.Output<boolean | undefined>;
+    /**
+     * The source file or directory to create a link to
+     */
+    public readonly source!: pulumi.Output<string>;
+    /**
+     * The target file or directory to create a link at
+     */
+    public readonly target!: pulumi.Output<string>;
+    /**
+     * The targets locations of the symlink
+     */
+    public /*out*/ readonly targets!: pulumi.Output<string[]>;
+
+    /**
+     * Create a Link resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: LinkArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.source === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'source'");
+            }
+            if ((!args || args.target === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'target'");
+            }
+            resourceInputs["overwrite"] = args ? args.overwrite : undefined;
+            resourceInputs["recursive"] = args ? args.recursive : undefined;
+            resourceInputs["retain"] = args ? args.retain : undefined;
+            resourceInputs["source"] = args ? args.source : undefined;
+            resourceInputs["target"] = args ? args.target : undefined;
+            resourceInputs["isDir"] = undefined /*out*/;
+            resourceInputs["linked"] = undefined /*out*/;
+            resourceInputs["targets"] = undefined /*out*/;
+        } else {
+            resourceInputs["isDir"] = undefined /*out*/;
+            resourceInputs["linked"] = undefined /*out*/;
+            resourceInputs["overwrite"] = undefined /*out*/;
+            resourceInputs["recursive"] = undefined /*out*/;
+            resourceInputs["retain"] = undefined /*out*/;
+            resourceInputs["source"] = undefined /*out*/;
+            resourceInputs["target"] = undefined /*out*/;
+            resourceInputs["targets"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(Link.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Link resource.
+ */
+export interface LinkArgs {
+    /**
+     * Whether to overwrite the target if it exists
+     */
+    overwrite?: pulumi.Input<boolean>;
+    /**
+     * Whether to recursively create links for directories
+     */
+    recursive?: pulumi.Input<boolean>;
+    /**
+     * Whether to retain the link if the resource is deleted
+     */
+    retain?: pulumi.Input<boolean>;
+    /**
+     * The source file or directory to create a link to
+     */
+    source: pulumi.Input<string>;
+    /**
+     * The target file or directory to create a link at
+     */
+    target: pulumi.Input<string>;
+}
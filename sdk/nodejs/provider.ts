@@ -0,0 +1,78 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+export class Provider extends pulumi.ProviderResource {
+    /** @internal */
+    public static readonly __pulumiType = 'pde';
+
+    /**
+     * Returns true if the given object is an instance of Provider.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Provider {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === "pulumi:providers:" + Provider.__pulumiType;
+    }
+
+    /**
+     * The password to use when running commands with become. This is
+     * 				passed to the become method through an askpass helper and is never written to state or logs
+     */
+    public readonly becomePassword!: pulumi.Output<string | undefined>;
+    /**
+     * The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+     * 				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+     * 				Defaults to cloning to $HOME/$REPO
+     */
+    public readonly sourceRoot!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a Provider resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: ProviderArgs, opts?: pulumi.ResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        {
+            resourceInputs["becomePassword"] = args?.becomePassword ? pulumi.secret(args.becomePassword) : undefined;
+            resourceInputs["binPaths"] = pulumi.output(args ? args.binPaths : undefined).apply(JSON.stringify);
+            resourceInputs["sourceRoot"] = args ? args.sourceRoot : undefined;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["becomePassword"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(Provider.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Provider resource.
+ */
+export interface ProviderArgs {
+    /**
+     * The password to use when running commands with become. This is
+     * 				passed to the become method through an askpass helper and is never written to state or logs
+     */
+    becomePassword?: pulumi.Input<string>;
+    /**
+     * Directories to put on PATH for commands that don't inherit the environment. They come
+     * 				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+     * 				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+     * 				resources it deletes, so this can be used to always find tools installed by other resources
+     */
+    binPaths?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+     * 				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+     * 				Defaults to cloning to $HOME/$REPO
+     */
+    sourceRoot?: pulumi.Input<string>;
+}
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+
+export namespace installers {
+    export interface GitRemoteArgs {
+        /**
+         * The URL to push to. Defaults to url
+         */
+        pushUrl?: pulumi.Input<string>;
+        /**
+         * The URL to fetch from
+         */
+        url: pulumi.Input<string>;
+    }
+
+    export interface StepArgs {
+        /**
+         * The command to run
+         */
+        command: pulumi.Input<string>;
+        /**
+         * Whether to continue with the next step if this step fails
+         */
+        continueOnError?: pulumi.Input<boolean>;
+        /**
+         * The directory to run the command in. Relative paths are relative to
+         * 				the directory the resource runs its commands in
+         */
+        dir?: pulumi.Input<string>;
+        /**
+         * The name of the step. This is used in logs and errors. Defaults to the command
+         */
+        name?: pulumi.Input<string>;
+    }
+
+    export interface VerifyArgs {
+        /**
+         * The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+         * 				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+         */
+        command: pulumi.Input<string>;
+        /**
+         * A regex that the output of the command must match. Both stdout and stderr are
+         * 				matched since some programs print their version to stderr
+         */
+        expectedOutput?: pulumi.Input<string>;
+    }
+}
@@ -0,0 +1,107 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+
+export namespace installers {
+    export interface GitRemote {
+        /**
+         * The URL to push to. Defaults to url
+         */
+        pushUrl?: string;
+        /**
+         * The URL to fetch from
+         */
+        url: string;
+    }
+
+    export interface LocalChanges {
+        /**
+         * The tracked files that have uncommitted changes
+         */
+        files?: string[];
+        /**
+         * The number of stash entries
+         */
+        stashes: number;
+        /**
+         * The commits on HEAD or a local branch that are not on any remote branch or tag,
+         * 				as the short hash and subject
+         */
+        unpushedCommits?: string[];
+    }
+
+    export interface Plan {
+        /**
+         * The release asset that is installed
+         */
+        asset?: string;
+        /**
+         * The commands that are run, in order
+         */
+        commands: string[];
+        /**
+         * The resolved URL the program is downloaded from
+         */
+        downloadURL?: string;
+        /**
+         * The files that are removed
+         */
+        removes: string[];
+        /**
+         * The files that are written
+         */
+        writes: string[];
+    }
+
+    export interface Step {
+        /**
+         * The command to run
+         */
+        command: string;
+        /**
+         * Whether to continue with the next step if this step fails
+         */
+        continueOnError?: boolean;
+        /**
+         * The directory to run the command in. Relative paths are relative to
+         * 				the directory the resource runs its commands in
+         */
+        dir?: string;
+        /**
+         * The name of the step. This is used in logs and errors. Defaults to the command
+         */
+        name?: string;
+    }
+
+    export interface StepResult {
+        /**
+         * How long the step took to run
+         */
+        duration: string;
+        /**
+         * The exit code of the step. This is -1 if the step could not be started
+         */
+        exitCode: number;
+        /**
+         * The name of the step
+         */
+        name: string;
+    }
+
+    export interface Verify {
+        /**
+         * The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+         * 				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+         */
+        command: string;
+        /**
+         * A regex that the output of the command must match. Both stdout and stderr are
+         * 				matched since some programs print their version to stderr
+         */
+        expectedOutput?: string;
+    }
+
+}
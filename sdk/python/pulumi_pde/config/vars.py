@@ -0,0 +1,44 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+import types
+
+__config__ = pulumi.Config('pde')
+
+
+class _ExportableConfig(types.ModuleType):
+    @property
+    def become_password(self) -> Optional[str]:
+        """
+        The password to use when running commands with become. This is
+        				passed to the become method through an askpass helper and is never written to state or logs
+        """
+        return __config__.get('becomePassword')
+
+    @property
+    def bin_paths(self) -> Optional[str]:
+        """
+        Directories to put on PATH for commands that don't inherit the environment. They come
+        				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+        				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+        				resources it deletes, so this can be used to always find tools installed by other resources
+        """
+        return __config__.get('binPaths')
+
+    @property
+    def source_root(self) -> Optional[str]:
+        """
+        The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+        				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+        				Defaults to cloning to $HOME/$REPO
+        """
+        return __config__.get('sourceRoot')
+
@@ -0,0 +1,14 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+from . import _utilities
+import typing
+# Export this package's modules as members:
+from .git_hub_release import *
+from .git_hub_repo import *
+from .npm import *
+from .script import *
+from .shell import *
+from ._inputs import *
+from . import outputs
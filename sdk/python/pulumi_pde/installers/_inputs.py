@@ -0,0 +1,169 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = [
+    'GitRemoteArgs',
+    'StepArgs',
+    'VerifyArgs',
+]
+
+@pulumi.input_type
+class GitRemoteArgs:
+    def __init__(__self__, *,
+                 url: pulumi.Input[str],
+                 push_url: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] url: The URL to fetch from
+        :param pulumi.Input[str] push_url: The URL to push to. Defaults to url
+        """
+        pulumi.set(__self__, "url", url)
+        if push_url is not None:
+            pulumi.set(__self__, "push_url", push_url)
+
+    @property
+    @pulumi.getter
+    def url(self) -> pulumi.Input[str]:
+        """
+        The URL to fetch from
+        """
+        return pulumi.get(self, "url")
+
+    @url.setter
+    def url(self, value: pulumi.Input[str]):
+        pulumi.set(self, "url", value)
+
+    @property
+    @pulumi.getter(name="pushUrl")
+    def push_url(self) -> Optional[pulumi.Input[str]]:
+        """
+        The URL to push to. Defaults to url
+        """
+        return pulumi.get(self, "push_url")
+
+    @push_url.setter
+    def push_url(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "push_url", value)
+
+
+@pulumi.input_type
+class StepArgs:
+    def __init__(__self__, *,
+                 command: pulumi.Input[str],
+                 continue_on_error: Optional[pulumi.Input[bool]] = None,
+                 dir: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] command: The command to run
+        :param pulumi.Input[bool] continue_on_error: Whether to continue with the next step if this step fails
+        :param pulumi.Input[str] dir: The directory to run the command in. Relative paths are relative to
+               				the directory the resource runs its commands in
+        :param pulumi.Input[str] name: The name of the step. This is used in logs and errors. Defaults to the command
+        """
+        pulumi.set(__self__, "command", command)
+        if continue_on_error is not None:
+            pulumi.set(__self__, "continue_on_error", continue_on_error)
+        if dir is not None:
+            pulumi.set(__self__, "dir", dir)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+
+    @property
+    @pulumi.getter
+    def command(self) -> pulumi.Input[str]:
+        """
+        The command to run
+        """
+        return pulumi.get(self, "command")
+
+    @command.setter
+    def command(self, value: pulumi.Input[str]):
+        pulumi.set(self, "command", value)
+
+    @property
+    @pulumi.getter(name="continueOnError")
+    def continue_on_error(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to continue with the next step if this step fails
+        """
+        return pulumi.get(self, "continue_on_error")
+
+    @continue_on_error.setter
+    def continue_on_error(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "continue_on_error", value)
+
+    @property
+    @pulumi.getter
+    def dir(self) -> Optional[pulumi.Input[str]]:
+        """
+        The directory to run the command in. Relative paths are relative to
+        				the directory the resource runs its commands in
+        """
+        return pulumi.get(self, "dir")
+
+    @dir.setter
+    def dir(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "dir", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the step. This is used in logs and errors. Defaults to the command
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+
+@pulumi.input_type
+class VerifyArgs:
+    def __init__(__self__, *,
+                 command: pulumi.Input[str],
+                 expected_output: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] command: The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+               				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+        :param pulumi.Input[str] expected_output: A regex that the output of the command must match. Both stdout and stderr are
+               				matched since some programs print their version to stderr
+        """
+        pulumi.set(__self__, "command", command)
+        if expected_output is not None:
+            pulumi.set(__self__, "expected_output", expected_output)
+
+    @property
+    @pulumi.getter
+    def command(self) -> pulumi.Input[str]:
+        """
+        The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+        				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+        """
+        return pulumi.get(self, "command")
+
+    @command.setter
+    def command(self, value: pulumi.Input[str]):
+        pulumi.set(self, "command", value)
+
+    @property
+    @pulumi.getter(name="expectedOutput")
+    def expected_output(self) -> Optional[pulumi.Input[str]]:
+        """
+        A regex that the output of the command must match. Both stdout and stderr are
+        				matched since some programs print their version to stderr
+        """
+        return pulumi.get(self, "expected_output")
+
+    @expected_output.setter
+    def expected_output(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "expected_output", value)
+
+
@@ -0,0 +1,1071 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['GitHubReleaseArgs', 'GitHubRelease']
+
+@pulumi.input_type
+class GitHubReleaseArgs:
+    def __init__(__self__, *,
+                 org: pulumi.Input[str],
+                 repo: pulumi.Input[str],
+                 asset_name: Optional[pulumi.Input[str]] = None,
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 bin_folder: Optional[pulumi.Input[str]] = None,
+                 bin_location: Optional[pulumi.Input[str]] = None,
+                 executable: Optional[pulumi.Input[str]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 install_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 install_steps: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 platform: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 release_version: Optional[pulumi.Input[str]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 track_changes: Optional[pulumi.Input[bool]] = None,
+                 track_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]] = None,
+                 verify: Optional[pulumi.Input['VerifyArgs']] = None):
+        """
+        The set of arguments for constructing a GitHubRelease resource.
+        :param pulumi.Input[str] org: The GitHub organization the repo belongs to
+        :param pulumi.Input[str] repo: The GitHub repository name
+        :param pulumi.Input[str] asset_name: The name of the release asset to install. If this is not provided then
+               				the resource will try and find the correct asset name to install. Supports regex
+        :param pulumi.Input[bool] become: Whether to run the install, update and uninstall commands as root. If the become method
+               				needs a password then it is read from the becomePassword provider config
+        :param pulumi.Input[str] become_method: The method to use to become root. Either sudo or doas. Defaults to sudo
+        :param pulumi.Input[str] bin_folder: Sometimes release assets contain a folder containing
+               				program binaries which can just be copied. If that is the case, then provide the
+               				location here. This will copy all files in the directory to the bin_location
+        :param pulumi.Input[str] bin_location: The location to put the program. Defaults to $HOME/.local/bin
+        :param pulumi.Input[str] executable: The name of the executable to create a symlink for. If not provided then the executable name will be the same as the repo name
+        :param pulumi.Input[bool] inherit_environment: Whether commands inherit the environment of the provider. If this is false then
+               				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+               				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+               				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+               				directories. Defaults to true
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] install_commands: The commands to run to install the program. Each command is run as a separate step
+        :param pulumi.Input[Sequence[pulumi.Input['StepArgs']]] install_steps: The steps to run to install the program. These are run after installCommands
+        :param pulumi.Input[str] outputs_from: How to create the outputs map from the install and update commands. The only supported
+               				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+               				even if it sets continueOnError
+        :param pulumi.Input[str] platform: The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+               				used to find the release asset and executables that are built for another platform are
+               				refused. Defaults to the platform of the host
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_install: Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that were installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_uninstall: Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+               				to the location and version that were removed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_install: Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that will be installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_uninstall: Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+               				and PDE_VERSION are set to the location and version that are installed
+        :param pulumi.Input[str] release_version: The release version to install. If this is not provided then
+               				the resource will try and find the latest release version to install.
+        :param pulumi.Input[bool] secret_outputs: Whether to mark stdout, stderr and outputs as secret
+        :param pulumi.Input[str] stdin: Input to write to the stdin of the install, update and uninstall commands. This can be used
+               				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        :param pulumi.Input[bool] track_changes: Whether to record every path that the install creates in binLocation, $HOME/.local/share
+               				and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+               				commands while a tracked install runs
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] track_paths: The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+               				set, e.g. ~/.local/share/tool. binLocation is always watched
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] uninstall_commands: Optional Commands to run to uninstall the program
+        :param pulumi.Input[Sequence[pulumi.Input['StepArgs']]] uninstall_steps: Optional steps to run to uninstall the program. These are run after uninstallCommands.
+               				A failing step fails the delete unless it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] update_commands: Optional Commands to run to update the program
+        :param pulumi.Input[Sequence[pulumi.Input['StepArgs']]] update_steps: Optional steps to run to update the program. These are run after updateCommands
+        :param pulumi.Input['VerifyArgs'] verify: A command to check that the program works after it is installed or updated. If this
+               				fails then a create is cleaned up and an update is rolled back
+        """
+        pulumi.set(__self__, "org", org)
+        pulumi.set(__self__, "repo", repo)
+        if asset_name is not None:
+            pulumi.set(__self__, "asset_name", asset_name)
+        if become is not None:
+            pulumi.set(__self__, "become", become)
+        if become_method is not None:
+            pulumi.set(__self__, "become_method", become_method)
+        if bin_folder is not None:
+            pulumi.set(__self__, "bin_folder", bin_folder)
+        if bin_location is not None:
+            pulumi.set(__self__, "bin_location", bin_location)
+        if executable is not None:
+            pulumi.set(__self__, "executable", executable)
+        if inherit_environment is not None:
+            pulumi.set(__self__, "inherit_environment", inherit_environment)
+        if install_commands is not None:
+            pulumi.set(__self__, "install_commands", install_commands)
+        if install_steps is not None:
+            pulumi.set(__self__, "install_steps", install_steps)
+        if outputs_from is not None:
+            pulumi.set(__self__, "outputs_from", outputs_from)
+        if platform is not None:
+            pulumi.set(__self__, "platform", platform)
+        if post_install is not None:
+            pulumi.set(__self__, "post_install", post_install)
+        if post_uninstall is not None:
+            pulumi.set(__self__, "post_uninstall", post_uninstall)
+        if pre_install is not None:
+            pulumi.set(__self__, "pre_install", pre_install)
+        if pre_uninstall is not None:
+            pulumi.set(__self__, "pre_uninstall", pre_uninstall)
+        if release_version is not None:
+            pulumi.set(__self__, "release_version", release_version)
+        if secret_outputs is not None:
+            pulumi.set(__self__, "secret_outputs", secret_outputs)
+        if stdin is not None:
+            pulumi.set(__self__, "stdin", stdin)
+        if track_changes is not None:
+            pulumi.set(__self__, "track_changes", track_changes)
+        if track_paths is not None:
+            pulumi.set(__self__, "track_paths", track_paths)
+        if uninstall_commands is not None:
+            pulumi.set(__self__, "uninstall_commands", uninstall_commands)
+        if uninstall_steps is not None:
+            pulumi.set(__self__, "uninstall_steps", uninstall_steps)
+        if update_commands is not None:
+            pulumi.set(__self__, "update_commands", update_commands)
+        if update_steps is not None:
+            pulumi.set(__self__, "update_steps", update_steps)
+        if verify is not None:
+            pulumi.set(__self__, "verify", verify)
+
+    @property
+    @pulumi.getter
+    def org(self) -> pulumi.Input[str]:
+        """
+        The GitHub organization the repo belongs to
+        """
+        return pulumi.get(self, "org")
+
+    @org.setter
+    def org(self, value: pulumi.Input[str]):
+        pulumi.set(self, "org", value)
+
+    @property
+    @pulumi.getter
+    def repo(self) -> pulumi.Input[str]:
+        """
+        The GitHub repository name
+        """
+        return pulumi.get(self, "repo")
+
+    @repo.setter
+    def repo(self, value: pulumi.Input[str]):
+        pulumi.set(self, "repo", value)
+
+    @property
+    @pulumi.getter(name="assetName")
+    def asset_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the release asset to install. If this is not provided then
+        				the resource will try and find the correct asset name to install. Supports regex
+        """
+        return pulumi.get(self, "asset_name")
+
+    @asset_name.setter
+    def asset_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "asset_name", value)
+
+    @property
+    @pulumi.getter
+    def become(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to run the install, update and uninstall commands as root. If the become method
+        				needs a password then it is read from the becomePassword provider config
+        """
+        return pulumi.get(self, "become")
+
+    @become.setter
+    def become(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "become", value)
+
+    @property
+    @pulumi.getter(name="becomeMethod")
+    def become_method(self) -> Optional[pulumi.Input[str]]:
+        """
+        The method to use to become root. Either sudo or doas. Defaults to sudo
+        """
+        return pulumi.get(self, "become_method")
+
+    @become_method.setter
+    def become_method(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "become_method", value)
+
+    @property
+    @pulumi.getter(name="binFolder")
+    def bin_folder(self) -> Optional[pulumi.Input[str]]:
+        """
+        Sometimes release assets contain a folder containing
+        				program binaries which can just be copied. If that is the case, then provide the
+        				location here. This will copy all files in the directory to the bin_location
+        """
+        return pulumi.get(self, "bin_folder")
+
+    @bin_folder.setter
+    def bin_folder(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "bin_folder", value)
+
+    @property
+    @pulumi.getter(name="binLocation")
+    def bin_location(self) -> Optional[pulumi.Input[str]]:
+        """
+        The location to put the program. Defaults to $HOME/.local/bin
+        """
+        return pulumi.get(self, "bin_location")
+
+    @bin_location.setter
+    def bin_location(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "bin_location", value)
+
+    @property
+    @pulumi.getter
+    def executable(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the executable to create a symlink for. If not provided then the executable name will be the same as the repo name
+        """
+        return pulumi.get(self, "executable")
+
+    @executable.setter
+    def executable(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "executable", value)
+
+    @property
+    @pulumi.getter(name="inheritEnvironment")
+    def inherit_environment(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether commands inherit the environment of the provider. If this is false then
+        				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        				directories. Defaults to true
+        """
+        return pulumi.get(self, "inherit_environment")
+
+    @inherit_environment.setter
+    def inherit_environment(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "inherit_environment", value)
+
+    @property
+    @pulumi.getter(name="installCommands")
+    def install_commands(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The commands to run to install the program. Each command is run as a separate step
+        """
+        return pulumi.get(self, "install_commands")
+
+    @install_commands.setter
+    def install_commands(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "install_commands", value)
+
+    @property
+    @pulumi.getter(name="installSteps")
+    def install_steps(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]:
+        """
+        The steps to run to install the program. These are run after installCommands
+        """
+        return pulumi.get(self, "install_steps")
+
+    @install_steps.setter
+    def install_steps(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]):
+        pulumi.set(self, "install_steps", value)
+
+    @property
+    @pulumi.getter(name="outputsFrom")
+    def outputs_from(self) -> Optional[pulumi.Input[str]]:
+        """
+        How to create the outputs map from the install and update commands. The only supported
+        				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        				even if it sets continueOnError
+        """
+        return pulumi.get(self, "outputs_from")
+
+    @outputs_from.setter
+    def outputs_from(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "outputs_from", value)
+
+    @property
+    @pulumi.getter
+    def platform(self) -> Optional[pulumi.Input[str]]:
+        """
+        The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+        				used to find the release asset and executables that are built for another platform are
+        				refused. Defaults to the platform of the host
+        """
+        return pulumi.get(self, "platform")
+
+    @platform.setter
+    def platform(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "platform", value)
+
+    @property
+    @pulumi.getter(name="postInstall")
+    def post_install(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that were installed
+        """
+        return pulumi.get(self, "post_install")
+
+    @post_install.setter
+    def post_install(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "post_install", value)
+
+    @property
+    @pulumi.getter(name="postUninstall")
+    def post_uninstall(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        				to the location and version that were removed
+        """
+        return pulumi.get(self, "post_uninstall")
+
+    @post_uninstall.setter
+    def post_uninstall(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "post_uninstall", value)
+
+    @property
+    @pulumi.getter(name="preInstall")
+    def pre_install(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that will be installed
+        """
+        return pulumi.get(self, "pre_install")
+
+    @pre_install.setter
+    def pre_install(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "pre_install", value)
+
+    @property
+    @pulumi.getter(name="preUninstall")
+    def pre_uninstall(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        				and PDE_VERSION are set to the location and version that are installed
+        """
+        return pulumi.get(self, "pre_uninstall")
+
+    @pre_uninstall.setter
+    def pre_uninstall(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "pre_uninstall", value)
+
+    @property
+    @pulumi.getter(name="releaseVersion")
+    def release_version(self) -> Optional[pulumi.Input[str]]:
+        """
+        The release version to install. If this is not provided then
+        				the resource will try and find the latest release version to install.
+        """
+        return pulumi.get(self, "release_version")
+
+    @release_version.setter
+    def release_version(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "release_version", value)
+
+    @property
+    @pulumi.getter(name="secretOutputs")
+    def secret_outputs(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to mark stdout, stderr and outputs as secret
+        """
+        return pulumi.get(self, "secret_outputs")
+
+    @secret_outputs.setter
+    def secret_outputs(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "secret_outputs", value)
+
+    @property
+    @pulumi.getter
+    def stdin(self) -> Optional[pulumi.Input[str]]:
+        """
+        Input to write to the stdin of the install, update and uninstall commands. This can be used
+        				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        """
+        return pulumi.get(self, "stdin")
+
+    @stdin.setter
+    def stdin(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "stdin", value)
+
+    @property
+    @pulumi.getter(name="trackChanges")
+    def track_changes(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to record every path that the install creates in binLocation, $HOME/.local/share
+        				and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+        				commands while a tracked install runs
+        """
+        return pulumi.get(self, "track_changes")
+
+    @track_changes.setter
+    def track_changes(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "track_changes", value)
+
+    @property
+    @pulumi.getter(name="trackPaths")
+    def track_paths(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+        				set, e.g. ~/.local/share/tool. binLocation is always watched
+        """
+        return pulumi.get(self, "track_paths")
+
+    @track_paths.setter
+    def track_paths(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "track_paths", value)
+
+    @property
+    @pulumi.getter(name="uninstallCommands")
+    def uninstall_commands(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Optional Commands to run to uninstall the program
+        """
+        return pulumi.get(self, "uninstall_commands")
+
+    @uninstall_commands.setter
+    def uninstall_commands(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "uninstall_commands", value)
+
+    @property
+    @pulumi.getter(name="uninstallSteps")
+    def uninstall_steps(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]:
+        """
+        Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        				A failing step fails the delete unless it sets continueOnError
+        """
+        return pulumi.get(self, "uninstall_steps")
+
+    @uninstall_steps.setter
+    def uninstall_steps(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]):
+        pulumi.set(self, "uninstall_steps", value)
+
+    @property
+    @pulumi.getter(name="updateCommands")
+    def update_commands(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Optional Commands to run to update the program
+        """
+        return pulumi.get(self, "update_commands")
+
+    @update_commands.setter
+    def update_commands(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "update_commands", value)
+
+    @property
+    @pulumi.getter(name="updateSteps")
+    def update_steps(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]:
+        """
+        Optional steps to run to update the program. These are run after updateCommands
+        """
+        return pulumi.get(self, "update_steps")
+
+    @update_steps.setter
+    def update_steps(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]):
+        pulumi.set(self, "update_steps", value)
+
+    @property
+    @pulumi.getter
+    def verify(self) -> Optional[pulumi.Input['VerifyArgs']]:
+        """
+        A command to check that the program works after it is installed or updated. If this
+        				fails then a create is cleaned up and an update is rolled back
+        """
+        return pulumi.get(self, "verify")
+
+    @verify.setter
+    def verify(self, value: Optional[pulumi.Input['VerifyArgs']]):
+        pulumi.set(self, "verify", value)
+
+
+class GitHubRelease(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 asset_name: Optional[pulumi.Input[str]] = None,
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 bin_folder: Optional[pulumi.Input[str]] = None,
+                 bin_location: Optional[pulumi.Input[str]] = None,
+                 executable: Optional[pulumi.Input[str]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 install_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 install_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 org: Optional[pulumi.Input[str]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 platform: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 release_version: Optional[pulumi.Input[str]] = None,
+                 repo: Optional[pulumi.Input[str]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 track_changes: Optional[pulumi.Input[bool]] = None,
+                 track_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 verify: Optional[pulumi.Input[pulumi.InputType['VerifyArgs']]] = None,
+                 __props__=None):
+        """
+        Install a program from a GitHub release
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] asset_name: The name of the release asset to install. If this is not provided then
+               				the resource will try and find the correct asset name to install. Supports regex
+        :param pulumi.Input[bool] become: Whether to run the install, update and uninstall commands as root. If the become method
+               				needs a password then it is read from the becomePassword provider config
+        :param pulumi.Input[str] become_method: The method to use to become root. Either sudo or doas. Defaults to sudo
+        :param pulumi.Input[str] bin_folder: Sometimes release assets contain a folder containing
+               				program binaries which can just be copied. If that is the case, then provide the
+               				location here. This will copy all files in the directory to the bin_location
+        :param pulumi.Input[str] bin_location: The location to put the program. Defaults to $HOME/.local/bin
+        :param pulumi.Input[str] executable: The name of the executable to create a symlink for. If not provided then the executable name will be the same as the repo name
+        :param pulumi.Input[bool] inherit_environment: Whether commands inherit the environment of the provider. If this is false then
+               				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+               				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+               				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+               				directories. Defaults to true
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] install_commands: The commands to run to install the program. Each command is run as a separate step
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]] install_steps: The steps to run to install the program. These are run after installCommands
+        :param pulumi.Input[str] org: The GitHub organization the repo belongs to
+        :param pulumi.Input[str] outputs_from: How to create the outputs map from the install and update commands. The only supported
+               				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+               				even if it sets continueOnError
+        :param pulumi.Input[str] platform: The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+               				used to find the release asset and executables that are built for another platform are
+               				refused. Defaults to the platform of the host
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_install: Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that were installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_uninstall: Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+               				to the location and version that were removed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_install: Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that will be installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_uninstall: Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+               				and PDE_VERSION are set to the location and version that are installed
+        :param pulumi.Input[str] release_version: The release version to install. If this is not provided then
+               				the resource will try and find the latest release version to install.
+        :param pulumi.Input[str] repo: The GitHub repository name
+        :param pulumi.Input[bool] secret_outputs: Whether to mark stdout, stderr and outputs as secret
+        :param pulumi.Input[str] stdin: Input to write to the stdin of the install, update and uninstall commands. This can be used
+               				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        :param pulumi.Input[bool] track_changes: Whether to record every path that the install creates in binLocation, $HOME/.local/share
+               				and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+               				commands while a tracked install runs
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] track_paths: The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+               				set, e.g. ~/.local/share/tool. binLocation is always watched
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] uninstall_commands: Optional Commands to run to uninstall the program
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]] uninstall_steps: Optional steps to run to uninstall the program. These are run after uninstallCommands.
+               				A failing step fails the delete unless it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] update_commands: Optional Commands to run to update the program
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]] update_steps: Optional steps to run to update the program. These are run after updateCommands
+        :param pulumi.Input[pulumi.InputType['VerifyArgs']] verify: A command to check that the program works after it is installed or updated. If this
+               				fails then a create is cleaned up and an update is rolled back
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: GitHubReleaseArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Install a program from a GitHub release
+
+        :param str resource_name: The name of the resource.
+        :param GitHubReleaseArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(GitHubReleaseArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 asset_name: Optional[pulumi.Input[str]] = None,
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 bin_folder: Optional[pulumi.Input[str]] = None,
+                 bin_location: Optional[pulumi.Input[str]] = None,
+                 executable: Optional[pulumi.Input[str]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 install_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 install_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 org: Optional[pulumi.Input[str]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 platform: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 release_version: Optional[pulumi.Input[str]] = None,
+                 repo: Optional[pulumi.Input[str]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 track_changes: Optional[pulumi.Input[bool]] = None,
+                 track_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 verify: Optional[pulumi.Input[pulumi.InputType['VerifyArgs']]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = GitHubReleaseArgs.__new__(GitHubReleaseArgs)
+
+            __props__.__dict__["asset_name"] = asset_name
+            __props__.__dict__["become"] = become
+            __props__.__dict__["become_method"] = become_method
+            __props__.__dict__["bin_folder"] = bin_folder
+            __props__.__dict__["bin_location"] = bin_location
+            __props__.__dict__["executable"] = executable
+            __props__.__dict__["inherit_environment"] = inherit_environment
+            __props__.__dict__["install_commands"] = install_commands
+            __props__.__dict__["install_steps"] = install_steps
+            if org is None and not opts.urn:
+                raise TypeError("Missing required property 'org'")
+            __props__.__dict__["org"] = org
+            __props__.__dict__["outputs_from"] = outputs_from
+            __props__.__dict__["platform"] = platform
+            __props__.__dict__["post_install"] = post_install
+            __props__.__dict__["post_uninstall"] = post_uninstall
+            __props__.__dict__["pre_install"] = pre_install
+            __props__.__dict__["pre_uninstall"] = pre_uninstall
+            __props__.__dict__["release_version"] = release_version
+            if repo is None and not opts.urn:
+                raise TypeError("Missing required property 'repo'")
+            __props__.__dict__["repo"] = repo
+            __props__.__dict__["secret_outputs"] = secret_outputs
+            __props__.__dict__["stdin"] = None if stdin is None else pulumi.Output.secret(stdin)
+            __props__.__dict__["track_changes"] = track_changes
+            __props__.__dict__["track_paths"] = track_paths
+            __props__.__dict__["uninstall_commands"] = uninstall_commands
+            __props__.__dict__["uninstall_steps"] = uninstall_steps
+            __props__.__dict__["update_commands"] = update_commands
+            __props__.__dict__["update_steps"] = update_steps
+            __props__.__dict__["verify"] = verify
+            __props__.__dict__["created_paths"] = None
+            __props__.__dict__["download_url"] = None
+            __props__.__dict__["environment"] = None
+            __props__.__dict__["interpreter"] = None
+            __props__.__dict__["locations"] = None
+            __props__.__dict__["outputs"] = None
+            __props__.__dict__["plan"] = None
+            __props__.__dict__["stderr"] = None
+            __props__.__dict__["stdout"] = None
+            __props__.__dict__["steps"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["stdin"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(GitHubRelease, __self__).__init__(
+            'pde:installers:GitHubRelease',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'GitHubRelease':
+        """
+        Get an existing GitHubRelease resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = GitHubReleaseArgs.__new__(GitHubReleaseArgs)
+
+        __props__.__dict__["asset_name"] = None
+        __props__.__dict__["become"] = None
+        __props__.__dict__["become_method"] = None
+        __props__.__dict__["bin_folder"] = None
+        __props__.__dict__["bin_location"] = None
+        __props__.__dict__["created_paths"] = None
+        __props__.__dict__["download_url"] = None
+        __props__.__dict__["environment"] = None
+        __props__.__dict__["executable"] = None
+        __props__.__dict__["inherit_environment"] = None
+        __props__.__dict__["install_commands"] = None
+        __props__.__dict__["install_steps"] = None
+        __props__.__dict__["interpreter"] = None
+        __props__.__dict__["locations"] = None
+        __props__.__dict__["org"] = None
+        __props__.__dict__["outputs"] = None
+        __props__.__dict__["outputs_from"] = None
+        __props__.__dict__["plan"] = None
+        __props__.__dict__["platform"] = None
+        __props__.__dict__["post_install"] = None
+        __props__.__dict__["post_uninstall"] = None
+        __props__.__dict__["pre_install"] = None
+        __props__.__dict__["pre_uninstall"] = None
+        __props__.__dict__["release_version"] = None
+        __props__.__dict__["repo"] = None
+        __props__.__dict__["secret_outputs"] = None
+        __props__.__dict__["stderr"] = None
+        __props__.__dict__["stdin"] = None
+        __props__.__dict__["stdout"] = None
+        __props__.__dict__["steps"] = None
+        __props__.__dict__["track_changes"] = None
+        __props__.__dict__["track_paths"] = None
+        __props__.__dict__["uninstall_commands"] = None
+        __props__.__dict__["uninstall_steps"] = None
+        __props__.__dict__["update_commands"] = None
+        __props__.__dict__["update_steps"] = None
+        __props__.__dict__["verify"] = None
+        return GitHubRelease(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="assetName")
+    def asset_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        The name of the release asset to install. If this is not provided then
+        				the resource will try and find the correct asset name to install. Supports regex
+        """
+        return pulumi.get(self, "asset_name")
+
+    @property
+    @pulumi.getter
+    def become(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether to run the install, update and uninstall commands as root. If the become method
+        				needs a password then it is read from the becomePassword provider config
+        """
+        return pulumi.get(self, "become")
+
+    @property
+    @pulumi.getter(name="becomeMethod")
+    def become_method(self) -> pulumi.Output[Optional[str]]:
+        """
+        The method to use to become root. Either sudo or doas. Defaults to sudo
+        """
+        return pulumi.get(self, "become_method")
+
+    @property
+    @pulumi.getter(name="binFolder")
+    def bin_folder(self) -> pulumi.Output[Optional[str]]:
+        """
+        Sometimes release assets contain a folder containing
+        				program binaries which can just be copied. If that is the case, then provide the
+        				location here. This will copy all files in the directory to the bin_location
+        """
+        return pulumi.get(self, "bin_folder")
+
+    @property
+    @pulumi.getter(name="binLocation")
+    def bin_location(self) -> pulumi.Output[Optional[str]]:
+        """
+        The location to put the program. Defaults to $HOME/.local/bin
+        """
+        return pulumi.get(self, "bin_location")
+
+    @property
+    @pulumi.getter(name="createdPaths")
+    def created_paths(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The paths that were created by the install when trackChanges is set
+        """
+        return pulumi.get(self, "created_paths")
+
+    @property
+    @pulumi.getter(name="downloadURL")
+    def download_url(self) -> pulumi.Output[str]:
+        """
+        The URL of the GitHub release asset
+        """
+        return pulumi.get(self, "download_url")
+
+    @property
+    @pulumi.getter
+    def environment(self) -> pulumi.Output[Optional[Mapping[str, str]]]:
+        """
+        The environment variables to set when running the commands
+        """
+        return pulumi.get(self, "environment")
+
+    @property
+    @pulumi.getter
+    def executable(self) -> pulumi.Output[Optional[str]]:
+        """
+        The name of the executable to create a symlink for. If not provided then the executable name will be the same as the repo name
+        """
+        return pulumi.get(self, "executable")
+
+    @property
+    @pulumi.getter(name="inheritEnvironment")
+    def inherit_environment(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether commands inherit the environment of the provider. If this is false then
+        				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        				directories. Defaults to true
+        """
+        return pulumi.get(self, "inherit_environment")
+
+    @property
+    @pulumi.getter(name="installCommands")
+    def install_commands(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The commands to run to install the program. Each command is run as a separate step
+        """
+        return pulumi.get(self, "install_commands")
+
+    @property
+    @pulumi.getter(name="installSteps")
+    def install_steps(self) -> pulumi.Output[Optional[Sequence['outputs.Step']]]:
+        """
+        The steps to run to install the program. These are run after installCommands
+        """
+        return pulumi.get(self, "install_steps")
+
+    @property
+    @pulumi.getter
+    def interpreter(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+        """
+        return pulumi.get(self, "interpreter")
+
+    @property
+    @pulumi.getter
+    def locations(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The locations the program was installed to
+        """
+        return pulumi.get(self, "locations")
+
+    @property
+    @pulumi.getter
+    def org(self) -> pulumi.Output[str]:
+        """
+        The GitHub organization the repo belongs to
+        """
+        return pulumi.get(self, "org")
+
+    @property
+    @pulumi.getter
+    def outputs(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        The outputs parsed from the last install or update. See outputsFrom
+        """
+        return pulumi.get(self, "outputs")
+
+    @property
+    @pulumi.getter(name="outputsFrom")
+    def outputs_from(self) -> pulumi.Output[Optional[str]]:
+        """
+        How to create the outputs map from the install and update commands. The only supported
+        				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        				even if it sets continueOnError
+        """
+        return pulumi.get(self, "outputs_from")
+
+    @property
+    @pulumi.getter
+    def plan(self) -> pulumi.Output[Optional['outputs.Plan']]:
+        """
+        What the last create or update did. During preview this is what it will do
+        """
+        return pulumi.get(self, "plan")
+
+    @property
+    @pulumi.getter
+    def platform(self) -> pulumi.Output[Optional[str]]:
+        """
+        The platform to install the program for in the form os/arch, e.g. linux/arm64. This is
+        				used to find the release asset and executables that are built for another platform are
+        				refused. Defaults to the platform of the host
+        """
+        return pulumi.get(self, "platform")
+
+    @property
+    @pulumi.getter(name="postInstall")
+    def post_install(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that were installed
+        """
+        return pulumi.get(self, "post_install")
+
+    @property
+    @pulumi.getter(name="postUninstall")
+    def post_uninstall(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        				to the location and version that were removed
+        """
+        return pulumi.get(self, "post_uninstall")
+
+    @property
+    @pulumi.getter(name="preInstall")
+    def pre_install(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that will be installed
+        """
+        return pulumi.get(self, "pre_install")
+
+    @property
+    @pulumi.getter(name="preUninstall")
+    def pre_uninstall(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        				and PDE_VERSION are set to the location and version that are installed
+        """
+        return pulumi.get(self, "pre_uninstall")
+
+    @property
+    @pulumi.getter(name="releaseVersion")
+    def release_version(self) -> pulumi.Output[Optional[str]]:
+        """
+        The release version to install. If this is not provided then
+        				the resource will try and find the latest release version to install.
+        """
+        return pulumi.get(self, "release_version")
+
+    @property
+    @pulumi.getter
+    def repo(self) -> pulumi.Output[str]:
+        """
+        The GitHub repository name
+        """
+        return pulumi.get(self, "repo")
+
+    @property
+    @pulumi.getter(name="secretOutputs")
+    def secret_outputs(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether to mark stdout, stderr and outputs as secret
+        """
+        return pulumi.get(self, "secret_outputs")
+
+    @property
+    @pulumi.getter
+    def stderr(self) -> pulumi.Output[Optional[str]]:
+        """
+        The stderr of the last install or update
+        """
+        return pulumi.get(self, "stderr")
+
+    @property
+    @pulumi.getter
+    def stdin(self) -> pulumi.Output[Optional[str]]:
+        """
+        Input to write to the stdin of the install, update and uninstall commands. This can be used
+        				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        """
+        return pulumi.get(self, "stdin")
+
+    @property
+    @pulumi.getter
+    def stdout(self) -> pulumi.Output[Optional[str]]:
+        """
+        The stdout of the last install or update
+        """
+        return pulumi.get(self, "stdout")
+
+    @property
+    @pulumi.getter
+    def steps(self) -> pulumi.Output[Optional[Sequence['outputs.StepResult']]]:
+        """
+        The results of the steps that were run by the last install or update
+        """
+        return pulumi.get(self, "steps")
+
+    @property
+    @pulumi.getter(name="trackChanges")
+    def track_changes(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether to record every path that the install creates in binLocation, $HOME/.local/share
+        				and $HOME/.config so that they are all removed when the resource is deleted. Nothing else runs
+        				commands while a tracked install runs
+        """
+        return pulumi.get(self, "track_changes")
+
+    @property
+    @pulumi.getter(name="trackPaths")
+    def track_paths(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The directories to watch instead of $HOME/.local/share and $HOME/.config when trackChanges is
+        				set, e.g. ~/.local/share/tool. binLocation is always watched
+        """
+        return pulumi.get(self, "track_paths")
+
+    @property
+    @pulumi.getter(name="uninstallCommands")
+    def uninstall_commands(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Optional Commands to run to uninstall the program
+        """
+        return pulumi.get(self, "uninstall_commands")
+
+    @property
+    @pulumi.getter(name="uninstallSteps")
+    def uninstall_steps(self) -> pulumi.Output[Optional[Sequence['outputs.Step']]]:
+        """
+        Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        				A failing step fails the delete unless it sets continueOnError
+        """
+        return pulumi.get(self, "uninstall_steps")
+
+    @property
+    @pulumi.getter(name="updateCommands")
+    def update_commands(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Optional Commands to run to update the program
+        """
+        return pulumi.get(self, "update_commands")
+
+    @property
+    @pulumi.getter(name="updateSteps")
+    def update_steps(self) -> pulumi.Output[Optional[Sequence['outputs.Step']]]:
+        """
+        Optional steps to run to update the program. These are run after updateCommands
+        """
+        return pulumi.get(self, "update_steps")
+
+    @property
+    @pulumi.getter
+    def verify(self) -> pulumi.Output[Optional['outputs.Verify']]:
+        """
+        A command to check that the program works after it is installed or updated. If this
+        				fails then a create is cleaned up and an update is rolled back
+        """
+        return pulumi.get(self, "verify")
+
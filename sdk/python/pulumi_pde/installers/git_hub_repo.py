@@ -0,0 +1,1426 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['GitHubRepoArgs', 'GitHubRepo']
+
+@pulumi.input_type
+class GitHubRepoArgs:
+    def __init__(__self__, *,
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 bin_location: Optional[pulumi.Input[str]] = None,
+                 branch: Optional[pulumi.Input[str]] = None,
+                 depth: Optional[pulumi.Input[int]] = None,
+                 executables: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 filter: Optional[pulumi.Input[str]] = None,
+                 folder_name: Optional[pulumi.Input[str]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 install_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 install_steps: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]] = None,
+                 lfs: Optional[pulumi.Input[bool]] = None,
+                 on_local_changes: Optional[pulumi.Input[str]] = None,
+                 org: Optional[pulumi.Input[str]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ref: Optional[pulumi.Input[str]] = None,
+                 remotes: Optional[pulumi.Input[Mapping[str, pulumi.Input['GitRemoteArgs']]]] = None,
+                 repo: Optional[pulumi.Input[str]] = None,
+                 retain_on_delete: Optional[pulumi.Input[bool]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 sparse_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ssh_key: Optional[pulumi.Input[str]] = None,
+                 ssh_key_password: Optional[pulumi.Input[str]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 submodules: Optional[pulumi.Input[str]] = None,
+                 tag: Optional[pulumi.Input[str]] = None,
+                 track_remote: Optional[pulumi.Input[str]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]] = None,
+                 url: Optional[pulumi.Input[str]] = None,
+                 verify: Optional[pulumi.Input['VerifyArgs']] = None,
+                 version: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a GitHubRepo resource.
+        :param pulumi.Input[bool] become: Whether to run the install, update and uninstall commands as root. If the become method
+               				needs a password then it is read from the becomePassword provider config
+        :param pulumi.Input[str] become_method: The method to use to become root. Either sudo or doas. Defaults to sudo
+        :param pulumi.Input[str] bin_location: The location to link executables into. Defaults to $HOME/.local/bin
+        :param pulumi.Input[str] branch: The branch to clone from. Default to main
+        :param pulumi.Input[int] depth: Only fetch this many commits of history. Updates fetch more history if the new version
+               				is not in it
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] executables: The programs that the install commands build, relative to the clone, e.g.
+               				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+               				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+        :param pulumi.Input[str] filter: Make a partial clone that fetches files as they are needed, either blob:none to leave out
+               				file contents or tree:0 to also leave out directories. This needs the git CLI
+        :param pulumi.Input[str] folder_name: The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+               				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+               				otherwise $HOME/$REPO
+        :param pulumi.Input[bool] inherit_environment: Whether commands inherit the environment of the provider. If this is false then
+               				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+               				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+               				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+               				directories. Defaults to true
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] install_commands: The commands to run to install the program. Each command is run as a separate step
+        :param pulumi.Input[Sequence[pulumi.Input['StepArgs']]] install_steps: The steps to run to install the program. These are run after installCommands
+        :param pulumi.Input[bool] lfs: Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+        :param pulumi.Input[str] on_local_changes: What to do when an update or delete would lose changed files, unpushed commits or
+               				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+               				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+               				Defaults to fail
+        :param pulumi.Input[str] org: The GitHub organization the repo belongs to. Not needed if url is set
+        :param pulumi.Input[str] outputs_from: How to create the outputs map from the install and update commands. The only supported
+               				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+               				even if it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_install: Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that were installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_uninstall: Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+               				to the location and version that were removed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_install: Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that will be installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_uninstall: Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+               				and PDE_VERSION are set to the location and version that are installed
+        :param pulumi.Input[str] ref: The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+               				develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+               				the highest tag that matches. Prerelease tags are never matched
+        :param pulumi.Input[Mapping[str, pulumi.Input['GitRemoteArgs']]] remotes: More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+               				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+               				removed from the clone, remotes that were added by hand are left alone
+        :param pulumi.Input[str] repo: The GitHub repository name. Not needed if url is set
+        :param pulumi.Input[bool] retain_on_delete: Leave the clone on disk when the resource is deleted. The uninstall commands
+               				are still run
+        :param pulumi.Input[bool] secret_outputs: Whether to mark stdout, stderr and outputs as secret
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] sparse_paths: Only check out these directories of the repo, along with the files at its root.
+               				This needs the git CLI
+        :param pulumi.Input[str] ssh_key: The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+               				is used. The host key of the remote must be in known_hosts
+        :param pulumi.Input[str] ssh_key_password: The password of sshKey if it is encrypted
+        :param pulumi.Input[str] stdin: Input to write to the stdin of the install, update and uninstall commands. This can be used
+               				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        :param pulumi.Input[str] submodules: How to check out submodules whenever the version changes. One of none, shallow to check
+               				out only the commit each submodule points to, or recursive to also check out their submodules.
+               				Defaults to none. This needs the git CLI
+        :param pulumi.Input[str] tag: The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+        :param pulumi.Input[str] track_remote: The remote whose branches and tags define version, either origin or one of remotes.
+               				Defaults to origin
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] uninstall_commands: Optional Commands to run to uninstall the program
+        :param pulumi.Input[Sequence[pulumi.Input['StepArgs']]] uninstall_steps: Optional steps to run to uninstall the program. These are run after uninstallCommands.
+               				A failing step fails the delete unless it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] update_commands: Optional Commands to run to update the program
+        :param pulumi.Input[Sequence[pulumi.Input['StepArgs']]] update_steps: Optional steps to run to update the program. These are run after updateCommands
+        :param pulumi.Input[str] url: The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+               				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+        :param pulumi.Input['VerifyArgs'] verify: A command to check that the program works after it is installed or updated. If this
+               				fails then a create is cleaned up and an update is rolled back
+        :param pulumi.Input[str] version: The commit to check out. If this is not set then it is the commit that ref or the head of
+               				branch resolves to
+        """
+        if become is not None:
+            pulumi.set(__self__, "become", become)
+        if become_method is not None:
+            pulumi.set(__self__, "become_method", become_method)
+        if bin_location is not None:
+            pulumi.set(__self__, "bin_location", bin_location)
+        if branch is not None:
+            pulumi.set(__self__, "branch", branch)
+        if depth is not None:
+            pulumi.set(__self__, "depth", depth)
+        if executables is not None:
+            pulumi.set(__self__, "executables", executables)
+        if filter is not None:
+            pulumi.set(__self__, "filter", filter)
+        if folder_name is not None:
+            pulumi.set(__self__, "folder_name", folder_name)
+        if inherit_environment is not None:
+            pulumi.set(__self__, "inherit_environment", inherit_environment)
+        if install_commands is not None:
+            pulumi.set(__self__, "install_commands", install_commands)
+        if install_steps is not None:
+            pulumi.set(__self__, "install_steps", install_steps)
+        if lfs is not None:
+            pulumi.set(__self__, "lfs", lfs)
+        if on_local_changes is not None:
+            pulumi.set(__self__, "on_local_changes", on_local_changes)
+        if org is not None:
+            pulumi.set(__self__, "org", org)
+        if outputs_from is not None:
+            pulumi.set(__self__, "outputs_from", outputs_from)
+        if post_install is not None:
+            pulumi.set(__self__, "post_install", post_install)
+        if post_uninstall is not None:
+            pulumi.set(__self__, "post_uninstall", post_uninstall)
+        if pre_install is not None:
+            pulumi.set(__self__, "pre_install", pre_install)
+        if pre_uninstall is not None:
+            pulumi.set(__self__, "pre_uninstall", pre_uninstall)
+        if ref is not None:
+            pulumi.set(__self__, "ref", ref)
+        if remotes is not None:
+            pulumi.set(__self__, "remotes", remotes)
+        if repo is not None:
+            pulumi.set(__self__, "repo", repo)
+        if retain_on_delete is not None:
+            pulumi.set(__self__, "retain_on_delete", retain_on_delete)
+        if secret_outputs is not None:
+            pulumi.set(__self__, "secret_outputs", secret_outputs)
+        if sparse_paths is not None:
+            pulumi.set(__self__, "sparse_paths", sparse_paths)
+        if ssh_key is not None:
+            pulumi.set(__self__, "ssh_key", ssh_key)
+        if ssh_key_password is not None:
+            pulumi.set(__self__, "ssh_key_password", ssh_key_password)
+        if stdin is not None:
+            pulumi.set(__self__, "stdin", stdin)
+        if submodules is not None:
+            pulumi.set(__self__, "submodules", submodules)
+        if tag is not None:
+            pulumi.set(__self__, "tag", tag)
+        if track_remote is not None:
+            pulumi.set(__self__, "track_remote", track_remote)
+        if uninstall_commands is not None:
+            pulumi.set(__self__, "uninstall_commands", uninstall_commands)
+        if uninstall_steps is not None:
+            pulumi.set(__self__, "uninstall_steps", uninstall_steps)
+        if update_commands is not None:
+            pulumi.set(__self__, "update_commands", update_commands)
+        if update_steps is not None:
+            pulumi.set(__self__, "update_steps", update_steps)
+        if url is not None:
+            pulumi.set(__self__, "url", url)
+        if verify is not None:
+            pulumi.set(__self__, "verify", verify)
+        if version is not None:
+            pulumi.set(__self__, "version", version)
+
+    @property
+    @pulumi.getter
+    def become(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to run the install, update and uninstall commands as root. If the become method
+        				needs a password then it is read from the becomePassword provider config
+        """
+        return pulumi.get(self, "become")
+
+    @become.setter
+    def become(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "become", value)
+
+    @property
+    @pulumi.getter(name="becomeMethod")
+    def become_method(self) -> Optional[pulumi.Input[str]]:
+        """
+        The method to use to become root. Either sudo or doas. Defaults to sudo
+        """
+        return pulumi.get(self, "become_method")
+
+    @become_method.setter
+    def become_method(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "become_method", value)
+
+    @property
+    @pulumi.getter(name="binLocation")
+    def bin_location(self) -> Optional[pulumi.Input[str]]:
+        """
+        The location to link executables into. Defaults to $HOME/.local/bin
+        """
+        return pulumi.get(self, "bin_location")
+
+    @bin_location.setter
+    def bin_location(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "bin_location", value)
+
+    @property
+    @pulumi.getter
+    def branch(self) -> Optional[pulumi.Input[str]]:
+        """
+        The branch to clone from. Default to main
+        """
+        return pulumi.get(self, "branch")
+
+    @branch.setter
+    def branch(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "branch", value)
+
+    @property
+    @pulumi.getter
+    def depth(self) -> Optional[pulumi.Input[int]]:
+        """
+        Only fetch this many commits of history. Updates fetch more history if the new version
+        				is not in it
+        """
+        return pulumi.get(self, "depth")
+
+    @depth.setter
+    def depth(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "depth", value)
+
+    @property
+    @pulumi.getter
+    def executables(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The programs that the install commands build, relative to the clone, e.g.
+        				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+        				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+        """
+        return pulumi.get(self, "executables")
+
+    @executables.setter
+    def executables(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "executables", value)
+
+    @property
+    @pulumi.getter
+    def filter(self) -> Optional[pulumi.Input[str]]:
+        """
+        Make a partial clone that fetches files as they are needed, either blob:none to leave out
+        				file contents or tree:0 to also leave out directories. This needs the git CLI
+        """
+        return pulumi.get(self, "filter")
+
+    @filter.setter
+    def filter(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "filter", value)
+
+    @property
+    @pulumi.getter(name="folderName")
+    def folder_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+        				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+        				otherwise $HOME/$REPO
+        """
+        return pulumi.get(self, "folder_name")
+
+    @folder_name.setter
+    def folder_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "folder_name", value)
+
+    @property
+    @pulumi.getter(name="inheritEnvironment")
+    def inherit_environment(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether commands inherit the environment of the provider. If this is false then
+        				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        				directories. Defaults to true
+        """
+        return pulumi.get(self, "inherit_environment")
+
+    @inherit_environment.setter
+    def inherit_environment(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "inherit_environment", value)
+
+    @property
+    @pulumi.getter(name="installCommands")
+    def install_commands(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The commands to run to install the program. Each command is run as a separate step
+        """
+        return pulumi.get(self, "install_commands")
+
+    @install_commands.setter
+    def install_commands(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "install_commands", value)
+
+    @property
+    @pulumi.getter(name="installSteps")
+    def install_steps(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]:
+        """
+        The steps to run to install the program. These are run after installCommands
+        """
+        return pulumi.get(self, "install_steps")
+
+    @install_steps.setter
+    def install_steps(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]):
+        pulumi.set(self, "install_steps", value)
+
+    @property
+    @pulumi.getter
+    def lfs(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+        """
+        return pulumi.get(self, "lfs")
+
+    @lfs.setter
+    def lfs(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "lfs", value)
+
+    @property
+    @pulumi.getter(name="onLocalChanges")
+    def on_local_changes(self) -> Optional[pulumi.Input[str]]:
+        """
+        What to do when an update or delete would lose changed files, unpushed commits or
+        				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+        				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+        				Defaults to fail
+        """
+        return pulumi.get(self, "on_local_changes")
+
+    @on_local_changes.setter
+    def on_local_changes(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "on_local_changes", value)
+
+    @property
+    @pulumi.getter
+    def org(self) -> Optional[pulumi.Input[str]]:
+        """
+        The GitHub organization the repo belongs to. Not needed if url is set
+        """
+        return pulumi.get(self, "org")
+
+    @org.setter
+    def org(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "org", value)
+
+    @property
+    @pulumi.getter(name="outputsFrom")
+    def outputs_from(self) -> Optional[pulumi.Input[str]]:
+        """
+        How to create the outputs map from the install and update commands. The only supported
+        				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        				even if it sets continueOnError
+        """
+        return pulumi.get(self, "outputs_from")
+
+    @outputs_from.setter
+    def outputs_from(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "outputs_from", value)
+
+    @property
+    @pulumi.getter(name="postInstall")
+    def post_install(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that were installed
+        """
+        return pulumi.get(self, "post_install")
+
+    @post_install.setter
+    def post_install(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "post_install", value)
+
+    @property
+    @pulumi.getter(name="postUninstall")
+    def post_uninstall(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        				to the location and version that were removed
+        """
+        return pulumi.get(self, "post_uninstall")
+
+    @post_uninstall.setter
+    def post_uninstall(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "post_uninstall", value)
+
+    @property
+    @pulumi.getter(name="preInstall")
+    def pre_install(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that will be installed
+        """
+        return pulumi.get(self, "pre_install")
+
+    @pre_install.setter
+    def pre_install(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "pre_install", value)
+
+    @property
+    @pulumi.getter(name="preUninstall")
+    def pre_uninstall(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        				and PDE_VERSION are set to the location and version that are installed
+        """
+        return pulumi.get(self, "pre_uninstall")
+
+    @pre_uninstall.setter
+    def pre_uninstall(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "pre_uninstall", value)
+
+    @property
+    @pulumi.getter
+    def ref(self) -> Optional[pulumi.Input[str]]:
+        """
+        The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+        				develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+        				the highest tag that matches. Prerelease tags are never matched
+        """
+        return pulumi.get(self, "ref")
+
+    @ref.setter
+    def ref(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "ref", value)
+
+    @property
+    @pulumi.getter
+    def remotes(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input['GitRemoteArgs']]]]:
+        """
+        More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+        				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+        				removed from the clone, remotes that were added by hand are left alone
+        """
+        return pulumi.get(self, "remotes")
+
+    @remotes.setter
+    def remotes(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input['GitRemoteArgs']]]]):
+        pulumi.set(self, "remotes", value)
+
+    @property
+    @pulumi.getter
+    def repo(self) -> Optional[pulumi.Input[str]]:
+        """
+        The GitHub repository name. Not needed if url is set
+        """
+        return pulumi.get(self, "repo")
+
+    @repo.setter
+    def repo(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "repo", value)
+
+    @property
+    @pulumi.getter(name="retainOnDelete")
+    def retain_on_delete(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Leave the clone on disk when the resource is deleted. The uninstall commands
+        				are still run
+        """
+        return pulumi.get(self, "retain_on_delete")
+
+    @retain_on_delete.setter
+    def retain_on_delete(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "retain_on_delete", value)
+
+    @property
+    @pulumi.getter(name="secretOutputs")
+    def secret_outputs(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to mark stdout, stderr and outputs as secret
+        """
+        return pulumi.get(self, "secret_outputs")
+
+    @secret_outputs.setter
+    def secret_outputs(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "secret_outputs", value)
+
+    @property
+    @pulumi.getter(name="sparsePaths")
+    def sparse_paths(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Only check out these directories of the repo, along with the files at its root.
+        				This needs the git CLI
+        """
+        return pulumi.get(self, "sparse_paths")
+
+    @sparse_paths.setter
+    def sparse_paths(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "sparse_paths", value)
+
+    @property
+    @pulumi.getter(name="sshKey")
+    def ssh_key(self) -> Optional[pulumi.Input[str]]:
+        """
+        The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+        				is used. The host key of the remote must be in known_hosts
+        """
+        return pulumi.get(self, "ssh_key")
+
+    @ssh_key.setter
+    def ssh_key(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "ssh_key", value)
+
+    @property
+    @pulumi.getter(name="sshKeyPassword")
+    def ssh_key_password(self) -> Optional[pulumi.Input[str]]:
+        """
+        The password of sshKey if it is encrypted
+        """
+        return pulumi.get(self, "ssh_key_password")
+
+    @ssh_key_password.setter
+    def ssh_key_password(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "ssh_key_password", value)
+
+    @property
+    @pulumi.getter
+    def stdin(self) -> Optional[pulumi.Input[str]]:
+        """
+        Input to write to the stdin of the install, update and uninstall commands. This can be used
+        				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        """
+        return pulumi.get(self, "stdin")
+
+    @stdin.setter
+    def stdin(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "stdin", value)
+
+    @property
+    @pulumi.getter
+    def submodules(self) -> Optional[pulumi.Input[str]]:
+        """
+        How to check out submodules whenever the version changes. One of none, shallow to check
+        				out only the commit each submodule points to, or recursive to also check out their submodules.
+        				Defaults to none. This needs the git CLI
+        """
+        return pulumi.get(self, "submodules")
+
+    @submodules.setter
+    def submodules(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "submodules", value)
+
+    @property
+    @pulumi.getter
+    def tag(self) -> Optional[pulumi.Input[str]]:
+        """
+        The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+        """
+        return pulumi.get(self, "tag")
+
+    @tag.setter
+    def tag(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "tag", value)
+
+    @property
+    @pulumi.getter(name="trackRemote")
+    def track_remote(self) -> Optional[pulumi.Input[str]]:
+        """
+        The remote whose branches and tags define version, either origin or one of remotes.
+        				Defaults to origin
+        """
+        return pulumi.get(self, "track_remote")
+
+    @track_remote.setter
+    def track_remote(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "track_remote", value)
+
+    @property
+    @pulumi.getter(name="uninstallCommands")
+    def uninstall_commands(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Optional Commands to run to uninstall the program
+        """
+        return pulumi.get(self, "uninstall_commands")
+
+    @uninstall_commands.setter
+    def uninstall_commands(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "uninstall_commands", value)
+
+    @property
+    @pulumi.getter(name="uninstallSteps")
+    def uninstall_steps(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]:
+        """
+        Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        				A failing step fails the delete unless it sets continueOnError
+        """
+        return pulumi.get(self, "uninstall_steps")
+
+    @uninstall_steps.setter
+    def uninstall_steps(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]):
+        pulumi.set(self, "uninstall_steps", value)
+
+    @property
+    @pulumi.getter(name="updateCommands")
+    def update_commands(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Optional Commands to run to update the program
+        """
+        return pulumi.get(self, "update_commands")
+
+    @update_commands.setter
+    def update_commands(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "update_commands", value)
+
+    @property
+    @pulumi.getter(name="updateSteps")
+    def update_steps(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]:
+        """
+        Optional steps to run to update the program. These are run after updateCommands
+        """
+        return pulumi.get(self, "update_steps")
+
+    @update_steps.setter
+    def update_steps(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]):
+        pulumi.set(self, "update_steps", value)
+
+    @property
+    @pulumi.getter
+    def url(self) -> Optional[pulumi.Input[str]]:
+        """
+        The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+        				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+        """
+        return pulumi.get(self, "url")
+
+    @url.setter
+    def url(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "url", value)
+
+    @property
+    @pulumi.getter
+    def verify(self) -> Optional[pulumi.Input['VerifyArgs']]:
+        """
+        A command to check that the program works after it is installed or updated. If this
+        				fails then a create is cleaned up and an update is rolled back
+        """
+        return pulumi.get(self, "verify")
+
+    @verify.setter
+    def verify(self, value: Optional[pulumi.Input['VerifyArgs']]):
+        pulumi.set(self, "verify", value)
+
+    @property
+    @pulumi.getter
+    def version(self) -> Optional[pulumi.Input[str]]:
+        """
+        The commit to check out. If this is not set then it is the commit that ref or the head of
+        				branch resolves to
+        """
+        return pulumi.get(self, "version")
+
+    @version.setter
+    def version(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "version", value)
+
+
+class GitHubRepo(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 bin_location: Optional[pulumi.Input[str]] = None,
+                 branch: Optional[pulumi.Input[str]] = None,
+                 depth: Optional[pulumi.Input[int]] = None,
+                 executables: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 filter: Optional[pulumi.Input[str]] = None,
+                 folder_name: Optional[pulumi.Input[str]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 install_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 install_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 lfs: Optional[pulumi.Input[bool]] = None,
+                 on_local_changes: Optional[pulumi.Input[str]] = None,
+                 org: Optional[pulumi.Input[str]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ref: Optional[pulumi.Input[str]] = None,
+                 remotes: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['GitRemoteArgs']]]]] = None,
+                 repo: Optional[pulumi.Input[str]] = None,
+                 retain_on_delete: Optional[pulumi.Input[bool]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 sparse_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ssh_key: Optional[pulumi.Input[str]] = None,
+                 ssh_key_password: Optional[pulumi.Input[str]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 submodules: Optional[pulumi.Input[str]] = None,
+                 tag: Optional[pulumi.Input[str]] = None,
+                 track_remote: Optional[pulumi.Input[str]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 url: Optional[pulumi.Input[str]] = None,
+                 verify: Optional[pulumi.Input[pulumi.InputType['VerifyArgs']]] = None,
+                 version: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Install a program from a GitHub repository
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] become: Whether to run the install, update and uninstall commands as root. If the become method
+               				needs a password then it is read from the becomePassword provider config
+        :param pulumi.Input[str] become_method: The method to use to become root. Either sudo or doas. Defaults to sudo
+        :param pulumi.Input[str] bin_location: The location to link executables into. Defaults to $HOME/.local/bin
+        :param pulumi.Input[str] branch: The branch to clone from. Default to main
+        :param pulumi.Input[int] depth: Only fetch this many commits of history. Updates fetch more history if the new version
+               				is not in it
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] executables: The programs that the install commands build, relative to the clone, e.g.
+               				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+               				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+        :param pulumi.Input[str] filter: Make a partial clone that fetches files as they are needed, either blob:none to leave out
+               				file contents or tree:0 to also leave out directories. This needs the git CLI
+        :param pulumi.Input[str] folder_name: The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+               				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+               				otherwise $HOME/$REPO
+        :param pulumi.Input[bool] inherit_environment: Whether commands inherit the environment of the provider. If this is false then
+               				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+               				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+               				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+               				directories. Defaults to true
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] install_commands: The commands to run to install the program. Each command is run as a separate step
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]] install_steps: The steps to run to install the program. These are run after installCommands
+        :param pulumi.Input[bool] lfs: Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+        :param pulumi.Input[str] on_local_changes: What to do when an update or delete would lose changed files, unpushed commits or
+               				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+               				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+               				Defaults to fail
+        :param pulumi.Input[str] org: The GitHub organization the repo belongs to. Not needed if url is set
+        :param pulumi.Input[str] outputs_from: How to create the outputs map from the install and update commands. The only supported
+               				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+               				even if it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_install: Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that were installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_uninstall: Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+               				to the location and version that were removed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_install: Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that will be installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_uninstall: Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+               				and PDE_VERSION are set to the location and version that are installed
+        :param pulumi.Input[str] ref: The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+               				develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+               				the highest tag that matches. Prerelease tags are never matched
+        :param pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['GitRemoteArgs']]]] remotes: More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+               				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+               				removed from the clone, remotes that were added by hand are left alone
+        :param pulumi.Input[str] repo: The GitHub repository name. Not needed if url is set
+        :param pulumi.Input[bool] retain_on_delete: Leave the clone on disk when the resource is deleted. The uninstall commands
+               				are still run
+        :param pulumi.Input[bool] secret_outputs: Whether to mark stdout, stderr and outputs as secret
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] sparse_paths: Only check out these directories of the repo, along with the files at its root.
+               				This needs the git CLI
+        :param pulumi.Input[str] ssh_key: The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+               				is used. The host key of the remote must be in known_hosts
+        :param pulumi.Input[str] ssh_key_password: The password of sshKey if it is encrypted
+        :param pulumi.Input[str] stdin: Input to write to the stdin of the install, update and uninstall commands. This can be used
+               				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        :param pulumi.Input[str] submodules: How to check out submodules whenever the version changes. One of none, shallow to check
+               				out only the commit each submodule points to, or recursive to also check out their submodules.
+               				Defaults to none. This needs the git CLI
+        :param pulumi.Input[str] tag: The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+        :param pulumi.Input[str] track_remote: The remote whose branches and tags define version, either origin or one of remotes.
+               				Defaults to origin
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] uninstall_commands: Optional Commands to run to uninstall the program
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]] uninstall_steps: Optional steps to run to uninstall the program. These are run after uninstallCommands.
+               				A failing step fails the delete unless it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] update_commands: Optional Commands to run to update the program
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]] update_steps: Optional steps to run to update the program. These are run after updateCommands
+        :param pulumi.Input[str] url: The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+               				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+        :param pulumi.Input[pulumi.InputType['VerifyArgs']] verify: A command to check that the program works after it is installed or updated. If this
+               				fails then a create is cleaned up and an update is rolled back
+        :param pulumi.Input[str] version: The commit to check out. If this is not set then it is the commit that ref or the head of
+               				branch resolves to
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[GitHubRepoArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Install a program from a GitHub repository
+
+        :param str resource_name: The name of the resource.
+        :param GitHubRepoArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(GitHubRepoArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 bin_location: Optional[pulumi.Input[str]] = None,
+                 branch: Optional[pulumi.Input[str]] = None,
+                 depth: Optional[pulumi.Input[int]] = None,
+                 executables: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 filter: Optional[pulumi.Input[str]] = None,
+                 folder_name: Optional[pulumi.Input[str]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 install_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 install_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 lfs: Optional[pulumi.Input[bool]] = None,
+                 on_local_changes: Optional[pulumi.Input[str]] = None,
+                 org: Optional[pulumi.Input[str]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ref: Optional[pulumi.Input[str]] = None,
+                 remotes: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['GitRemoteArgs']]]]] = None,
+                 repo: Optional[pulumi.Input[str]] = None,
+                 retain_on_delete: Optional[pulumi.Input[bool]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 sparse_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ssh_key: Optional[pulumi.Input[str]] = None,
+                 ssh_key_password: Optional[pulumi.Input[str]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 submodules: Optional[pulumi.Input[str]] = None,
+                 tag: Optional[pulumi.Input[str]] = None,
+                 track_remote: Optional[pulumi.Input[str]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 url: Optional[pulumi.Input[str]] = None,
+                 verify: Optional[pulumi.Input[pulumi.InputType['VerifyArgs']]] = None,
+                 version: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = GitHubRepoArgs.__new__(GitHubRepoArgs)
+
+            __props__.__dict__["become"] = become
+            __props__.__dict__["become_method"] = become_method
+            __props__.__dict__["bin_location"] = bin_location
+            __props__.__dict__["branch"] = branch
+            __props__.__dict__["depth"] = depth
+            __props__.__dict__["executables"] = executables
+            __props__.__dict__["filter"] = filter
+            __props__.__dict__["folder_name"] = folder_name
+            __props__.__dict__["inherit_environment"] = inherit_environment
+            __props__.__dict__["install_commands"] = install_commands
+            __props__.__dict__["install_steps"] = install_steps
+            __props__.__dict__["lfs"] = lfs
+            __props__.__dict__["on_local_changes"] = on_local_changes
+            __props__.__dict__["org"] = org
+            __props__.__dict__["outputs_from"] = outputs_from
+            __props__.__dict__["post_install"] = post_install
+            __props__.__dict__["post_uninstall"] = post_uninstall
+            __props__.__dict__["pre_install"] = pre_install
+            __props__.__dict__["pre_uninstall"] = pre_uninstall
+            __props__.__dict__["ref"] = ref
+            __props__.__dict__["remotes"] = remotes
+            __props__.__dict__["repo"] = repo
+            __props__.__dict__["retain_on_delete"] = retain_on_delete
+            __props__.__dict__["secret_outputs"] = secret_outputs
+            __props__.__dict__["sparse_paths"] = sparse_paths
+            __props__.__dict__["ssh_key"] = ssh_key
+            __props__.__dict__["ssh_key_password"] = None if ssh_key_password is None else pulumi.Output.secret(ssh_key_password)
+            __props__.__dict__["stdin"] = None if stdin is None else pulumi.Output.secret(stdin)
+            __props__.__dict__["submodules"] = submodules
+            __props__.__dict__["tag"] = tag
+            __props__.__dict__["track_remote"] = track_remote
+            __props__.__dict__["uninstall_commands"] = uninstall_commands
+            __props__.__dict__["uninstall_steps"] = uninstall_steps
+            __props__.__dict__["update_commands"] = update_commands
+            __props__.__dict__["update_steps"] = update_steps
+            __props__.__dict__["url"] = url
+            __props__.__dict__["verify"] = verify
+            __props__.__dict__["version"] = version
+            __props__.__dict__["abs_folder_name"] = None
+            __props__.__dict__["environment"] = None
+            __props__.__dict__["interpreter"] = None
+            __props__.__dict__["local_changes"] = None
+            __props__.__dict__["locations"] = None
+            __props__.__dict__["outputs"] = None
+            __props__.__dict__["stderr"] = None
+            __props__.__dict__["stdout"] = None
+            __props__.__dict__["steps"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["sshKeyPassword", "stdin"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(GitHubRepo, __self__).__init__(
+            'pde:installers:GitHubRepo',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'GitHubRepo':
+        """
+        Get an existing GitHubRepo resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = GitHubRepoArgs.__new__(GitHubRepoArgs)
+
+        __props__.__dict__["abs_folder_name"] = None
+        __props__.__dict__["become"] = None
+        __props__.__dict__["become_method"] = None
+        __props__.__dict__["bin_location"] = None
+        __props__.__dict__["branch"] = None
+        __props__.__dict__["depth"] = None
+        __props__.__dict__["environment"] = None
+        __props__.__dict__["executables"] = None
+        __props__.__dict__["filter"] = None
+        __props__.__dict__["folder_name"] = None
+        __props__.__dict__["inherit_environment"] = None
+        __props__.__dict__["install_commands"] = None
+        __props__.__dict__["install_steps"] = None
+        __props__.__dict__["interpreter"] = None
+        __props__.__dict__["lfs"] = None
+        __props__.__dict__["local_changes"] = None
+        __props__.__dict__["locations"] = None
+        __props__.__dict__["on_local_changes"] = None
+        __props__.__dict__["org"] = None
+        __props__.__dict__["outputs"] = None
+        __props__.__dict__["outputs_from"] = None
+        __props__.__dict__["post_install"] = None
+        __props__.__dict__["post_uninstall"] = None
+        __props__.__dict__["pre_install"] = None
+        __props__.__dict__["pre_uninstall"] = None
+        __props__.__dict__["ref"] = None
+        __props__.__dict__["remotes"] = None
+        __props__.__dict__["repo"] = None
+        __props__.__dict__["retain_on_delete"] = None
+        __props__.__dict__["secret_outputs"] = None
+        __props__.__dict__["sparse_paths"] = None
+        __props__.__dict__["ssh_key"] = None
+        __props__.__dict__["ssh_key_password"] = None
+        __props__.__dict__["stderr"] = None
+        __props__.__dict__["stdin"] = None
+        __props__.__dict__["stdout"] = None
+        __props__.__dict__["steps"] = None
+        __props__.__dict__["submodules"] = None
+        __props__.__dict__["tag"] = None
+        __props__.__dict__["track_remote"] = None
+        __props__.__dict__["uninstall_commands"] = None
+        __props__.__dict__["uninstall_steps"] = None
+        __props__.__dict__["update_commands"] = None
+        __props__.__dict__["update_steps"] = None
+        __props__.__dict__["url"] = None
+        __props__.__dict__["verify"] = None
+        __props__.__dict__["version"] = None
+        return GitHubRepo(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="absFolderName")
+    def abs_folder_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        The absolute path to the folder the repo was cloned to
+        """
+        return pulumi.get(self, "abs_folder_name")
+
+    @property
+    @pulumi.getter
+    def become(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether to run the install, update and uninstall commands as root. If the become method
+        				needs a password then it is read from the becomePassword provider config
+        """
+        return pulumi.get(self, "become")
+
+    @property
+    @pulumi.getter(name="becomeMethod")
+    def become_method(self) -> pulumi.Output[Optional[str]]:
+        """
+        The method to use to become root. Either sudo or doas. Defaults to sudo
+        """
+        return pulumi.get(self, "become_method")
+
+    @property
+    @pulumi.getter(name="binLocation")
+    def bin_location(self) -> pulumi.Output[Optional[str]]:
+        """
+        The location to link executables into. Defaults to $HOME/.local/bin
+        """
+        return pulumi.get(self, "bin_location")
+
+    @property
+    @pulumi.getter
+    def branch(self) -> pulumi.Output[Optional[str]]:
+        """
+        The branch to clone from. Default to main
+        """
+        return pulumi.get(self, "branch")
+
+    @property
+    @pulumi.getter
+    def depth(self) -> pulumi.Output[Optional[int]]:
+        """
+        Only fetch this many commits of history. Updates fetch more history if the new version
+        				is not in it
+        """
+        return pulumi.get(self, "depth")
+
+    @property
+    @pulumi.getter
+    def environment(self) -> pulumi.Output[Optional[Mapping[str, str]]]:
+        """
+        The environment variables to set when running the commands
+        """
+        return pulumi.get(self, "environment")
+
+    @property
+    @pulumi.getter
+    def executables(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The programs that the install commands build, relative to the clone, e.g.
+        				target/release/foo. They are linked into binLocation after each install and update. Binaries that
+        				can't run on the host, e.g. from a cross-compile, are refused before anything is linked
+        """
+        return pulumi.get(self, "executables")
+
+    @property
+    @pulumi.getter
+    def filter(self) -> pulumi.Output[Optional[str]]:
+        """
+        Make a partial clone that fetches files as they are needed, either blob:none to leave out
+        				file contents or tree:0 to also leave out directories. This needs the git CLI
+        """
+        return pulumi.get(self, "filter")
+
+    @property
+    @pulumi.getter(name="folderName")
+    def folder_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        The folder to clone the repo to. This can be an absolute path or start with ~, other paths
+        				are relative to $HOME. Defaults to $SOURCE_ROOT/$HOST/$ORG/$REPO if the provider has a sourceRoot,
+        				otherwise $HOME/$REPO
+        """
+        return pulumi.get(self, "folder_name")
+
+    @property
+    @pulumi.getter(name="inheritEnvironment")
+    def inherit_environment(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether commands inherit the environment of the provider. If this is false then
+        				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        				directories. Defaults to true
+        """
+        return pulumi.get(self, "inherit_environment")
+
+    @property
+    @pulumi.getter(name="installCommands")
+    def install_commands(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The commands to run to install the program. Each command is run as a separate step
+        """
+        return pulumi.get(self, "install_commands")
+
+    @property
+    @pulumi.getter(name="installSteps")
+    def install_steps(self) -> pulumi.Output[Optional[Sequence['outputs.Step']]]:
+        """
+        The steps to run to install the program. These are run after installCommands
+        """
+        return pulumi.get(self, "install_steps")
+
+    @property
+    @pulumi.getter
+    def interpreter(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+        """
+        return pulumi.get(self, "interpreter")
+
+    @property
+    @pulumi.getter
+    def lfs(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Pull the Git LFS files of the repo, and its submodules, whenever the version changes. This needs git-lfs
+        """
+        return pulumi.get(self, "lfs")
+
+    @property
+    @pulumi.getter(name="localChanges")
+    def local_changes(self) -> pulumi.Output[Optional['outputs.LocalChanges']]:
+        """
+        The work in the clone that is not on the remote, as of the last refresh
+        """
+        return pulumi.get(self, "local_changes")
+
+    @property
+    @pulumi.getter
+    def locations(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The links to executables that were created in binLocation
+        """
+        return pulumi.get(self, "locations")
+
+    @property
+    @pulumi.getter(name="onLocalChanges")
+    def on_local_changes(self) -> pulumi.Output[Optional[str]]:
+        """
+        What to do when an update or delete would lose changed files, unpushed commits or
+        				stashes in the clone. One of fail, stash to stash changed files and keep unpushed commits on a
+        				branch before an update, which needs the git CLI, or discard. Deletes fail when this is stash.
+        				Defaults to fail
+        """
+        return pulumi.get(self, "on_local_changes")
+
+    @property
+    @pulumi.getter
+    def org(self) -> pulumi.Output[Optional[str]]:
+        """
+        The GitHub organization the repo belongs to. Not needed if url is set
+        """
+        return pulumi.get(self, "org")
+
+    @property
+    @pulumi.getter
+    def outputs(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        The outputs parsed from the last install or update. See outputsFrom
+        """
+        return pulumi.get(self, "outputs")
+
+    @property
+    @pulumi.getter(name="outputsFrom")
+    def outputs_from(self) -> pulumi.Output[Optional[str]]:
+        """
+        How to create the outputs map from the install and update commands. The only supported
+        				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        				even if it sets continueOnError
+        """
+        return pulumi.get(self, "outputs_from")
+
+    @property
+    @pulumi.getter(name="postInstall")
+    def post_install(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that were installed
+        """
+        return pulumi.get(self, "post_install")
+
+    @property
+    @pulumi.getter(name="postUninstall")
+    def post_uninstall(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        				to the location and version that were removed
+        """
+        return pulumi.get(self, "post_uninstall")
+
+    @property
+    @pulumi.getter(name="preInstall")
+    def pre_install(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that will be installed
+        """
+        return pulumi.get(self, "pre_install")
+
+    @property
+    @pulumi.getter(name="preUninstall")
+    def pre_uninstall(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        				and PDE_VERSION are set to the location and version that are installed
+        """
+        return pulumi.get(self, "pre_uninstall")
+
+    @property
+    @pulumi.getter
+    def ref(self) -> pulumi.Output[Optional[str]]:
+        """
+        The tag, branch or semver constraint over the tags to follow instead of branch, e.g. v1.2.3,
+        				develop or ~0.10. A constraint can use ~, ^ and ranges like >=1.2.0 <2.0.0 and resolves to
+        				the highest tag that matches. Prerelease tags are never matched
+        """
+        return pulumi.get(self, "ref")
+
+    @property
+    @pulumi.getter
+    def remotes(self) -> pulumi.Output[Optional[Mapping[str, 'outputs.GitRemote']]]:
+        """
+        More remotes to add to the clone by name, e.g. the upstream of a fork. origin is the
+        				url of the repo but can be listed to set its pushUrl. Remotes that are removed from here are
+        				removed from the clone, remotes that were added by hand are left alone
+        """
+        return pulumi.get(self, "remotes")
+
+    @property
+    @pulumi.getter
+    def repo(self) -> pulumi.Output[Optional[str]]:
+        """
+        The GitHub repository name. Not needed if url is set
+        """
+        return pulumi.get(self, "repo")
+
+    @property
+    @pulumi.getter(name="retainOnDelete")
+    def retain_on_delete(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Leave the clone on disk when the resource is deleted. The uninstall commands
+        				are still run
+        """
+        return pulumi.get(self, "retain_on_delete")
+
+    @property
+    @pulumi.getter(name="secretOutputs")
+    def secret_outputs(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether to mark stdout, stderr and outputs as secret
+        """
+        return pulumi.get(self, "secret_outputs")
+
+    @property
+    @pulumi.getter(name="sparsePaths")
+    def sparse_paths(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Only check out these directories of the repo, along with the files at its root.
+        				This needs the git CLI
+        """
+        return pulumi.get(self, "sparse_paths")
+
+    @property
+    @pulumi.getter(name="sshKey")
+    def ssh_key(self) -> pulumi.Output[Optional[str]]:
+        """
+        The path to the private key to use for ssh remotes. If this is not set then the SSH agent
+        				is used. The host key of the remote must be in known_hosts
+        """
+        return pulumi.get(self, "ssh_key")
+
+    @property
+    @pulumi.getter(name="sshKeyPassword")
+    def ssh_key_password(self) -> pulumi.Output[Optional[str]]:
+        """
+        The password of sshKey if it is encrypted
+        """
+        return pulumi.get(self, "ssh_key_password")
+
+    @property
+    @pulumi.getter
+    def stderr(self) -> pulumi.Output[Optional[str]]:
+        """
+        The stderr of the last install or update
+        """
+        return pulumi.get(self, "stderr")
+
+    @property
+    @pulumi.getter
+    def stdin(self) -> pulumi.Output[Optional[str]]:
+        """
+        Input to write to the stdin of the install, update and uninstall commands. This can be used
+        				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        """
+        return pulumi.get(self, "stdin")
+
+    @property
+    @pulumi.getter
+    def stdout(self) -> pulumi.Output[Optional[str]]:
+        """
+        The stdout of the last install or update
+        """
+        return pulumi.get(self, "stdout")
+
+    @property
+    @pulumi.getter
+    def steps(self) -> pulumi.Output[Optional[Sequence['outputs.StepResult']]]:
+        """
+        The results of the steps that were run by the last install or update
+        """
+        return pulumi.get(self, "steps")
+
+    @property
+    @pulumi.getter
+    def submodules(self) -> pulumi.Output[Optional[str]]:
+        """
+        How to check out submodules whenever the version changes. One of none, shallow to check
+        				out only the commit each submodule points to, or recursive to also check out their submodules.
+        				Defaults to none. This needs the git CLI
+        """
+        return pulumi.get(self, "submodules")
+
+    @property
+    @pulumi.getter
+    def tag(self) -> pulumi.Output[Optional[str]]:
+        """
+        The tag that ref resolved to. This is set by the provider when ref is a tag or a constraint
+        """
+        return pulumi.get(self, "tag")
+
+    @property
+    @pulumi.getter(name="trackRemote")
+    def track_remote(self) -> pulumi.Output[Optional[str]]:
+        """
+        The remote whose branches and tags define version, either origin or one of remotes.
+        				Defaults to origin
+        """
+        return pulumi.get(self, "track_remote")
+
+    @property
+    @pulumi.getter(name="uninstallCommands")
+    def uninstall_commands(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Optional Commands to run to uninstall the program
+        """
+        return pulumi.get(self, "uninstall_commands")
+
+    @property
+    @pulumi.getter(name="uninstallSteps")
+    def uninstall_steps(self) -> pulumi.Output[Optional[Sequence['outputs.Step']]]:
+        """
+        Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        				A failing step fails the delete unless it sets continueOnError
+        """
+        return pulumi.get(self, "uninstall_steps")
+
+    @property
+    @pulumi.getter(name="updateCommands")
+    def update_commands(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Optional Commands to run to update the program
+        """
+        return pulumi.get(self, "update_commands")
+
+    @property
+    @pulumi.getter(name="updateSteps")
+    def update_steps(self) -> pulumi.Output[Optional[Sequence['outputs.Step']]]:
+        """
+        Optional steps to run to update the program. These are run after updateCommands
+        """
+        return pulumi.get(self, "update_steps")
+
+    @property
+    @pulumi.getter
+    def url(self) -> pulumi.Output[Optional[str]]:
+        """
+        The URL of the git repo to clone, e.g. git@host:org/repo.git, ssh://, https:// or file://.
+        				org and repo are not needed if this is set. Defaults to https://github.com/$ORG/$REPO
+        """
+        return pulumi.get(self, "url")
+
+    @property
+    @pulumi.getter
+    def verify(self) -> pulumi.Output[Optional['outputs.Verify']]:
+        """
+        A command to check that the program works after it is installed or updated. If this
+        				fails then a create is cleaned up and an update is rolled back
+        """
+        return pulumi.get(self, "verify")
+
+    @property
+    @pulumi.getter
+    def version(self) -> pulumi.Output[Optional[str]]:
+        """
+        The commit to check out. If this is not set then it is the commit that ref or the head of
+        				branch resolves to
+        """
+        return pulumi.get(self, "version")
+
@@ -0,0 +1,742 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['NpmArgs', 'Npm']
+
+@pulumi.input_type
+class NpmArgs:
+    def __init__(__self__, *,
+                 location: pulumi.Input[str],
+                 package: pulumi.Input[str],
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]] = None,
+                 verify: Optional[pulumi.Input['VerifyArgs']] = None,
+                 version: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a Npm resource.
+        :param pulumi.Input[str] location: The location of the node project
+        :param pulumi.Input[str] package: The npm package to install
+        :param pulumi.Input[bool] become: Whether to run the install, update and uninstall commands as root. If the become method
+               				needs a password then it is read from the becomePassword provider config
+        :param pulumi.Input[str] become_method: The method to use to become root. Either sudo or doas. Defaults to sudo
+        :param pulumi.Input[bool] inherit_environment: Whether commands inherit the environment of the provider. If this is false then
+               				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+               				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+               				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+               				directories. Defaults to true
+        :param pulumi.Input[str] outputs_from: How to create the outputs map from the install and update commands. The only supported
+               				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+               				even if it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_install: Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that were installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_uninstall: Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+               				to the location and version that were removed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_install: Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that will be installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_uninstall: Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+               				and PDE_VERSION are set to the location and version that are installed
+        :param pulumi.Input[bool] secret_outputs: Whether to mark stdout, stderr and outputs as secret
+        :param pulumi.Input[str] stdin: Input to write to the stdin of the install, update and uninstall commands. This can be used
+               				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] uninstall_commands: Optional Commands to run to uninstall the program
+        :param pulumi.Input[Sequence[pulumi.Input['StepArgs']]] uninstall_steps: Optional steps to run to uninstall the program. These are run after uninstallCommands.
+               				A failing step fails the delete unless it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] update_commands: Optional Commands to run to update the program
+        :param pulumi.Input[Sequence[pulumi.Input['StepArgs']]] update_steps: Optional steps to run to update the program. These are run after updateCommands
+        :param pulumi.Input['VerifyArgs'] verify: A command to check that the program works after it is installed or updated. If this
+               				fails then a create is cleaned up and an update is rolled back
+        :param pulumi.Input[str] version: The version of the package to install
+        """
+        pulumi.set(__self__, "location", location)
+        pulumi.set(__self__, "package", package)
+        if become is not None:
+            pulumi.set(__self__, "become", become)
+        if become_method is not None:
+            pulumi.set(__self__, "become_method", become_method)
+        if inherit_environment is not None:
+            pulumi.set(__self__, "inherit_environment", inherit_environment)
+        if outputs_from is not None:
+            pulumi.set(__self__, "outputs_from", outputs_from)
+        if post_install is not None:
+            pulumi.set(__self__, "post_install", post_install)
+        if post_uninstall is not None:
+            pulumi.set(__self__, "post_uninstall", post_uninstall)
+        if pre_install is not None:
+            pulumi.set(__self__, "pre_install", pre_install)
+        if pre_uninstall is not None:
+            pulumi.set(__self__, "pre_uninstall", pre_uninstall)
+        if secret_outputs is not None:
+            pulumi.set(__self__, "secret_outputs", secret_outputs)
+        if stdin is not None:
+            pulumi.set(__self__, "stdin", stdin)
+        if uninstall_commands is not None:
+            pulumi.set(__self__, "uninstall_commands", uninstall_commands)
+        if uninstall_steps is not None:
+            pulumi.set(__self__, "uninstall_steps", uninstall_steps)
+        if update_commands is not None:
+            pulumi.set(__self__, "update_commands", update_commands)
+        if update_steps is not None:
+            pulumi.set(__self__, "update_steps", update_steps)
+        if verify is not None:
+            pulumi.set(__self__, "verify", verify)
+        if version is not None:
+            pulumi.set(__self__, "version", version)
+
+    @property
+    @pulumi.getter
+    def location(self) -> pulumi.Input[str]:
+        """
+        The location of the node project
+        """
+        return pulumi.get(self, "location")
+
+    @location.setter
+    def location(self, value: pulumi.Input[str]):
+        pulumi.set(self, "location", value)
+
+    @property
+    @pulumi.getter
+    def package(self) -> pulumi.Input[str]:
+        """
+        The npm package to install
+        """
+        return pulumi.get(self, "package")
+
+    @package.setter
+    def package(self, value: pulumi.Input[str]):
+        pulumi.set(self, "package", value)
+
+    @property
+    @pulumi.getter
+    def become(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to run the install, update and uninstall commands as root. If the become method
+        				needs a password then it is read from the becomePassword provider config
+        """
+        return pulumi.get(self, "become")
+
+    @become.setter
+    def become(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "become", value)
+
+    @property
+    @pulumi.getter(name="becomeMethod")
+    def become_method(self) -> Optional[pulumi.Input[str]]:
+        """
+        The method to use to become root. Either sudo or doas. Defaults to sudo
+        """
+        return pulumi.get(self, "become_method")
+
+    @become_method.setter
+    def become_method(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "become_method", value)
+
+    @property
+    @pulumi.getter(name="inheritEnvironment")
+    def inherit_environment(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether commands inherit the environment of the provider. If this is false then
+        				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        				directories. Defaults to true
+        """
+        return pulumi.get(self, "inherit_environment")
+
+    @inherit_environment.setter
+    def inherit_environment(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "inherit_environment", value)
+
+    @property
+    @pulumi.getter(name="outputsFrom")
+    def outputs_from(self) -> Optional[pulumi.Input[str]]:
+        """
+        How to create the outputs map from the install and update commands. The only supported
+        				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        				even if it sets continueOnError
+        """
+        return pulumi.get(self, "outputs_from")
+
+    @outputs_from.setter
+    def outputs_from(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "outputs_from", value)
+
+    @property
+    @pulumi.getter(name="postInstall")
+    def post_install(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that were installed
+        """
+        return pulumi.get(self, "post_install")
+
+    @post_install.setter
+    def post_install(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "post_install", value)
+
+    @property
+    @pulumi.getter(name="postUninstall")
+    def post_uninstall(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        				to the location and version that were removed
+        """
+        return pulumi.get(self, "post_uninstall")
+
+    @post_uninstall.setter
+    def post_uninstall(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "post_uninstall", value)
+
+    @property
+    @pulumi.getter(name="preInstall")
+    def pre_install(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that will be installed
+        """
+        return pulumi.get(self, "pre_install")
+
+    @pre_install.setter
+    def pre_install(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "pre_install", value)
+
+    @property
+    @pulumi.getter(name="preUninstall")
+    def pre_uninstall(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        				and PDE_VERSION are set to the location and version that are installed
+        """
+        return pulumi.get(self, "pre_uninstall")
+
+    @pre_uninstall.setter
+    def pre_uninstall(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "pre_uninstall", value)
+
+    @property
+    @pulumi.getter(name="secretOutputs")
+    def secret_outputs(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to mark stdout, stderr and outputs as secret
+        """
+        return pulumi.get(self, "secret_outputs")
+
+    @secret_outputs.setter
+    def secret_outputs(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "secret_outputs", value)
+
+    @property
+    @pulumi.getter
+    def stdin(self) -> Optional[pulumi.Input[str]]:
+        """
+        Input to write to the stdin of the install, update and uninstall commands. This can be used
+        				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        """
+        return pulumi.get(self, "stdin")
+
+    @stdin.setter
+    def stdin(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "stdin", value)
+
+    @property
+    @pulumi.getter(name="uninstallCommands")
+    def uninstall_commands(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Optional Commands to run to uninstall the program
+        """
+        return pulumi.get(self, "uninstall_commands")
+
+    @uninstall_commands.setter
+    def uninstall_commands(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "uninstall_commands", value)
+
+    @property
+    @pulumi.getter(name="uninstallSteps")
+    def uninstall_steps(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]:
+        """
+        Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        				A failing step fails the delete unless it sets continueOnError
+        """
+        return pulumi.get(self, "uninstall_steps")
+
+    @uninstall_steps.setter
+    def uninstall_steps(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]):
+        pulumi.set(self, "uninstall_steps", value)
+
+    @property
+    @pulumi.getter(name="updateCommands")
+    def update_commands(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Optional Commands to run to update the program
+        """
+        return pulumi.get(self, "update_commands")
+
+    @update_commands.setter
+    def update_commands(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "update_commands", value)
+
+    @property
+    @pulumi.getter(name="updateSteps")
+    def update_steps(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]:
+        """
+        Optional steps to run to update the program. These are run after updateCommands
+        """
+        return pulumi.get(self, "update_steps")
+
+    @update_steps.setter
+    def update_steps(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]):
+        pulumi.set(self, "update_steps", value)
+
+    @property
+    @pulumi.getter
+    def verify(self) -> Optional[pulumi.Input['VerifyArgs']]:
+        """
+        A command to check that the program works after it is installed or updated. If this
+        				fails then a create is cleaned up and an update is rolled back
+        """
+        return pulumi.get(self, "verify")
+
+    @verify.setter
+    def verify(self, value: Optional[pulumi.Input['VerifyArgs']]):
+        pulumi.set(self, "verify", value)
+
+    @property
+    @pulumi.getter
+    def version(self) -> Optional[pulumi.Input[str]]:
+        """
+        The version of the package to install
+        """
+        return pulumi.get(self, "version")
+
+    @version.setter
+    def version(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "version", value)
+
+
+class Npm(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 location: Optional[pulumi.Input[str]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 package: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 verify: Optional[pulumi.Input[pulumi.InputType['VerifyArgs']]] = None,
+                 version: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Install global npm packages.
+
+        This resource will create a local node project at the location you specify
+        and will then symlink the node_modules/.bin directory so that all the executables
+        are available globally.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] become: Whether to run the install, update and uninstall commands as root. If the become method
+               				needs a password then it is read from the becomePassword provider config
+        :param pulumi.Input[str] become_method: The method to use to become root. Either sudo or doas. Defaults to sudo
+        :param pulumi.Input[bool] inherit_environment: Whether commands inherit the environment of the provider. If this is false then
+               				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+               				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+               				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+               				directories. Defaults to true
+        :param pulumi.Input[str] location: The location of the node project
+        :param pulumi.Input[str] outputs_from: How to create the outputs map from the install and update commands. The only supported
+               				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+               				even if it sets continueOnError
+        :param pulumi.Input[str] package: The npm package to install
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_install: Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that were installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_uninstall: Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+               				to the location and version that were removed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_install: Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that will be installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_uninstall: Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+               				and PDE_VERSION are set to the location and version that are installed
+        :param pulumi.Input[bool] secret_outputs: Whether to mark stdout, stderr and outputs as secret
+        :param pulumi.Input[str] stdin: Input to write to the stdin of the install, update and uninstall commands. This can be used
+               				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] uninstall_commands: Optional Commands to run to uninstall the program
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]] uninstall_steps: Optional steps to run to uninstall the program. These are run after uninstallCommands.
+               				A failing step fails the delete unless it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] update_commands: Optional Commands to run to update the program
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]] update_steps: Optional steps to run to update the program. These are run after updateCommands
+        :param pulumi.Input[pulumi.InputType['VerifyArgs']] verify: A command to check that the program works after it is installed or updated. If this
+               				fails then a create is cleaned up and an update is rolled back
+        :param pulumi.Input[str] version: The version of the package to install
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: NpmArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Install global npm packages.
+
+        This resource will create a local node project at the location you specify
+        and will then symlink the node_modules/.bin directory so that all the executables
+        are available globally.
+
+        :param str resource_name: The name of the resource.
+        :param NpmArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(NpmArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 location: Optional[pulumi.Input[str]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 package: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 verify: Optional[pulumi.Input[pulumi.InputType['VerifyArgs']]] = None,
+                 version: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = NpmArgs.__new__(NpmArgs)
+
+            __props__.__dict__["become"] = become
+            __props__.__dict__["become_method"] = become_method
+            __props__.__dict__["inherit_environment"] = inherit_environment
+            if location is None and not opts.urn:
+                raise TypeError("Missing required property 'location'")
+            __props__.__dict__["location"] = location
+            __props__.__dict__["outputs_from"] = outputs_from
+            if package is None and not opts.urn:
+                raise TypeError("Missing required property 'package'")
+            __props__.__dict__["package"] = package
+            __props__.__dict__["post_install"] = post_install
+            __props__.__dict__["post_uninstall"] = post_uninstall
+            __props__.__dict__["pre_install"] = pre_install
+            __props__.__dict__["pre_uninstall"] = pre_uninstall
+            __props__.__dict__["secret_outputs"] = secret_outputs
+            __props__.__dict__["stdin"] = None if stdin is None else pulumi.Output.secret(stdin)
+            __props__.__dict__["uninstall_commands"] = uninstall_commands
+            __props__.__dict__["uninstall_steps"] = uninstall_steps
+            __props__.__dict__["update_commands"] = update_commands
+            __props__.__dict__["update_steps"] = update_steps
+            __props__.__dict__["verify"] = verify
+            __props__.__dict__["version"] = version
+            __props__.__dict__["environment"] = None
+            __props__.__dict__["interpreter"] = None
+            __props__.__dict__["outputs"] = None
+            __props__.__dict__["stderr"] = None
+            __props__.__dict__["stdout"] = None
+            __props__.__dict__["steps"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["stdin"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Npm, __self__).__init__(
+            'pde:installers:Npm',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Npm':
+        """
+        Get an existing Npm resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = NpmArgs.__new__(NpmArgs)
+
+        __props__.__dict__["become"] = None
+        __props__.__dict__["become_method"] = None
+        __props__.__dict__["environment"] = None
+        __props__.__dict__["inherit_environment"] = None
+        __props__.__dict__["interpreter"] = None
+        __props__.__dict__["location"] = None
+        __props__.__dict__["outputs"] = None
+        __props__.__dict__["outputs_from"] = None
+        __props__.__dict__["package"] = None
+        __props__.__dict__["post_install"] = None
+        __props__.__dict__["post_uninstall"] = None
+        __props__.__dict__["pre_install"] = None
+        __props__.__dict__["pre_uninstall"] = None
+        __props__.__dict__["secret_outputs"] = None
+        __props__.__dict__["stderr"] = None
+        __props__.__dict__["stdin"] = None
+        __props__.__dict__["stdout"] = None
+        __props__.__dict__["steps"] = None
+        __props__.__dict__["uninstall_commands"] = None
+        __props__.__dict__["uninstall_steps"] = None
+        __props__.__dict__["update_commands"] = None
+        __props__.__dict__["update_steps"] = None
+        __props__.__dict__["verify"] = None
+        __props__.__dict__["version"] = None
+        return Npm(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def become(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether to run the install, update and uninstall commands as root. If the become method
+        				needs a password then it is read from the becomePassword provider config
+        """
+        return pulumi.get(self, "become")
+
+    @property
+    @pulumi.getter(name="becomeMethod")
+    def become_method(self) -> pulumi.Output[Optional[str]]:
+        """
+        The method to use to become root. Either sudo or doas. Defaults to sudo
+        """
+        return pulumi.get(self, "become_method")
+
+    @property
+    @pulumi.getter
+    def environment(self) -> pulumi.Output[Optional[Mapping[str, str]]]:
+        """
+        The environment variables to set when running the commands
+        """
+        return pulumi.get(self, "environment")
+
+    @property
+    @pulumi.getter(name="inheritEnvironment")
+    def inherit_environment(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether commands inherit the environment of the provider. If this is false then
+        				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        				directories. Defaults to true
+        """
+        return pulumi.get(self, "inherit_environment")
+
+    @property
+    @pulumi.getter
+    def interpreter(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+        """
+        return pulumi.get(self, "interpreter")
+
+    @property
+    @pulumi.getter
+    def location(self) -> pulumi.Output[str]:
+        """
+        The location of the node project
+        """
+        return pulumi.get(self, "location")
+
+    @property
+    @pulumi.getter
+    def outputs(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        The outputs parsed from the last install or update. See outputsFrom
+        """
+        return pulumi.get(self, "outputs")
+
+    @property
+    @pulumi.getter(name="outputsFrom")
+    def outputs_from(self) -> pulumi.Output[Optional[str]]:
+        """
+        How to create the outputs map from the install and update commands. The only supported
+        				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        				even if it sets continueOnError
+        """
+        return pulumi.get(self, "outputs_from")
+
+    @property
+    @pulumi.getter
+    def package(self) -> pulumi.Output[str]:
+        """
+        The npm package to install
+        """
+        return pulumi.get(self, "package")
+
+    @property
+    @pulumi.getter(name="postInstall")
+    def post_install(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that were installed
+        """
+        return pulumi.get(self, "post_install")
+
+    @property
+    @pulumi.getter(name="postUninstall")
+    def post_uninstall(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        				to the location and version that were removed
+        """
+        return pulumi.get(self, "post_uninstall")
+
+    @property
+    @pulumi.getter(name="preInstall")
+    def pre_install(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that will be installed
+        """
+        return pulumi.get(self, "pre_install")
+
+    @property
+    @pulumi.getter(name="preUninstall")
+    def pre_uninstall(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        				and PDE_VERSION are set to the location and version that are installed
+        """
+        return pulumi.get(self, "pre_uninstall")
+
+    @property
+    @pulumi.getter(name="secretOutputs")
+    def secret_outputs(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether to mark stdout, stderr and outputs as secret
+        """
+        return pulumi.get(self, "secret_outputs")
+
+    @property
+    @pulumi.getter
+    def stderr(self) -> pulumi.Output[Optional[str]]:
+        """
+        The stderr of the last install or update
+        """
+        return pulumi.get(self, "stderr")
+
+    @property
+    @pulumi.getter
+    def stdin(self) -> pulumi.Output[Optional[str]]:
+        """
+        Input to write to the stdin of the install, update and uninstall commands. This can be used
+        				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        """
+        return pulumi.get(self, "stdin")
+
+    @property
+    @pulumi.getter
+    def stdout(self) -> pulumi.Output[Optional[str]]:
+        """
+        The stdout of the last install or update
+        """
+        return pulumi.get(self, "stdout")
+
+    @property
+    @pulumi.getter
+    def steps(self) -> pulumi.Output[Optional[Sequence['outputs.StepResult']]]:
+        """
+        The results of the steps that were run by the last install or update
+        """
+        return pulumi.get(self, "steps")
+
+    @property
+    @pulumi.getter(name="uninstallCommands")
+    def uninstall_commands(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Optional Commands to run to uninstall the program
+        """
+        return pulumi.get(self, "uninstall_commands")
+
+    @property
+    @pulumi.getter(name="uninstallSteps")
+    def uninstall_steps(self) -> pulumi.Output[Optional[Sequence['outputs.Step']]]:
+        """
+        Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        				A failing step fails the delete unless it sets continueOnError
+        """
+        return pulumi.get(self, "uninstall_steps")
+
+    @property
+    @pulumi.getter(name="updateCommands")
+    def update_commands(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Optional Commands to run to update the program
+        """
+        return pulumi.get(self, "update_commands")
+
+    @property
+    @pulumi.getter(name="updateSteps")
+    def update_steps(self) -> pulumi.Output[Optional[Sequence['outputs.Step']]]:
+        """
+        Optional steps to run to update the program. These are run after updateCommands
+        """
+        return pulumi.get(self, "update_steps")
+
+    @property
+    @pulumi.getter
+    def verify(self) -> pulumi.Output[Optional['outputs.Verify']]:
+        """
+        A command to check that the program works after it is installed or updated. If this
+        				fails then a create is cleaned up and an update is rolled back
+        """
+        return pulumi.get(self, "verify")
+
+    @property
+    @pulumi.getter
+    def version(self) -> pulumi.Output[Optional[str]]:
+        """
+        The version of the package to install
+        """
+        return pulumi.get(self, "version")
+
@@ -0,0 +1,390 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = [
+    'GitRemote',
+    'LocalChanges',
+    'Plan',
+    'Step',
+    'StepResult',
+    'Verify',
+]
+
+@pulumi.output_type
+class GitRemote(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "pushUrl":
+            suggest = "push_url"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in GitRemote. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        GitRemote.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        GitRemote.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 url: str,
+                 push_url: Optional[str] = None):
+        """
+        :param str url: The URL to fetch from
+        :param str push_url: The URL to push to. Defaults to url
+        """
+        pulumi.set(__self__, "url", url)
+        if push_url is not None:
+            pulumi.set(__self__, "push_url", push_url)
+
+    @property
+    @pulumi.getter
+    def url(self) -> str:
+        """
+        The URL to fetch from
+        """
+        return pulumi.get(self, "url")
+
+    @property
+    @pulumi.getter(name="pushUrl")
+    def push_url(self) -> Optional[str]:
+        """
+        The URL to push to. Defaults to url
+        """
+        return pulumi.get(self, "push_url")
+
+
+@pulumi.output_type
+class LocalChanges(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "unpushedCommits":
+            suggest = "unpushed_commits"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in LocalChanges. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        LocalChanges.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        LocalChanges.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 stashes: int,
+                 files: Optional[Sequence[str]] = None,
+                 unpushed_commits: Optional[Sequence[str]] = None):
+        """
+        :param int stashes: The number of stash entries
+        :param Sequence[str] files: The tracked files that have uncommitted changes
+        :param Sequence[str] unpushed_commits: The commits on HEAD or a local branch that are not on any remote branch or tag,
+               				as the short hash and subject
+        """
+        pulumi.set(__self__, "stashes", stashes)
+        if files is not None:
+            pulumi.set(__self__, "files", files)
+        if unpushed_commits is not None:
+            pulumi.set(__self__, "unpushed_commits", unpushed_commits)
+
+    @property
+    @pulumi.getter
+    def stashes(self) -> int:
+        """
+        The number of stash entries
+        """
+        return pulumi.get(self, "stashes")
+
+    @property
+    @pulumi.getter
+    def files(self) -> Optional[Sequence[str]]:
+        """
+        The tracked files that have uncommitted changes
+        """
+        return pulumi.get(self, "files")
+
+    @property
+    @pulumi.getter(name="unpushedCommits")
+    def unpushed_commits(self) -> Optional[Sequence[str]]:
+        """
+        The commits on HEAD or a local branch that are not on any remote branch or tag,
+        				as the short hash and subject
+        """
+        return pulumi.get(self, "unpushed_commits")
+
+
+@pulumi.output_type
+class Plan(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "downloadURL":
+            suggest = "download_url"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in Plan. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        Plan.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        Plan.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 commands: Sequence[str],
+                 removes: Sequence[str],
+                 writes: Sequence[str],
+                 asset: Optional[str] = None,
+                 download_url: Optional[str] = None):
+        """
+        :param Sequence[str] commands: The commands that are run, in order
+        :param Sequence[str] removes: The files that are removed
+        :param Sequence[str] writes: The files that are written
+        :param str asset: The release asset that is installed
+        :param str download_url: The resolved URL the program is downloaded from
+        """
+        pulumi.set(__self__, "commands", commands)
+        pulumi.set(__self__, "removes", removes)
+        pulumi.set(__self__, "writes", writes)
+        if asset is not None:
+            pulumi.set(__self__, "asset", asset)
+        if download_url is not None:
+            pulumi.set(__self__, "download_url", download_url)
+
+    @property
+    @pulumi.getter
+    def commands(self) -> Sequence[str]:
+        """
+        The commands that are run, in order
+        """
+        return pulumi.get(self, "commands")
+
+    @property
+    @pulumi.getter
+    def removes(self) -> Sequence[str]:
+        """
+        The files that are removed
+        """
+        return pulumi.get(self, "removes")
+
+    @property
+    @pulumi.getter
+    def writes(self) -> Sequence[str]:
+        """
+        The files that are written
+        """
+        return pulumi.get(self, "writes")
+
+    @property
+    @pulumi.getter
+    def asset(self) -> Optional[str]:
+        """
+        The release asset that is installed
+        """
+        return pulumi.get(self, "asset")
+
+    @property
+    @pulumi.getter(name="downloadURL")
+    def download_url(self) -> Optional[str]:
+        """
+        The resolved URL the program is downloaded from
+        """
+        return pulumi.get(self, "download_url")
+
+
+@pulumi.output_type
+class Step(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "continueOnError":
+            suggest = "continue_on_error"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in Step. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        Step.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        Step.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 command: str,
+                 continue_on_error: Optional[bool] = None,
+                 dir: Optional[str] = None,
+                 name: Optional[str] = None):
+        """
+        :param str command: The command to run
+        :param bool continue_on_error: Whether to continue with the next step if this step fails
+        :param str dir: The directory to run the command in. Relative paths are relative to
+               				the directory the resource runs its commands in
+        :param str name: The name of the step. This is used in logs and errors. Defaults to the command
+        """
+        pulumi.set(__self__, "command", command)
+        if continue_on_error is not None:
+            pulumi.set(__self__, "continue_on_error", continue_on_error)
+        if dir is not None:
+            pulumi.set(__self__, "dir", dir)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+
+    @property
+    @pulumi.getter
+    def command(self) -> str:
+        """
+        The command to run
+        """
+        return pulumi.get(self, "command")
+
+    @property
+    @pulumi.getter(name="continueOnError")
+    def continue_on_error(self) -> Optional[bool]:
+        """
+        Whether to continue with the next step if this step fails
+        """
+        return pulumi.get(self, "continue_on_error")
+
+    @property
+    @pulumi.getter
+    def dir(self) -> Optional[str]:
+        """
+        The directory to run the command in. Relative paths are relative to
+        				the directory the resource runs its commands in
+        """
+        return pulumi.get(self, "dir")
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[str]:
+        """
+        The name of the step. This is used in logs and errors. Defaults to the command
+        """
+        return pulumi.get(self, "name")
+
+
+@pulumi.output_type
+class StepResult(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "exitCode":
+            suggest = "exit_code"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in StepResult. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        StepResult.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        StepResult.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 duration: str,
+                 exit_code: int,
+                 name: str):
+        """
+        :param str duration: How long the step took to run
+        :param int exit_code: The exit code of the step. This is -1 if the step could not be started
+        :param str name: The name of the step
+        """
+        pulumi.set(__self__, "duration", duration)
+        pulumi.set(__self__, "exit_code", exit_code)
+        pulumi.set(__self__, "name", name)
+
+    @property
+    @pulumi.getter
+    def duration(self) -> str:
+        """
+        How long the step took to run
+        """
+        return pulumi.get(self, "duration")
+
+    @property
+    @pulumi.getter(name="exitCode")
+    def exit_code(self) -> int:
+        """
+        The exit code of the step. This is -1 if the step could not be started
+        """
+        return pulumi.get(self, "exit_code")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the step
+        """
+        return pulumi.get(self, "name")
+
+
+@pulumi.output_type
+class Verify(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "expectedOutput":
+            suggest = "expected_output"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in Verify. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        Verify.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        Verify.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 command: str,
+                 expected_output: Optional[str] = None):
+        """
+        :param str command: The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+               				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+        :param str expected_output: A regex that the output of the command must match. Both stdout and stderr are
+               				matched since some programs print their version to stderr
+        """
+        pulumi.set(__self__, "command", command)
+        if expected_output is not None:
+            pulumi.set(__self__, "expected_output", expected_output)
+
+    @property
+    @pulumi.getter
+    def command(self) -> str:
+        """
+        The command to run to check the install, e.g. "$PDE_LOCATION --version". This is run with
+        				PDE_LOCATION and PDE_VERSION set in the same way as the lifecycle hooks
+        """
+        return pulumi.get(self, "command")
+
+    @property
+    @pulumi.getter(name="expectedOutput")
+    def expected_output(self) -> Optional[str]:
+        """
+        A regex that the output of the command must match. Both stdout and stderr are
+        				matched since some programs print their version to stderr
+        """
+        return pulumi.get(self, "expected_output")
+
+
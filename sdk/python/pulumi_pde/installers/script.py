@@ -0,0 +1,852 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['ScriptArgs', 'Script']
+
+@pulumi.input_type
+class ScriptArgs:
+    def __init__(__self__, *,
+                 create: pulumi.Input[str],
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 delete: Optional[pulumi.Input[str]] = None,
+                 dir: Optional[pulumi.Input[str]] = None,
+                 environment: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 interpreter: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 read: Optional[pulumi.Input[str]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]] = None,
+                 update: Optional[pulumi.Input[str]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]] = None,
+                 verify: Optional[pulumi.Input['VerifyArgs']] = None):
+        """
+        The set of arguments for constructing a Script resource.
+        :param pulumi.Input[str] create: The script to run when the resource is created
+        :param pulumi.Input[bool] become: Whether to run the install, update and uninstall commands as root. If the become method
+               				needs a password then it is read from the becomePassword provider config
+        :param pulumi.Input[str] become_method: The method to use to become root. Either sudo or doas. Defaults to sudo
+        :param pulumi.Input[str] delete: The script to run when the resource is deleted. It is followed by uninstallCommands and uninstallSteps
+        :param pulumi.Input[str] dir: The directory to run the scripts in
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] environment: The environment variables to set when running the scripts
+        :param pulumi.Input[bool] inherit_environment: Whether commands inherit the environment of the provider. If this is false then
+               				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+               				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+               				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+               				directories. Defaults to true
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] interpreter: The interpreter to run the scripts with, e.g. ['python3', '-u']. The path of the script
+               				is added as the last argument. Defaults to the shebang of each script, or /bin/sh
+        :param pulumi.Input[str] outputs_from: How to create the outputs map from the install and update commands. The only supported
+               				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+               				even if it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_install: Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that were installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_uninstall: Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+               				to the location and version that were removed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_install: Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that will be installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_uninstall: Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+               				and PDE_VERSION are set to the location and version that are installed
+        :param pulumi.Input[str] read: The script to run to read the state of the resource. This runs after create,
+               				update and during refresh. If it prints a JSON object then that becomes the outputs of the resource
+        :param pulumi.Input[bool] secret_outputs: Whether to mark stdout, stderr and outputs as secret
+        :param pulumi.Input[str] stdin: Input to write to the stdin of the install, update and uninstall commands. This can be used
+               				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] uninstall_commands: Optional Commands to run to uninstall the program
+        :param pulumi.Input[Sequence[pulumi.Input['StepArgs']]] uninstall_steps: Optional steps to run to uninstall the program. These are run after uninstallCommands.
+               				A failing step fails the delete unless it sets continueOnError
+        :param pulumi.Input[str] update: The script to run when the create or update script changes. It is followed by
+               				updateCommands and updateSteps. If none of them are provided then changing the create script
+               				will replace the resource
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] update_commands: Optional Commands to run to update the program
+        :param pulumi.Input[Sequence[pulumi.Input['StepArgs']]] update_steps: Optional steps to run to update the program. These are run after updateCommands
+        :param pulumi.Input['VerifyArgs'] verify: A command to check that the program works after it is installed or updated. If this
+               				fails then a create is cleaned up and an update is rolled back
+        """
+        pulumi.set(__self__, "create", create)
+        if become is not None:
+            pulumi.set(__self__, "become", become)
+        if become_method is not None:
+            pulumi.set(__self__, "become_method", become_method)
+        if delete is not None:
+            pulumi.set(__self__, "delete", delete)
+        if dir is not None:
+            pulumi.set(__self__, "dir", dir)
+        if environment is not None:
+            pulumi.set(__self__, "environment", environment)
+        if inherit_environment is not None:
+            pulumi.set(__self__, "inherit_environment", inherit_environment)
+        if interpreter is not None:
+            pulumi.set(__self__, "interpreter", interpreter)
+        if outputs_from is not None:
+            pulumi.set(__self__, "outputs_from", outputs_from)
+        if post_install is not None:
+            pulumi.set(__self__, "post_install", post_install)
+        if post_uninstall is not None:
+            pulumi.set(__self__, "post_uninstall", post_uninstall)
+        if pre_install is not None:
+            pulumi.set(__self__, "pre_install", pre_install)
+        if pre_uninstall is not None:
+            pulumi.set(__self__, "pre_uninstall", pre_uninstall)
+        if read is not None:
+            pulumi.set(__self__, "read", read)
+        if secret_outputs is not None:
+            pulumi.set(__self__, "secret_outputs", secret_outputs)
+        if stdin is not None:
+            pulumi.set(__self__, "stdin", stdin)
+        if uninstall_commands is not None:
+            pulumi.set(__self__, "uninstall_commands", uninstall_commands)
+        if uninstall_steps is not None:
+            pulumi.set(__self__, "uninstall_steps", uninstall_steps)
+        if update is not None:
+            pulumi.set(__self__, "update", update)
+        if update_commands is not None:
+            pulumi.set(__self__, "update_commands", update_commands)
+        if update_steps is not None:
+            pulumi.set(__self__, "update_steps", update_steps)
+        if verify is not None:
+            pulumi.set(__self__, "verify", verify)
+
+    @property
+    @pulumi.getter
+    def create(self) -> pulumi.Input[str]:
+        """
+        The script to run when the resource is created
+        """
+        return pulumi.get(self, "create")
+
+    @create.setter
+    def create(self, value: pulumi.Input[str]):
+        pulumi.set(self, "create", value)
+
+    @property
+    @pulumi.getter
+    def become(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to run the install, update and uninstall commands as root. If the become method
+        				needs a password then it is read from the becomePassword provider config
+        """
+        return pulumi.get(self, "become")
+
+    @become.setter
+    def become(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "become", value)
+
+    @property
+    @pulumi.getter(name="becomeMethod")
+    def become_method(self) -> Optional[pulumi.Input[str]]:
+        """
+        The method to use to become root. Either sudo or doas. Defaults to sudo
+        """
+        return pulumi.get(self, "become_method")
+
+    @become_method.setter
+    def become_method(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "become_method", value)
+
+    @property
+    @pulumi.getter
+    def delete(self) -> Optional[pulumi.Input[str]]:
+        """
+        The script to run when the resource is deleted. It is followed by uninstallCommands and uninstallSteps
+        """
+        return pulumi.get(self, "delete")
+
+    @delete.setter
+    def delete(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "delete", value)
+
+    @property
+    @pulumi.getter
+    def dir(self) -> Optional[pulumi.Input[str]]:
+        """
+        The directory to run the scripts in
+        """
+        return pulumi.get(self, "dir")
+
+    @dir.setter
+    def dir(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "dir", value)
+
+    @property
+    @pulumi.getter
+    def environment(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        The environment variables to set when running the scripts
+        """
+        return pulumi.get(self, "environment")
+
+    @environment.setter
+    def environment(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "environment", value)
+
+    @property
+    @pulumi.getter(name="inheritEnvironment")
+    def inherit_environment(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether commands inherit the environment of the provider. If this is false then
+        				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        				directories. Defaults to true
+        """
+        return pulumi.get(self, "inherit_environment")
+
+    @inherit_environment.setter
+    def inherit_environment(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "inherit_environment", value)
+
+    @property
+    @pulumi.getter
+    def interpreter(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The interpreter to run the scripts with, e.g. ['python3', '-u']. The path of the script
+        				is added as the last argument. Defaults to the shebang of each script, or /bin/sh
+        """
+        return pulumi.get(self, "interpreter")
+
+    @interpreter.setter
+    def interpreter(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "interpreter", value)
+
+    @property
+    @pulumi.getter(name="outputsFrom")
+    def outputs_from(self) -> Optional[pulumi.Input[str]]:
+        """
+        How to create the outputs map from the install and update commands. The only supported
+        				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        				even if it sets continueOnError
+        """
+        return pulumi.get(self, "outputs_from")
+
+    @outputs_from.setter
+    def outputs_from(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "outputs_from", value)
+
+    @property
+    @pulumi.getter(name="postInstall")
+    def post_install(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that were installed
+        """
+        return pulumi.get(self, "post_install")
+
+    @post_install.setter
+    def post_install(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "post_install", value)
+
+    @property
+    @pulumi.getter(name="postUninstall")
+    def post_uninstall(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        				to the location and version that were removed
+        """
+        return pulumi.get(self, "post_uninstall")
+
+    @post_uninstall.setter
+    def post_uninstall(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "post_uninstall", value)
+
+    @property
+    @pulumi.getter(name="preInstall")
+    def pre_install(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that will be installed
+        """
+        return pulumi.get(self, "pre_install")
+
+    @pre_install.setter
+    def pre_install(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "pre_install", value)
+
+    @property
+    @pulumi.getter(name="preUninstall")
+    def pre_uninstall(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        				and PDE_VERSION are set to the location and version that are installed
+        """
+        return pulumi.get(self, "pre_uninstall")
+
+    @pre_uninstall.setter
+    def pre_uninstall(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "pre_uninstall", value)
+
+    @property
+    @pulumi.getter
+    def read(self) -> Optional[pulumi.Input[str]]:
+        """
+        The script to run to read the state of the resource. This runs after create,
+        				update and during refresh. If it prints a JSON object then that becomes the outputs of the resource
+        """
+        return pulumi.get(self, "read")
+
+    @read.setter
+    def read(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "read", value)
+
+    @property
+    @pulumi.getter(name="secretOutputs")
+    def secret_outputs(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to mark stdout, stderr and outputs as secret
+        """
+        return pulumi.get(self, "secret_outputs")
+
+    @secret_outputs.setter
+    def secret_outputs(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "secret_outputs", value)
+
+    @property
+    @pulumi.getter
+    def stdin(self) -> Optional[pulumi.Input[str]]:
+        """
+        Input to write to the stdin of the install, update and uninstall commands. This can be used
+        				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        """
+        return pulumi.get(self, "stdin")
+
+    @stdin.setter
+    def stdin(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "stdin", value)
+
+    @property
+    @pulumi.getter(name="uninstallCommands")
+    def uninstall_commands(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Optional Commands to run to uninstall the program
+        """
+        return pulumi.get(self, "uninstall_commands")
+
+    @uninstall_commands.setter
+    def uninstall_commands(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "uninstall_commands", value)
+
+    @property
+    @pulumi.getter(name="uninstallSteps")
+    def uninstall_steps(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]:
+        """
+        Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        				A failing step fails the delete unless it sets continueOnError
+        """
+        return pulumi.get(self, "uninstall_steps")
+
+    @uninstall_steps.setter
+    def uninstall_steps(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]):
+        pulumi.set(self, "uninstall_steps", value)
+
+    @property
+    @pulumi.getter
+    def update(self) -> Optional[pulumi.Input[str]]:
+        """
+        The script to run when the create or update script changes. It is followed by
+        				updateCommands and updateSteps. If none of them are provided then changing the create script
+        				will replace the resource
+        """
+        return pulumi.get(self, "update")
+
+    @update.setter
+    def update(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "update", value)
+
+    @property
+    @pulumi.getter(name="updateCommands")
+    def update_commands(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Optional Commands to run to update the program
+        """
+        return pulumi.get(self, "update_commands")
+
+    @update_commands.setter
+    def update_commands(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "update_commands", value)
+
+    @property
+    @pulumi.getter(name="updateSteps")
+    def update_steps(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]:
+        """
+        Optional steps to run to update the program. These are run after updateCommands
+        """
+        return pulumi.get(self, "update_steps")
+
+    @update_steps.setter
+    def update_steps(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['StepArgs']]]]):
+        pulumi.set(self, "update_steps", value)
+
+    @property
+    @pulumi.getter
+    def verify(self) -> Optional[pulumi.Input['VerifyArgs']]:
+        """
+        A command to check that the program works after it is installed or updated. If this
+        				fails then a create is cleaned up and an update is rolled back
+        """
+        return pulumi.get(self, "verify")
+
+    @verify.setter
+    def verify(self, value: Optional[pulumi.Input['VerifyArgs']]):
+        pulumi.set(self, "verify", value)
+
+
+class Script(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 create: Optional[pulumi.Input[str]] = None,
+                 delete: Optional[pulumi.Input[str]] = None,
+                 dir: Optional[pulumi.Input[str]] = None,
+                 environment: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 interpreter: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 read: Optional[pulumi.Input[str]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 update: Optional[pulumi.Input[str]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 verify: Optional[pulumi.Input[pulumi.InputType['VerifyArgs']]] = None,
+                 __props__=None):
+        """
+        Run inline scripts to install, update and uninstall something.
+
+        Each script is written to a private temporary file and run using
+        interpreter, or the interpreter from its shebang, e.g. #!/usr/bin/env python3.
+        Scripts without either are run with /bin/sh.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] become: Whether to run the install, update and uninstall commands as root. If the become method
+               				needs a password then it is read from the becomePassword provider config
+        :param pulumi.Input[str] become_method: The method to use to become root. Either sudo or doas. Defaults to sudo
+        :param pulumi.Input[str] create: The script to run when the resource is created
+        :param pulumi.Input[str] delete: The script to run when the resource is deleted. It is followed by uninstallCommands and uninstallSteps
+        :param pulumi.Input[str] dir: The directory to run the scripts in
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] environment: The environment variables to set when running the scripts
+        :param pulumi.Input[bool] inherit_environment: Whether commands inherit the environment of the provider. If this is false then
+               				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+               				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+               				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+               				directories. Defaults to true
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] interpreter: The interpreter to run the scripts with, e.g. ['python3', '-u']. The path of the script
+               				is added as the last argument. Defaults to the shebang of each script, or /bin/sh
+        :param pulumi.Input[str] outputs_from: How to create the outputs map from the install and update commands. The only supported
+               				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+               				even if it sets continueOnError
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_install: Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that were installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] post_uninstall: Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+               				to the location and version that were removed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_install: Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+               				are set to the location and version that will be installed
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] pre_uninstall: Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+               				and PDE_VERSION are set to the location and version that are installed
+        :param pulumi.Input[str] read: The script to run to read the state of the resource. This runs after create,
+               				update and during refresh. If it prints a JSON object then that becomes the outputs of the resource
+        :param pulumi.Input[bool] secret_outputs: Whether to mark stdout, stderr and outputs as secret
+        :param pulumi.Input[str] stdin: Input to write to the stdin of the install, update and uninstall commands. This can be used
+               				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] uninstall_commands: Optional Commands to run to uninstall the program
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]] uninstall_steps: Optional steps to run to uninstall the program. These are run after uninstallCommands.
+               				A failing step fails the delete unless it sets continueOnError
+        :param pulumi.Input[str] update: The script to run when the create or update script changes. It is followed by
+               				updateCommands and updateSteps. If none of them are provided then changing the create script
+               				will replace the resource
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] update_commands: Optional Commands to run to update the program
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]] update_steps: Optional steps to run to update the program. These are run after updateCommands
+        :param pulumi.Input[pulumi.InputType['VerifyArgs']] verify: A command to check that the program works after it is installed or updated. If this
+               				fails then a create is cleaned up and an update is rolled back
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ScriptArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Run inline scripts to install, update and uninstall something.
+
+        Each script is written to a private temporary file and run using
+        interpreter, or the interpreter from its shebang, e.g. #!/usr/bin/env python3.
+        Scripts without either are run with /bin/sh.
+
+        :param str resource_name: The name of the resource.
+        :param ScriptArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ScriptArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 become: Optional[pulumi.Input[bool]] = None,
+                 become_method: Optional[pulumi.Input[str]] = None,
+                 create: Optional[pulumi.Input[str]] = None,
+                 delete: Optional[pulumi.Input[str]] = None,
+                 dir: Optional[pulumi.Input[str]] = None,
+                 environment: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 inherit_environment: Optional[pulumi.Input[bool]] = None,
+                 interpreter: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 outputs_from: Optional[pulumi.Input[str]] = None,
+                 post_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 post_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_install: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 pre_uninstall: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 read: Optional[pulumi.Input[str]] = None,
+                 secret_outputs: Optional[pulumi.Input[bool]] = None,
+                 stdin: Optional[pulumi.Input[str]] = None,
+                 uninstall_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 uninstall_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 update: Optional[pulumi.Input[str]] = None,
+                 update_commands: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 update_steps: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['StepArgs']]]]] = None,
+                 verify: Optional[pulumi.Input[pulumi.InputType['VerifyArgs']]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ScriptArgs.__new__(ScriptArgs)
+
+            __props__.__dict__["become"] = become
+            __props__.__dict__["become_method"] = become_method
+            if create is None and not opts.urn:
+                raise TypeError("Missing required property 'create'")
+            __props__.__dict__["create"] = create
+            __props__.__dict__["delete"] = delete
+            __props__.__dict__["dir"] = dir
+            __props__.__dict__["environment"] = environment
+            __props__.__dict__["inherit_environment"] = inherit_environment
+            __props__.__dict__["interpreter"] = interpreter
+            __props__.__dict__["outputs_from"] = outputs_from
+            __props__.__dict__["post_install"] = post_install
+            __props__.__dict__["post_uninstall"] = post_uninstall
+            __props__.__dict__["pre_install"] = pre_install
+            __props__.__dict__["pre_uninstall"] = pre_uninstall
+            __props__.__dict__["read"] = read
+            __props__.__dict__["secret_outputs"] = secret_outputs
+            __props__.__dict__["stdin"] = None if stdin is None else pulumi.Output.secret(stdin)
+            __props__.__dict__["uninstall_commands"] = uninstall_commands
+            __props__.__dict__["uninstall_steps"] = uninstall_steps
+            __props__.__dict__["update"] = update
+            __props__.__dict__["update_commands"] = update_commands
+            __props__.__dict__["update_steps"] = update_steps
+            __props__.__dict__["verify"] = verify
+            __props__.__dict__["outputs"] = None
+            __props__.__dict__["stderr"] = None
+            __props__.__dict__["stdout"] = None
+            __props__.__dict__["steps"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["stdin"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Script, __self__).__init__(
+            'pde:installers:Script',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Script':
+        """
+        Get an existing Script resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = ScriptArgs.__new__(ScriptArgs)
+
+        __props__.__dict__["become"] = None
+        __props__.__dict__["become_method"] = None
+        __props__.__dict__["create"] = None
+        __props__.__dict__["delete"] = None
+        __props__.__dict__["dir"] = None
+        __props__.__dict__["environment"] = None
+        __props__.__dict__["inherit_environment"] = None
+        __props__.__dict__["interpreter"] = None
+        __props__.__dict__["outputs"] = None
+        __props__.__dict__["outputs_from"] = None
+        __props__.__dict__["post_install"] = None
+        __props__.__dict__["post_uninstall"] = None
+        __props__.__dict__["pre_install"] = None
+        __props__.__dict__["pre_uninstall"] = None
+        __props__.__dict__["read"] = None
+        __props__.__dict__["secret_outputs"] = None
+        __props__.__dict__["stderr"] = None
+        __props__.__dict__["stdin"] = None
+        __props__.__dict__["stdout"] = None
+        __props__.__dict__["steps"] = None
+        __props__.__dict__["uninstall_commands"] = None
+        __props__.__dict__["uninstall_steps"] = None
+        __props__.__dict__["update"] = None
+        __props__.__dict__["update_commands"] = None
+        __props__.__dict__["update_steps"] = None
+        __props__.__dict__["verify"] = None
+        return Script(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def become(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether to run the install, update and uninstall commands as root. If the become method
+        				needs a password then it is read from the becomePassword provider config
+        """
+        return pulumi.get(self, "become")
+
+    @property
+    @pulumi.getter(name="becomeMethod")
+    def become_method(self) -> pulumi.Output[Optional[str]]:
+        """
+        The method to use to become root. Either sudo or doas. Defaults to sudo
+        """
+        return pulumi.get(self, "become_method")
+
+    @property
+    @pulumi.getter
+    def create(self) -> pulumi.Output[str]:
+        """
+        The script to run when the resource is created
+        """
+        return pulumi.get(self, "create")
+
+    @property
+    @pulumi.getter
+    def delete(self) -> pulumi.Output[Optional[str]]:
+        """
+        The script to run when the resource is deleted. It is followed by uninstallCommands and uninstallSteps
+        """
+        return pulumi.get(self, "delete")
+
+    @property
+    @pulumi.getter
+    def dir(self) -> pulumi.Output[Optional[str]]:
+        """
+        The directory to run the scripts in
+        """
+        return pulumi.get(self, "dir")
+
+    @property
+    @pulumi.getter
+    def environment(self) -> pulumi.Output[Optional[Mapping[str, str]]]:
+        """
+        The environment variables to set when running the commands
+        """
+        return pulumi.get(self, "environment")
+
+    @property
+    @pulumi.getter(name="inheritEnvironment")
+    def inherit_environment(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether commands inherit the environment of the provider. If this is false then
+        				only HOME, USER, LANG and TERM are passed through and PATH is made up of the bin directories of
+        				the resource, e.g. binLocation or the node_modules/.bin of Npm, then those of the other resources
+        				in the stack, the binPaths provider config and $HOME/.local/bin followed by the system
+        				directories. Defaults to true
+        """
+        return pulumi.get(self, "inherit_environment")
+
+    @property
+    @pulumi.getter
+    def interpreter(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The interpreter to use to run the commands. Defaults to ['/bin/sh', '-c']
+        """
+        return pulumi.get(self, "interpreter")
+
+    @property
+    @pulumi.getter
+    def outputs(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        The outputs parsed from the last install or update. See outputsFrom
+        """
+        return pulumi.get(self, "outputs")
+
+    @property
+    @pulumi.getter(name="outputsFrom")
+    def outputs_from(self) -> pulumi.Output[Optional[str]]:
+        """
+        How to create the outputs map from the install and update commands. The only supported
+        				value is json, which parses the stdout of the last step as a JSON object. That step must succeed,
+        				even if it sets continueOnError
+        """
+        return pulumi.get(self, "outputs_from")
+
+    @property
+    @pulumi.getter(name="postInstall")
+    def post_install(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run after the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that were installed
+        """
+        return pulumi.get(self, "post_install")
+
+    @property
+    @pulumi.getter(name="postUninstall")
+    def post_uninstall(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run after the program has been removed. PDE_LOCATION and PDE_VERSION are set
+        				to the location and version that were removed
+        """
+        return pulumi.get(self, "post_uninstall")
+
+    @property
+    @pulumi.getter(name="preInstall")
+    def pre_install(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run before the program is installed or updated. PDE_LOCATION and PDE_VERSION
+        				are set to the location and version that will be installed
+        """
+        return pulumi.get(self, "pre_install")
+
+    @property
+    @pulumi.getter(name="preUninstall")
+    def pre_uninstall(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Commands to run before the uninstall commands when the program is removed. PDE_LOCATION
+        				and PDE_VERSION are set to the location and version that are installed
+        """
+        return pulumi.get(self, "pre_uninstall")
+
+    @property
+    @pulumi.getter
+    def read(self) -> pulumi.Output[Optional[str]]:
+        """
+        The script to run to read the state of the resource. This runs after create,
+        				update and during refresh. If it prints a JSON object then that becomes the outputs of the resource
+        """
+        return pulumi.get(self, "read")
+
+    @property
+    @pulumi.getter(name="secretOutputs")
+    def secret_outputs(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether to mark stdout, stderr and outputs as secret
+        """
+        return pulumi.get(self, "secret_outputs")
+
+    @property
+    @pulumi.getter
+    def stderr(self) -> pulumi.Output[Optional[str]]:
+        """
+        The stderr of the last install or update
+        """
+        return pulumi.get(self, "stderr")
+
+    @property
+    @pulumi.getter
+    def stdin(self) -> pulumi.Output[Optional[str]]:
+        """
+        Input to write to the stdin of the install, update and uninstall commands. This can be used
+        				to answer the prompts of interactive installers, e.g. "y\\n" to accept a license
+        """
+        return pulumi.get(self, "stdin")
+
+    @property
+    @pulumi.getter
+    def stdout(self) -> pulumi.Output[Optional[str]]:
+        """
+        The stdout of the last install or update
+        """
+        return pulumi.get(self, "stdout")
+
+    @property
+    @pulumi.getter
+    def steps(self) -> pulumi.Output[Optional[Sequence['outputs.StepResult']]]:
+        """
+        The results of the steps that were run by the last install or update
+        """
+        return pulumi.get(self, "steps")
+
+    @property
+    @pulumi.getter(name="uninstallCommands")
+    def uninstall_commands(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Optional Commands to run to uninstall the program
+        """
+        return pulumi.get(self, "uninstall_commands")
+
+    @property
+    @pulumi.getter(name="uninstallSteps")
+    def uninstall_steps(self) -> pulumi.Output[Optional[Sequence['outputs.Step']]]:
+        """
+        Optional steps to run to uninstall the program. These are run after uninstallCommands.
+        				A failing step fails the delete unless it sets continueOnError
+        """
+        return pulumi.get(self, "uninstall_steps")
+
+    @property
+    @pulumi.getter
+    def update(self) -> pulumi.Output[Optional[str]]:
+        """
+        The script to run when the create or update script changes. It is followed by
+        				updateCommands and updateSteps. If none of them are provided then changing the create script
+        				will replace the resource
+        """
+        return pulumi.get(self, "update")
+
+    @property
+    @pulumi.getter(name="updateCommands")
+    def update_commands(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Optional Commands to run to update the program
+        """
+        return pulumi.get(self, "update_commands")
+
+    @property
+    @pulumi.getter(name="updateSteps")
+    def update_steps(self) -> pulumi.Output[Optional[Sequence['outputs.Step']]]:
+        """
+        Optional steps to run to update the program. These are run after updateCommands
+        """
+        return pulumi.get(self, "update_steps")
+
+    @property
+    @pulumi.getter
+    def verify(self) -> pulumi.Output[Optional['outputs.Verify']]:
+        """
+        A command to check that the program works after it is installed or updated. If this
+        				fails then a create is cleaned up and an update is rolled back
+        """
+        return pulumi.get(self, "verify")
+
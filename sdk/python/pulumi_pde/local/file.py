@@ -0,0 +1,179 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['FileArgs', 'File']
+
+@pulumi.input_type
+class FileArgs:
+    def __init__(__self__, *,
+                 content: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 path: pulumi.Input[str],
+                 force: Optional[pulumi.Input[bool]] = None):
+        """
+        The set of arguments for constructing a File resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] content: The content of the file.
+        :param pulumi.Input[str] path: The path of the file. This defaults to the name of the pulumi resource.
+        :param pulumi.Input[bool] force: If an already existing file should be deleted if it exists.
+        """
+        pulumi.set(__self__, "content", content)
+        pulumi.set(__self__, "path", path)
+        if force is not None:
+            pulumi.set(__self__, "force", force)
+
+    @property
+    @pulumi.getter
+    def content(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        """
+        The content of the file.
+        """
+        return pulumi.get(self, "content")
+
+    @content.setter
+    def content(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "content", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Input[str]:
+        """
+        The path of the file. This defaults to the name of the pulumi resource.
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: pulumi.Input[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def force(self) -> Optional[pulumi.Input[bool]]:
+        """
+        If an already existing file should be deleted if it exists.
+        """
+        return pulumi.get(self, "force")
+
+    @force.setter
+    def force(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "force", value)
+
+
+class File(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 content: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 force: Optional[pulumi.Input[bool]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        A file projected into a pulumi resource
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] content: The content of the file.
+        :param pulumi.Input[bool] force: If an already existing file should be deleted if it exists.
+        :param pulumi.Input[str] path: The path of the file. This defaults to the name of the pulumi resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: FileArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A file projected into a pulumi resource
+
+        :param str resource_name: The name of the resource.
+        :param FileArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(FileArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 content: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 force: Optional[pulumi.Input[bool]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = FileArgs.__new__(FileArgs)
+
+            if content is None and not opts.urn:
+                raise TypeError("Missing required property 'content'")
+            __props__.__dict__["content"] = content
+            __props__.__dict__["force"] = force
+            if path is None and not opts.urn:
+                raise TypeError("Missing required property 'path'")
+            __props__.__dict__["path"] = path
+        super(File, __self__).__init__(
+            'pde:local:File',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'File':
+        """
+        Get an existing File resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = FileArgs.__new__(FileArgs)
+
+        __props__.__dict__["content"] = None
+        __props__.__dict__["force"] = None
+        __props__.__dict__["path"] = None
+        return File(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def content(self) -> pulumi.Output[Sequence[str]]:
+        """
+        The content of the file.
+        """
+        return pulumi.get(self, "content")
+
+    @property
+    @pulumi.getter
+    def force(self) -> pulumi.Output[bool]:
+        """
+        If an already existing file should be deleted if it exists.
+        """
+        return pulumi.get(self, "force")
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Output[str]:
+        """
+        The path of the file.
+        """
+        return pulumi.get(self, "path")
+
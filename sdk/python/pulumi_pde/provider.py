@@ -0,0 +1,169 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['ProviderArgs', 'Provider']
+
+@pulumi.input_type
+class ProviderArgs:
+    def __init__(__self__, *,
+                 become_password: Optional[pulumi.Input[str]] = None,
+                 bin_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 source_root: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a Provider resource.
+        :param pulumi.Input[str] become_password: The password to use when running commands with become. This is
+               				passed to the become method through an askpass helper and is never written to state or logs
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] bin_paths: Directories to put on PATH for commands that don't inherit the environment. They come
+               				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+               				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+               				resources it deletes, so this can be used to always find tools installed by other resources
+        :param pulumi.Input[str] source_root: The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+               				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+               				Defaults to cloning to $HOME/$REPO
+        """
+        if become_password is not None:
+            pulumi.set(__self__, "become_password", become_password)
+        if bin_paths is not None:
+            pulumi.set(__self__, "bin_paths", bin_paths)
+        if source_root is not None:
+            pulumi.set(__self__, "source_root", source_root)
+
+    @property
+    @pulumi.getter(name="becomePassword")
+    def become_password(self) -> Optional[pulumi.Input[str]]:
+        """
+        The password to use when running commands with become. This is
+        				passed to the become method through an askpass helper and is never written to state or logs
+        """
+        return pulumi.get(self, "become_password")
+
+    @become_password.setter
+    def become_password(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "become_password", value)
+
+    @property
+    @pulumi.getter(name="binPaths")
+    def bin_paths(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Directories to put on PATH for commands that don't inherit the environment. They come
+        				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+        				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+        				resources it deletes, so this can be used to always find tools installed by other resources
+        """
+        return pulumi.get(self, "bin_paths")
+
+    @bin_paths.setter
+    def bin_paths(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "bin_paths", value)
+
+    @property
+    @pulumi.getter(name="sourceRoot")
+    def source_root(self) -> Optional[pulumi.Input[str]]:
+        """
+        The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+        				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+        				Defaults to cloning to $HOME/$REPO
+        """
+        return pulumi.get(self, "source_root")
+
+    @source_root.setter
+    def source_root(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "source_root", value)
+
+
+class Provider(pulumi.ProviderResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 become_password: Optional[pulumi.Input[str]] = None,
+                 bin_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 source_root: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Create a Pde resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] become_password: The password to use when running commands with become. This is
+               				passed to the become method through an askpass helper and is never written to state or logs
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] bin_paths: Directories to put on PATH for commands that don't inherit the environment. They come
+               				after the bin directories of the resources in the stack and before $HOME/.local/bin. The
+               				provider only knows the bin directories of the resources it runs, e.g. a destroy only runs the
+               				resources it deletes, so this can be used to always find tools installed by other resources
+        :param pulumi.Input[str] source_root: The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+               				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+               				Defaults to cloning to $HOME/$REPO
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[ProviderArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Create a Pde resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param ProviderArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ProviderArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 become_password: Optional[pulumi.Input[str]] = None,
+                 bin_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 source_root: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ProviderArgs.__new__(ProviderArgs)
+
+            __props__.__dict__["become_password"] = None if become_password is None else pulumi.Output.secret(become_password)
+            __props__.__dict__["bin_paths"] = pulumi.Output.from_input(bin_paths).apply(pulumi.runtime.to_json) if bin_paths is not None else None
+            __props__.__dict__["source_root"] = source_root
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["becomePassword"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Provider, __self__).__init__(
+            'pde',
+            resource_name,
+            __props__,
+            opts)
+
+    @property
+    @pulumi.getter(name="becomePassword")
+    def become_password(self) -> pulumi.Output[Optional[str]]:
+        """
+        The password to use when running commands with become. This is
+        				passed to the become method through an askpass helper and is never written to state or logs
+        """
+        return pulumi.get(self, "become_password")
+
+    @property
+    @pulumi.getter(name="sourceRoot")
+    def source_root(self) -> pulumi.Output[Optional[str]]:
+        """
+        The directory to clone GitHubRepos to when they don't set folderName, e.g. ~/src. Repos are
+        				cloned to $SOURCE_ROOT/$HOST/$ORG/$REPO like ghq does. Changing this replaces those repos.
+        				Defaults to cloning to $HOME/$REPO
+        """
+        return pulumi.get(self, "source_root")
+
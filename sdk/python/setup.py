@@ -0,0 +1,38 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import errno
+from setuptools import setup, find_packages
+from setuptools.command.install import install
+from subprocess import check_call
+
+
+VERSION = "0.0.0"
+def readme():
+    try:
+        with open('README.md', encoding='utf-8') as f:
+            return f.read()
+    except FileNotFoundError:
+        return "pde Pulumi Package - Development Version"
+
+
+setup(name='pulumi_pde',
+      python_requires='>=3.7',
+      version=VERSION,
+      description="The pulumi pde provider...",
+      long_description=readme(),
+      long_description_content_type='text/markdown',
+      packages=find_packages(),
+      package_data={
+          'pulumi_pde': [
+              'py.typed',
+              'pulumi-plugin.json',
+          ]
+      },
+      install_requires=[
+          'parver>=0.2.1',
+          'pulumi',
+          'semver>=2.8.1'
+      ],
+      zip_safe=False)